@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pbRecord "github.com/quanbin27/commons/genproto/records"
+	pbUser "github.com/quanbin27/commons/genproto/users"
+)
+
+// Lịch làm việc của nhân viên: lịch hẹn trong các ngày được chọn kèm thông tin khách, thú cưng và dịch vụ.
+// Dịch vụ và thú cưng được preload cùng lịch hẹn, khách và thú cưng tra một lần cho cả danh sách
+// qua GetUsersByIDs/GetPetsByIDs. Users/Records service lỗi thì vẫn trả lịch, chỉ thiếu thông tin bổ sung.
+
+// maxAgendaDays - số ngày tối đa xem một lần
+const maxAgendaDays = 31
+
+func (s *AppService) GetEmployeeAgenda(ctx context.Context, employeeID int32, fromDate, toDate string) ([]AgendaEntry, *time.Location, error) {
+	if employeeID <= 0 {
+		return nil, nil, fmt.Errorf("%w: employee_id is required", ErrInvalidArgument)
+	}
+	if toDate == "" {
+		toDate = fromDate
+	}
+	from, err := time.Parse(dateLayout, fromDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid from_date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	to, err := time.Parse(dateLayout, toDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: invalid to_date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	if to.Before(from) {
+		return nil, nil, fmt.Errorf("%w: to_date must not be before from_date", ErrInvalidArgument)
+	}
+	if to.Sub(from) >= maxAgendaDays*24*time.Hour {
+		return nil, nil, fmt.Errorf("%w: at most %d days can be viewed at once", ErrInvalidArgument, maxAgendaDays)
+	}
+
+	_, loc := s.employeeBranch(ctx, employeeID)
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(to.Year(), to.Month(), to.Day()+1, 0, 0, 0, 0, loc)
+	appointments, err := s.store.ListEmployeeAgenda(ctx, employeeID, start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var customerIDs []int32
+	var petIDs []string
+	seenCustomers := make(map[int32]bool)
+	seenPets := make(map[string]bool)
+	for _, a := range appointments {
+		if !seenCustomers[a.CustomerID] {
+			seenCustomers[a.CustomerID] = true
+			customerIDs = append(customerIDs, a.CustomerID)
+		}
+		for _, p := range a.Pets {
+			if !seenPets[p.PetID] {
+				seenPets[p.PetID] = true
+				petIDs = append(petIDs, p.PetID)
+			}
+		}
+	}
+	customers := s.usersByID(ctx, customerIDs)
+	pets := s.petsByID(ctx, petIDs)
+
+	entries := make([]AgendaEntry, len(appointments))
+	for i, a := range appointments {
+		entries[i] = AgendaEntry{Appointment: a, Customer: customers[a.CustomerID]}
+		for _, p := range a.Pets {
+			if pet, ok := pets[p.PetID]; ok {
+				entries[i].Pets = append(entries[i].Pets, pet)
+			}
+		}
+	}
+	return entries, loc, nil
+}
+
+// employeeBranch - chi nhánh của nhân viên (0 nếu không có) và múi giờ chi nhánh, UTC khi không tra được
+func (s *AppService) employeeBranch(ctx context.Context, employeeID int32) (int32, *time.Location) {
+	branch, err := s.userClient.GetBranchByEmployeeID(ctx, &pbUser.GetBranchByEmployeeIDRequest{EmployeeId: employeeID})
+	if err != nil || branch.BranchId == 0 {
+		return 0, time.UTC
+	}
+	_, loc, err := s.branchSchedule(ctx, branch.BranchId)
+	if err != nil {
+		log.Printf("Failed to get branch %d schedule for employee %d: %v", branch.BranchId, employeeID, err)
+		return branch.BranchId, time.UTC
+	}
+	return branch.BranchId, loc
+}
+
+// usersByID tra nhiều user một lần; lỗi chỉ ghi log
+func (s *AppService) usersByID(ctx context.Context, ids []int32) map[int32]*pbUser.User {
+	users := make(map[int32]*pbUser.User, len(ids))
+	if len(ids) == 0 {
+		return users
+	}
+	resp, err := s.userClient.GetUsersByIDs(ctx, &pbUser.GetUsersByIDsRequest{IDs: ids})
+	if err != nil {
+		log.Printf("Failed to get users %v: %v", ids, err)
+		return users
+	}
+	for _, u := range resp.Users {
+		users[u.ID] = u
+	}
+	return users
+}
+
+// petsByID tra nhiều thú cưng một lần; lỗi chỉ ghi log
+func (s *AppService) petsByID(ctx context.Context, ids []string) map[string]*pbRecord.Pet {
+	pets := make(map[string]*pbRecord.Pet, len(ids))
+	if len(ids) == 0 {
+		return pets
+	}
+	resp, err := s.recordsClient.GetPetsByIDs(ctx, &pbRecord.GetPetsByIDsRequest{Ids: ids})
+	if err != nil {
+		log.Printf("Failed to get pets %v: %v", ids, err)
+		return pets
+	}
+	for _, p := range resp.Pets {
+		pets[p.Id] = p
+	}
+	return pets
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Tự phân công nhân viên cho lịch hẹn. Ứng viên là các nhân viên đang trong ca tại chi nhánh suốt thời gian hẹn,
+// không nghỉ phép và không có lịch khác chồng giờ (bookingSnapshot.freeEmployees); AssignmentStrategy chọn một người.
+
+// AssignmentStrategy chọn một nhân viên trong candidates (đã sắp xếp theo ID), trả về 0 nếu không ai phù hợp
+type AssignmentStrategy interface {
+	Pick(ctx context.Context, appointment *Appointment, details []AppointmentDetail, candidates []int32) (int32, error)
+}
+
+// NewAssignmentStrategy khởi tạo chiến lược theo tên cấu hình (APPOINTMENT_ASSIGNMENT_STRATEGY)
+func NewAssignmentStrategy(name string, store AppointmentStore) (AssignmentStrategy, error) {
+	switch name {
+	case "least_loaded":
+		return &LeastLoadedStrategy{store: store}, nil
+	case "round_robin":
+		return NewRoundRobinStrategy(), nil
+	case "skill_match", "":
+		return &SkillMatchStrategy{store: store, next: &LeastLoadedStrategy{store: store}}, nil
+	default:
+		return nil, fmt.Errorf("unknown assignment strategy %q", name)
+	}
+}
+
+// LeastLoadedStrategy chọn nhân viên có tổng thời gian lịch hẹn ít nhất trong khoảng 12 giờ quanh giờ hẹn
+type LeastLoadedStrategy struct {
+	store AppointmentStore
+}
+
+func (s *LeastLoadedStrategy) Pick(ctx context.Context, appointment *Appointment, details []AppointmentDetail, candidates []int32) (int32, error) {
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+	appointments, err := s.store.ListEmployeeAppointmentsBetween(ctx, candidates, appointment.ScheduledTime.Add(-maxAppointmentDuration), appointment.ScheduledTime.Add(maxAppointmentDuration))
+	if err != nil {
+		return 0, err
+	}
+	load := make(map[int32]time.Duration)
+	for i := range appointments {
+		a := &appointments[i]
+		if a.ID != appointment.ID {
+			load[a.EmployeeID] += a.EndTime().Sub(a.ScheduledTime)
+		}
+	}
+	best := candidates[0]
+	for _, id := range candidates[1:] {
+		if load[id] < load[best] {
+			best = id
+		}
+	}
+	return best, nil
+}
+
+// RoundRobinStrategy lần lượt xoay vòng nhân viên theo từng chi nhánh.
+// Vị trí xoay vòng chỉ giữ trong bộ nhớ, khởi động lại service thì bắt đầu lại từ đầu.
+type RoundRobinStrategy struct {
+	mu   sync.Mutex
+	last map[int32]int32 // branch ID -> nhân viên được phân công gần nhất
+}
+
+func NewRoundRobinStrategy() *RoundRobinStrategy {
+	return &RoundRobinStrategy{last: make(map[int32]int32)}
+}
+
+func (s *RoundRobinStrategy) Pick(ctx context.Context, appointment *Appointment, details []AppointmentDetail, candidates []int32) (int32, error) {
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	picked := candidates[0]
+	for _, id := range candidates {
+		if id > s.last[appointment.BranchID] {
+			picked = id
+			break
+		}
+	}
+	s.last[appointment.BranchID] = picked
+	return picked, nil
+}
+
+// SkillMatchStrategy chỉ giữ nhân viên có kỹ năng cho mọi dịch vụ của lịch hẹn rồi để next chọn.
+// Dịch vụ chưa gán kỹ năng cho ai thì không giới hạn người làm.
+type SkillMatchStrategy struct {
+	store AppointmentStore
+	next  AssignmentStrategy
+}
+
+func (s *SkillMatchStrategy) Pick(ctx context.Context, appointment *Appointment, details []AppointmentDetail, candidates []int32) (int32, error) {
+	if len(candidates) == 0 || len(details) == 0 {
+		return s.next.Pick(ctx, appointment, details, candidates)
+	}
+	serviceIDs := make([]int32, len(details))
+	for i, d := range details {
+		serviceIDs[i] = d.ServiceID
+	}
+	skills, err := s.store.ListEmployeeSkills(ctx, nil, serviceIDs)
+	if err != nil {
+		return 0, err
+	}
+	restricted := make(map[int32]bool) // dịch vụ đã gán kỹ năng cho ít nhất một nhân viên
+	has := make(map[int32]map[int32]bool)
+	for _, skill := range skills {
+		restricted[skill.ServiceID] = true
+		if has[skill.EmployeeID] == nil {
+			has[skill.EmployeeID] = make(map[int32]bool)
+		}
+		has[skill.EmployeeID][skill.ServiceID] = true
+	}
+	var qualified []int32
+	for _, id := range candidates {
+		ok := true
+		for serviceID := range restricted {
+			if !has[id][serviceID] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			qualified = append(qualified, id)
+		}
+	}
+	return s.next.Pick(ctx, appointment, details, qualified)
+}
+
+// pickEmployee tìm nhân viên rảnh cho lịch hẹn theo chiến lược phân công, 0 nếu không có ai.
+// Gọi khi đang giữ khoá đặt lịch của chi nhánh và ghi phân công trước khi nhả khoá, để hai lịch không cùng chọn một người.
+func (s *AppService) pickEmployee(ctx context.Context, appointment *Appointment, details []AppointmentDetail) (int32, error) {
+	start, end := appointment.ScheduledTime, appointment.EndTime()
+	snapshot, err := s.loadBookingSnapshot(ctx, appointment.BranchID, 0, timeRange{start: start, end: end}, appointment.ID, appointment.location())
+	if err != nil {
+		return 0, err
+	}
+	candidates := snapshot.freeEmployees(start, end)
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+	return s.assigner.Pick(ctx, appointment, details, candidates)
+}
+
+// AutoAssignAppointment phân công (lại) nhân viên cho lịch hẹn chưa bắt đầu
+func (s *AppService) AutoAssignAppointment(ctx context.Context, appointmentID int32) (int32, string, error) {
+	appointment, details, err := s.store.GetAppointmentDetails(ctx, appointmentID)
+	if err != nil {
+		return 0, "Failed", err
+	}
+	if !isUpcoming(appointment.Status) {
+		return 0, "Failed", fmt.Errorf("%w: appointment %d is %s", ErrInvalidState, appointmentID, appointment.Status)
+	}
+	var employeeID int32
+	err = s.withBranchLock(ctx, appointment.BranchID, func() error {
+		if employeeID, err = s.pickEmployee(ctx, appointment, details); err != nil {
+			return err
+		}
+		if employeeID == 0 {
+			return fmt.Errorf("%w: no employee is available for appointment %d", ErrSlotUnavailable, appointmentID)
+		}
+		return s.store.UpdateAppointmentEmployee(ctx, appointmentID, employeeID)
+	})
+	if err != nil {
+		return 0, "Failed", err
+	}
+	return employeeID, "Success", nil
+}
+
+// autoAssignNew phân công cho lịch vừa tạo (vẫn trong khoá chi nhánh của CreateAppointment);
+// không tìm được người thì để quản lý phân công tay
+func (s *AppService) autoAssignNew(ctx context.Context, appointment *Appointment, details []AppointmentDetail) {
+	employeeID, err := s.pickEmployee(ctx, appointment, details)
+	if err != nil {
+		log.Printf("Failed to auto-assign appointment %d: %v", appointment.ID, err)
+		return
+	}
+	if employeeID == 0 {
+		return
+	}
+	if err := s.store.UpdateAppointmentEmployee(ctx, appointment.ID, employeeID); err != nil {
+		log.Printf("Failed to auto-assign appointment %d: %v", appointment.ID, err)
+		return
+	}
+	appointment.EmployeeID = employeeID
+}
+
+// reassignUncovered phân công lại các lịch hẹn chưa bắt đầu của nhân viên tại chi nhánh trong [from, to)
+// mà nhân viên không còn nhận được (ca bị xoá); lịch không tìm được người thay được bỏ phân công
+func (s *AppService) reassignUncovered(ctx context.Context, employeeID, branchID int32, from, to time.Time) []Reassignment {
+	appointments, err := s.store.ListEmployeeAppointmentsBetween(ctx, []int32{employeeID}, from.Add(-maxAppointmentDuration), to)
+	if err != nil {
+		log.Printf("Failed to list appointments of employee %d for reassignment: %v", employeeID, err)
+		return nil
+	}
+	unlock, err := s.store.LockBranchBookings(ctx, branchID)
+	if err != nil {
+		log.Printf("Failed to lock branch %d for reassignment: %v", branchID, err)
+		return nil
+	}
+	defer unlock()
+	now := time.Now()
+	var result []Reassignment
+	for i := range appointments {
+		a := &appointments[i]
+		if a.BranchID != branchID || !isUpcoming(a.Status) || !a.ScheduledTime.After(now) || !overlaps(a, from, to) {
+			continue
+		}
+		snapshot, err := s.loadBookingSnapshot(ctx, branchID, 0, timeRange{start: a.ScheduledTime, end: a.EndTime()}, a.ID, a.location())
+		if err != nil {
+			log.Printf("Failed to reassign appointment %d: %v", a.ID, err)
+			continue
+		}
+		if snapshot.checkEmployee(employeeID, a.ScheduledTime, a.EndTime()) == nil {
+			continue // vẫn còn ca khác phủ lịch hẹn
+		}
+		_, details, err := s.store.GetAppointmentDetails(ctx, a.ID)
+		if err != nil {
+			log.Printf("Failed to reassign appointment %d: %v", a.ID, err)
+			continue
+		}
+		newEmployee, err := s.pickEmployee(ctx, a, details)
+		if err != nil {
+			log.Printf("Failed to reassign appointment %d: %v", a.ID, err)
+			continue
+		}
+		if err := s.store.UpdateAppointmentEmployee(ctx, a.ID, newEmployee); err != nil {
+			log.Printf("Failed to reassign appointment %d: %v", a.ID, err)
+			continue
+		}
+		result = append(result, Reassignment{AppointmentID: a.ID, EmployeeID: newEmployee})
+	}
+	return result
+}
+
+// --- KỸ NĂNG NHÂN VIÊN ---
+func (s *AppService) SetEmployeeSkills(ctx context.Context, employeeID int32, serviceIDs []int32) (string, error) {
+	if employeeID <= 0 {
+		return "Failed", fmt.Errorf("%w: employee_id is required", ErrInvalidArgument)
+	}
+	unique := make([]int32, 0, len(serviceIDs))
+	seen := make(map[int32]bool)
+	for _, id := range serviceIDs {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	if len(unique) > 0 {
+		services, err := s.store.GetServicesByIDs(ctx, unique)
+		if err != nil {
+			return "Failed", err
+		}
+		if len(services) != len(unique) {
+			return "Failed", fmt.Errorf("%w: unknown service in %v", ErrInvalidArgument, unique)
+		}
+	}
+	if err := s.store.SetEmployeeSkills(ctx, employeeID, unique); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *AppService) ListEmployeeSkills(ctx context.Context, employeeID int32) ([]int32, error) {
+	skills, err := s.store.ListEmployeeSkills(ctx, []int32{employeeID}, nil)
+	if err != nil {
+		return nil, err
+	}
+	serviceIDs := make([]int32, len(skills))
+	for i, skill := range skills {
+		serviceIDs[i] = skill.ServiceID
+	}
+	return serviceIDs, nil
+}
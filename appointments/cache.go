@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/quanbin27/commons/cache"
+)
+
+// Cache danh sách dịch vụ trên Redis. cachedStore bọc AppointmentStore: GetServices đọc qua cache,
+// các hàm ghi dịch vụ (và ảnh chính, được đồng bộ vào img_url) xoá key sau khi ghi.
+const (
+	cacheKeyServices = "services"
+	servicesCacheTTL = 30 * time.Minute
+)
+
+type cachedStore struct {
+	AppointmentStore
+	cache *cache.Cache
+}
+
+func newCachedStore(store AppointmentStore, c *cache.Cache) AppointmentStore {
+	return &cachedStore{AppointmentStore: store, cache: c}
+}
+
+func (s *cachedStore) GetServices(ctx context.Context) ([]Service, error) {
+	return cache.Load(ctx, s.cache, cacheKeyServices, servicesCacheTTL, func() ([]Service, error) {
+		return s.AppointmentStore.GetServices(ctx)
+	})
+}
+
+// invalidate xoá danh sách dịch vụ khi err == nil rồi trả lại err
+func (s *cachedStore) invalidate(ctx context.Context, err error) error {
+	if err == nil {
+		s.cache.Invalidate(ctx, cacheKeyServices)
+	}
+	return err
+}
+
+func (s *cachedStore) CreateService(ctx context.Context, service *Service) error {
+	return s.invalidate(ctx, s.AppointmentStore.CreateService(ctx, service))
+}
+func (s *cachedStore) UpdateService(ctx context.Context, service *Service) error {
+	return s.invalidate(ctx, s.AppointmentStore.UpdateService(ctx, service))
+}
+func (s *cachedStore) DeleteService(ctx context.Context, serviceID int32) error {
+	return s.invalidate(ctx, s.AppointmentStore.DeleteService(ctx, serviceID))
+}
+func (s *cachedStore) RestoreService(ctx context.Context, serviceID int32) error {
+	return s.invalidate(ctx, s.AppointmentStore.RestoreService(ctx, serviceID))
+}
+func (s *cachedStore) AddServiceImages(ctx context.Context, images []ServiceImage) error {
+	return s.invalidate(ctx, s.AppointmentStore.AddServiceImages(ctx, images))
+}
+func (s *cachedStore) DeleteServiceImage(ctx context.Context, imageID int32) (*ServiceImage, error) {
+	image, err := s.AppointmentStore.DeleteServiceImage(ctx, imageID)
+	return image, s.invalidate(ctx, err)
+}
+
+// Giá hẹn trước được ghi thẳng vào dịch vụ khi đến hạn
+func (s *cachedStore) ApplyDueServicePriceChanges(ctx context.Context, now time.Time, limit int) ([]ServicePriceChange, error) {
+	applied, err := s.AppointmentStore.ApplyDueServicePriceChanges(ctx, now, limit)
+	if len(applied) > 0 {
+		s.cache.Invalidate(ctx, cacheKeyServices)
+	}
+	return applied, err
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/quanbin27/commons/cache"
+)
+
+// Toạ độ địa chỉ khách (lịch hẹn tại nhà). Geocoder chọn qua config: stub chạy offline, nominatim gọi OpenStreetMap.
+// Kết quả tra được cache trên Redis nên cùng một địa chỉ (vd. các lần hẹn của lịch định kỳ) chỉ tra một lần.
+
+const (
+	geocodeCacheTTL = 30 * 24 * time.Hour
+	geocodeTimeout  = 5 * time.Second
+	earthRadiusKm   = 6371.0
+	// nominatimInterval - chính sách sử dụng Nominatim công cộng: tối đa 1 request/giây
+	nominatimInterval = time.Second
+)
+
+var (
+	ErrAddressNotFound = errors.New("address not found")
+	// ErrGeocoderBusy - hàng đợi tra toạ độ đã dài hơn geocodeTimeout, bỏ qua thay vì chờ
+	ErrGeocoderBusy = errors.New("geocoder is busy")
+)
+
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Geocoder tra toạ độ một địa chỉ, ErrAddressNotFound khi không tìm thấy
+type Geocoder interface {
+	Geocode(ctx context.Context, address string) (GeoPoint, error)
+}
+
+func NewGeocoder(name, baseURL string, c *cache.Cache) (Geocoder, error) {
+	var g Geocoder
+	switch name {
+	case "stub", "":
+		return StubGeocoder{}, nil // không cần cache
+	case "nominatim":
+		g = &NominatimGeocoder{baseURL: strings.TrimRight(baseURL, "/"), client: &http.Client{Timeout: geocodeTimeout}}
+	default:
+		return nil, fmt.Errorf("unknown geocoder %q", name)
+	}
+	return &cachedGeocoder{next: g, cache: c}, nil
+}
+
+func normalizeAddress(address string) string {
+	return strings.ToLower(strings.Join(strings.Fields(address), " "))
+}
+
+// StubGeocoder - geocoder offline cho môi trường dev: băm địa chỉ thành một điểm trong bán kính ~15km
+// quanh trung tâm TP.HCM. Cùng địa chỉ luôn ra cùng điểm, địa chỉ khác nhau nằm rải rác.
+type StubGeocoder struct{}
+
+func (StubGeocoder) Geocode(ctx context.Context, address string) (GeoPoint, error) {
+	address = normalizeAddress(address)
+	if address == "" {
+		return GeoPoint{}, ErrAddressNotFound
+	}
+	h := fnv.New64a()
+	h.Write([]byte(address))
+	sum := h.Sum64()
+	// 0.135 độ ~ 15km
+	dLat := (float64(sum&0xffff)/0xffff*2 - 1) * 0.135
+	dLng := (float64(sum>>16&0xffff)/0xffff*2 - 1) * 0.135
+	return GeoPoint{Lat: 10.7769 + dLat, Lng: 106.7009 + dLng}, nil
+}
+
+// NominatimGeocoder tra toạ độ qua API search của Nominatim (OpenStreetMap). Các request được giãn cách
+// nominatimInterval (trong một tiến trình); request phải chờ quá geocodeTimeout bị bỏ với ErrGeocoderBusy,
+// để endpoint công khai như GET /appointments/slots không dồn hàng đợi hay vượt giới hạn của Nominatim.
+type NominatimGeocoder struct {
+	baseURL string
+	client  *http.Client
+
+	mu   sync.Mutex
+	next time.Time // thời điểm sớm nhất được gửi request tiếp theo
+}
+
+// wait giữ lượt gửi request tiếp theo rồi chờ tới lượt đó
+func (g *NominatimGeocoder) wait(ctx context.Context) error {
+	g.mu.Lock()
+	now := time.Now()
+	at := g.next
+	if at.Before(now) {
+		at = now
+	}
+	if at.Sub(now) > geocodeTimeout {
+		g.mu.Unlock()
+		return ErrGeocoderBusy
+	}
+	g.next = at.Add(nominatimInterval)
+	g.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address string) (GeoPoint, error) {
+	if err := g.wait(ctx); err != nil {
+		return GeoPoint{}, err
+	}
+	query := url.Values{"q": {address}, "format": {"json"}, "limit": {"1"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	// Nominatim yêu cầu User-Agent định danh ứng dụng
+	req.Header.Set("User-Agent", "BEPetCare-appointments")
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return GeoPoint{}, fmt.Errorf("geocoder returned status %d", resp.StatusCode)
+	}
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return GeoPoint{}, err
+	}
+	if len(results) == 0 {
+		return GeoPoint{}, ErrAddressNotFound
+	}
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return GeoPoint{}, err
+	}
+	return GeoPoint{Lat: lat, Lng: lng}, nil
+}
+
+type cachedGeocoder struct {
+	next  Geocoder
+	cache *cache.Cache
+}
+
+func (g *cachedGeocoder) Geocode(ctx context.Context, address string) (GeoPoint, error) {
+	address = normalizeAddress(address)
+	if address == "" {
+		return GeoPoint{}, ErrAddressNotFound
+	}
+	return cache.Load(ctx, g.cache, "geocode:"+address, geocodeCacheTTL, func() (GeoPoint, error) {
+		return g.next.Geocode(ctx, address)
+	})
+}
+
+// distanceKm - khoảng cách đường chim bay (haversine)
+func distanceKm(a, b GeoPoint) float64 {
+	rad := math.Pi / 180
+	dLat := (b.Lat - a.Lat) * rad
+	dLng := (b.Lng - a.Lng) * rad
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(a.Lat*rad)*math.Cos(b.Lat*rad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// geocode tra toạ độ address, nil khi địa chỉ trống hoặc không tra được (lỗi chỉ ghi log)
+func (s *AppService) geocode(ctx context.Context, address string) *GeoPoint {
+	if s.geocoder == nil || strings.TrimSpace(address) == "" {
+		return nil
+	}
+	point, err := s.geocoder.Geocode(ctx, address)
+	if err != nil {
+		if !errors.Is(err, ErrAddressNotFound) {
+			log.Printf("Failed to geocode address %q: %v", address, err)
+		}
+		return nil
+	}
+	return &point
+}
+
+// locate gán toạ độ CustomerAddress cho lịch hẹn (chưa lưu)
+func (s *AppService) locate(ctx context.Context, appointment *Appointment) {
+	if point := s.geocode(ctx, appointment.CustomerAddress); point != nil {
+		appointment.Latitude, appointment.Longitude, appointment.Geocoded = point.Lat, point.Lng, true
+	}
+}
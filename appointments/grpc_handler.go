@@ -2,33 +2,46 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"github.com/quanbin27/commons/cache"
 	"github.com/quanbin27/commons/config"
 	pb "github.com/quanbin27/commons/genproto/appointments"
+	pbProduct "github.com/quanbin27/commons/genproto/products"
 	pbUser "github.com/quanbin27/commons/genproto/users"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 	"log"
+	"time"
 )
 
 type AppointmentGrpcHandler struct {
 	appointmentService AppointmentService
 	pb.UnimplementedAppointmentServiceServer
-	userClient pbUser.UserServiceClient
+	userClient    pbUser.UserServiceClient
+	productClient pbProduct.ProductServiceClient
+	serviceCache  *cache.Cache
 }
 
-func NewAppointmentGrpcHandler(grpcServer *grpc.Server, appointmentService AppointmentService) {
+func NewAppointmentGrpcHandler(grpcServer *grpc.Server, appointmentService AppointmentService, serviceCache *cache.Cache) {
 
 	usersServiceAddr := config.Envs.UsersGrpcAddr
 	usersConn, err := grpc.NewClient(usersServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Fatalf("Failed to dial user server: %v", err)
 	}
+	productsConn, err := grpc.NewClient(config.Envs.ProductsGrpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to dial product server: %v", err)
+	}
 	grpcHandler := &AppointmentGrpcHandler{
 		appointmentService: appointmentService,
 		userClient:         pbUser.NewUserServiceClient(usersConn),
+		productClient:      pbProduct.NewProductServiceClient(productsConn),
+		serviceCache:       serviceCache,
 	}
 	pb.RegisterAppointmentServiceServer(grpcServer, grpcHandler)
 }
@@ -40,13 +53,39 @@ func (h *AppointmentGrpcHandler) CreateAppointment(ctx context.Context, req *pb.
 		Items[i] = AppointmentDetail{
 			Quantity:  item.Quantity,
 			ServiceID: item.ServiceId,
+			PetID:     item.PetId,
 		}
 	}
-	appointmentID, statusMsg, err := h.appointmentService.CreateAppointment(ctx, req.CustomerId, req.CustomerAddress, req.ScheduledTime.AsTime(), Items, req.Note, req.BranchId)
+	pets := make([]AppointmentPet, len(req.PetIds))
+	for i, petID := range req.PetIds {
+		pets[i] = AppointmentPet{PetID: petID}
+	}
+	appointment := &Appointment{
+		CustomerID:      req.CustomerId,
+		EmployeeID:      req.EmployeeId,
+		CustomerAddress: req.CustomerAddress,
+		ScheduledTime:   req.ScheduledTime.AsTime(),
+		Note:            req.Note,
+		BranchID:        req.BranchId,
+		Pets:            pets,
+	}
+	appointmentID, quote, statusMsg, err := h.appointmentService.CreateAppointment(ctx, appointment, Items, req.CouponCodes)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		// Lỗi từ promotion engine (mã giảm giá không hợp lệ, hết lượt...) giữ nguyên mã gRPC
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, toGrpcError(err)
 	}
-	return &pb.CreateAppointmentResponse{AppointmentId: appointmentID, Status: statusMsg}, nil
+	return &pb.CreateAppointmentResponse{
+		AppointmentId:     appointmentID,
+		Status:            statusMsg,
+		EmployeeId:        appointment.EmployeeID,
+		Subtotal:          quote.Subtotal,
+		Discount:          quote.Discount,
+		Total:             quote.Total,
+		AppliedPromotions: toProtoAppliedPromotions(quote.Promotions),
+	}, nil
 }
 
 func (h *AppointmentGrpcHandler) GetAppointmentsByCustomer(ctx context.Context, req *pb.GetAppointmentsByCustomerRequest) (*pb.GetAppointmentsResponse, error) {
@@ -90,16 +129,129 @@ func (h *AppointmentGrpcHandler) GetAppointmentsByBranch(ctx context.Context, re
 }
 
 func (h *AppointmentGrpcHandler) UpdateAppointmentStatus(ctx context.Context, req *pb.UpdateAppointmentStatusRequest) (*pb.UpdateAppointmentStatusResponse, error) {
-	statusMsg, err := h.appointmentService.UpdateAppointmentStatus(ctx, req.AppointmentId, fromPbAppointmentStatus(req.Status))
+	if req.CreateExamination && req.Status != pb.AppointmentStatus_COMPLETED {
+		return nil, status.Error(codes.InvalidArgument, "create_examination is only allowed when completing an appointment")
+	}
+	fee, statusMsg, err := h.appointmentService.UpdateAppointmentStatus(ctx, req.AppointmentId, fromPbAppointmentStatus(req.Status), req.Reason, req.ChangedBy, req.CustomerId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.UpdateAppointmentStatusResponse{Status: statusMsg, CancellationFee: fee}
+	if req.CreateExamination {
+		// Lịch hẹn đã hoàn thành, lỗi tạo phiếu khám chỉ ghi log
+		ids, err := h.appointmentService.CreateAppointmentExaminations(ctx, req.AppointmentId)
+		if err != nil {
+			log.Printf("Failed to create examinations for appointment %d: %v", req.AppointmentId, err)
+		}
+		resp.ExaminationIds = ids
 	}
-	return &pb.UpdateAppointmentStatusResponse{Status: statusMsg}, nil
+	return resp, nil
+}
+
+func (h *AppointmentGrpcHandler) RescheduleAppointment(ctx context.Context, req *pb.RescheduleAppointmentRequest) (*pb.RescheduleAppointmentResponse, error) {
+	if req.ScheduledTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "scheduled_time is required")
+	}
+	appointment, statusMsg, err := h.appointmentService.RescheduleAppointment(ctx, req.AppointmentId, req.CustomerId, req.ScheduledTime.AsTime(), req.BranchId)
+	if err != nil {
+		// Lỗi lấy lịch mở cửa chi nhánh từ Products service giữ nguyên mã gRPC
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, toGrpcError(err)
+	}
+	return &pb.RescheduleAppointmentResponse{Appointment: toProtoAppointment(appointment), Status: statusMsg}, nil
+}
+
+// --- LỊCH HẸN ĐỊNH KỲ ---
+func (h *AppointmentGrpcHandler) CreateAppointmentSeries(ctx context.Context, req *pb.CreateAppointmentSeriesRequest) (*pb.CreateAppointmentSeriesResponse, error) {
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time is required")
+	}
+	series := &AppointmentSeries{
+		CustomerID:      req.CustomerId,
+		EmployeeID:      req.EmployeeId,
+		BranchID:        req.BranchId,
+		CustomerAddress: req.CustomerAddress,
+		Note:            req.Note,
+		Frequency:       fromPbFrequency(req.Frequency),
+		Interval:        req.Interval,
+		StartTime:       req.StartTime.AsTime(),
+		UntilDate:       req.UntilDate,
+		Count:           req.Count,
+	}
+	for _, d := range req.Detail {
+		series.Services = append(series.Services, SeriesService{ServiceID: d.ServiceId, PetID: d.PetId, Quantity: d.Quantity})
+	}
+	for _, petID := range req.PetIds {
+		series.Pets = append(series.Pets, SeriesPet{PetID: petID})
+	}
+	appointments, skipped, err := h.appointmentService.CreateAppointmentSeries(ctx, series)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, toGrpcError(err)
+	}
+	return &pb.CreateAppointmentSeriesResponse{
+		Series:       toProtoAppointmentSeries(series),
+		Appointments: toProtoAppointments(appointments),
+		Skipped:      toProtoSkippedOccurrences(skipped),
+		Status:       "Success",
+	}, nil
+}
+
+func (h *AppointmentGrpcHandler) GetAppointmentSeries(ctx context.Context, req *pb.GetAppointmentSeriesRequest) (*pb.GetAppointmentSeriesResponse, error) {
+	series, appointments, err := h.appointmentService.GetAppointmentSeries(ctx, req.SeriesId, req.CustomerId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.GetAppointmentSeriesResponse{Series: toProtoAppointmentSeries(series), Appointments: toProtoAppointments(appointments)}, nil
+}
+
+func (h *AppointmentGrpcHandler) UpdateAppointmentSeries(ctx context.Context, req *pb.UpdateAppointmentSeriesRequest) (*pb.UpdateAppointmentSeriesResponse, error) {
+	var scheduledTime time.Time
+	if req.ScheduledTime != nil {
+		scheduledTime = req.ScheduledTime.AsTime()
+	}
+	series, appointments, skipped, err := h.appointmentService.UpdateAppointmentSeries(ctx, req.SeriesId, req.CustomerId, scheduledTime, req.EmployeeId)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, toGrpcError(err)
+	}
+	return &pb.UpdateAppointmentSeriesResponse{
+		Series:       toProtoAppointmentSeries(series),
+		Appointments: toProtoAppointments(appointments),
+		Skipped:      toProtoSkippedOccurrences(skipped),
+		Status:       "Success",
+	}, nil
+}
+
+func (h *AppointmentGrpcHandler) CancelAppointmentSeries(ctx context.Context, req *pb.CancelAppointmentSeriesRequest) (*pb.CancelAppointmentSeriesResponse, error) {
+	cancelled, fee, statusMsg, err := h.appointmentService.CancelAppointmentSeries(ctx, req.SeriesId, req.CustomerId, req.Reason, req.ChangedBy)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CancelAppointmentSeriesResponse{Status: statusMsg, CancelledAppointmentIds: cancelled, CancellationFee: fee}, nil
+}
+
+func (h *AppointmentGrpcHandler) GetAppointmentStatusHistory(ctx context.Context, req *pb.GetAppointmentStatusHistoryRequest) (*pb.GetAppointmentStatusHistoryResponse, error) {
+	history, err := h.appointmentService.GetAppointmentStatusHistory(ctx, req.AppointmentId, req.CustomerId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.GetAppointmentStatusHistoryResponse{}
+	for i := range history {
+		resp.History = append(resp.History, toProtoStatusChange(&history[i]))
+	}
+	return resp, nil
 }
 func (h *AppointmentGrpcHandler) UpdateEmployeeForAppointment(ctx context.Context, req *pb.UpdateEmployeeForAppointmentRequest) (*pb.UpdateEmployeeForAppointmentResponse, error) {
 	statusMsg, err := h.appointmentService.UpdateEmployeeForAppointment(ctx, req.AppointmentId, req.EmployeeId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.UpdateEmployeeForAppointmentResponse{Status: statusMsg}, nil
 }
@@ -124,7 +276,7 @@ func (h *AppointmentGrpcHandler) GetAppointmentDetails(ctx context.Context, req
 
 // --- DỊCH VỤ ---
 func (h *AppointmentGrpcHandler) CreateService(ctx context.Context, req *pb.CreateServiceRequest) (*pb.CreateServiceResponse, error) {
-	serviceID, statusMsg, err := h.appointmentService.CreateService(ctx, req.Name, req.Description, req.Price)
+	serviceID, statusMsg, err := h.appointmentService.CreateService(ctx, req.Name, req.Description, req.Price, req.Imgurl, req.DurationMinutes)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -143,8 +295,13 @@ func (h *AppointmentGrpcHandler) GetServices(ctx context.Context, req *pb.GetSer
 	return &pb.GetServicesResponse{Services: pbServices}, nil
 }
 
+func (h *AppointmentGrpcHandler) GetCacheStats(ctx context.Context, req *pb.GetCacheStatsRequest) (*pb.CacheStats, error) {
+	stats := h.serviceCache.Stats()
+	return &pb.CacheStats{Hits: stats.Hits, Misses: stats.Misses, Errors: stats.Errors, HitRatio: stats.HitRatio}, nil
+}
+
 func (h *AppointmentGrpcHandler) UpdateService(ctx context.Context, req *pb.UpdateServiceRequest) (*pb.UpdateServiceResponse, error) {
-	statusMsg, err := h.appointmentService.UpdateService(ctx, req.ServiceId, req.Name, req.Description, req.Price)
+	statusMsg, err := h.appointmentService.UpdateService(ctx, req.ServiceId, req.Name, req.Description, req.Price, req.Imgurl, req.DurationMinutes)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -154,32 +311,457 @@ func (h *AppointmentGrpcHandler) UpdateService(ctx context.Context, req *pb.Upda
 func (h *AppointmentGrpcHandler) DeleteService(ctx context.Context, req *pb.DeleteServiceRequest) (*pb.DeleteServiceResponse, error) {
 	statusMsg, err := h.appointmentService.DeleteService(ctx, req.ServiceId)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "service %d not found", req.ServiceId)
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 	return &pb.DeleteServiceResponse{Status: statusMsg}, nil
 }
+
+func (h *AppointmentGrpcHandler) RestoreService(ctx context.Context, req *pb.RestoreServiceRequest) (*pb.RestoreServiceResponse, error) {
+	statusMsg, err := h.appointmentService.RestoreService(ctx, req.ServiceId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "service %d not found", req.ServiceId)
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &pb.RestoreServiceResponse{Status: statusMsg}, nil
+}
+
+func (h *AppointmentGrpcHandler) ListArchivedServices(ctx context.Context, req *pb.GetServicesRequest) (*pb.GetServicesResponse, error) {
+	services, err := h.appointmentService.ListArchivedServices(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	pbServices := make([]*pb.Service, len(services))
+	for i, s := range services {
+		pbServices[i] = toProtoService(&s)
+	}
+	return &pb.GetServicesResponse{Services: pbServices}, nil
+}
 func (h *AppointmentGrpcHandler) GetAllAppointments(ctx context.Context, req *pb.GetAllAppointmentsRequest) (*pb.GetAllAppointmentsResponse, error) {
 	appointments, err := h.appointmentService.GetAllAppointments(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	pbAppointments := make([]*pb.AppointmentWithCustomerName, len(appointments))
-	for i, a := range appointments {
-		customer, err := h.userClient.GetUserInfo(ctx, &pbUser.GetUserInfoRequest{ID: a.CustomerID})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get customer info: %v", err)
+	// Tra khách hàng và nhân viên một lần cho cả danh sách; lịch chưa phân công (EmployeeID 0) để trống tên nhân viên
+	var userIDs []int32
+	seen := make(map[int32]bool)
+	for _, a := range appointments {
+		for _, id := range []int32{a.CustomerID, a.EmployeeID} {
+			if id > 0 && !seen[id] {
+				seen[id] = true
+				userIDs = append(userIDs, id)
+			}
 		}
-		employee, err := h.userClient.GetUserInfo(ctx, &pbUser.GetUserInfoRequest{ID: a.EmployeeID})
+	}
+	users := make(map[int32]*pbUser.User, len(userIDs))
+	if len(userIDs) > 0 {
+		resp, err := h.userClient.GetUsersByIDs(ctx, &pbUser.GetUsersByIDsRequest{IDs: userIDs})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get employee info: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to get users info: %v", err)
 		}
-		pbAppointments[len(appointments)-1-i] = &pb.AppointmentWithCustomerName{
-			Appointment:   toProtoAppointment(&a),
-			CustomerName:  customer.Name,
-			CustomerEmail: customer.Email,
-			EmployeeEmail: employee.Email,
-			EmployeeName:  employee.Name,
+		for _, u := range resp.Users {
+			users[u.ID] = u
 		}
 	}
+	pbAppointments := make([]*pb.AppointmentWithCustomerName, len(appointments))
+	for i, a := range appointments {
+		item := &pb.AppointmentWithCustomerName{Appointment: toProtoAppointment(&a)}
+		if customer, ok := users[a.CustomerID]; ok {
+			item.CustomerName = customer.Name
+			item.CustomerEmail = customer.Email
+		}
+		if employee, ok := users[a.EmployeeID]; ok {
+			item.EmployeeName = employee.Name
+			item.EmployeeEmail = employee.Email
+		}
+		pbAppointments[len(appointments)-1-i] = item
+	}
 	return &pb.GetAllAppointmentsResponse{Appointments: pbAppointments}, nil
 }
+
+func (h *AppointmentGrpcHandler) GetEmployeeAgenda(ctx context.Context, req *pb.GetEmployeeAgendaRequest) (*pb.GetEmployeeAgendaResponse, error) {
+	entries, loc, err := h.appointmentService.GetEmployeeAgenda(ctx, req.EmployeeId, req.FromDate, req.ToDate)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	pbAppointments := make([]*pb.AgendaAppointment, len(entries))
+	for i := range entries {
+		pbAppointments[i] = toProtoAgendaAppointment(&entries[i])
+	}
+	return &pb.GetEmployeeAgendaResponse{Appointments: pbAppointments, Timezone: loc.String()}, nil
+}
+
+func (h *AppointmentGrpcHandler) GetEmployeeRoute(ctx context.Context, req *pb.GetEmployeeRouteRequest) (*pb.GetEmployeeRouteResponse, error) {
+	route, err := h.appointmentService.GetEmployeeRoute(ctx, req.EmployeeId, req.Date)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	stops := make([]*pb.RouteStop, len(route.Stops))
+	for i, stop := range route.Stops {
+		stops[i] = &pb.RouteStop{
+			Appointment:   toProtoAppointment(&stop.Appointment),
+			Latitude:      stop.Point.Lat,
+			Longitude:     stop.Point.Lng,
+			DistanceKm:    stop.DistanceKm,
+			TravelMinutes: int32(stop.Travel / time.Minute),
+		}
+	}
+	return &pb.GetEmployeeRouteResponse{
+		Stops:               stops,
+		TotalDistanceKm:     route.DistanceKm,
+		TotalTravelMinutes:  int32(route.Travel / time.Minute),
+		ScheduledDistanceKm: route.ScheduledDistanceKm,
+		Unlocated:           toProtoAppointments(route.Unlocated),
+		StartsAtBranch:      route.Start != nil,
+		Timezone:            route.Location.String(),
+	}, nil
+}
+
+// --- ẢNH DỊCH VỤ ---
+func (h *AppointmentGrpcHandler) AddServiceImage(ctx context.Context, req *pb.AddServiceImageRequest) (*pb.ServiceImage, error) {
+	images, err := h.appointmentService.AddServiceImages(ctx, []ServiceImage{fromProtoAddServiceImage(req)})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoServiceImage(&images[0]), nil
+}
+
+func (h *AppointmentGrpcHandler) AddServiceImages(ctx context.Context, req *pb.AddServiceImagesRequest) (*pb.ListServiceImagesResponse, error) {
+	images := make([]ServiceImage, len(req.Images))
+	for i, img := range req.Images {
+		images[i] = fromProtoAddServiceImage(img)
+	}
+	images, err := h.appointmentService.AddServiceImages(ctx, images)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	pbImages := make([]*pb.ServiceImage, len(images))
+	for i := range images {
+		pbImages[i] = toProtoServiceImage(&images[i])
+	}
+	return &pb.ListServiceImagesResponse{Images: pbImages}, nil
+}
+
+func fromProtoAddServiceImage(req *pb.AddServiceImageRequest) ServiceImage {
+	return ServiceImage{
+		ServiceID:    req.ServiceId,
+		URL:          req.Url,
+		ThumbnailURL: req.ThumbnailUrl,
+		StorageKey:   req.StorageKey,
+		ThumbnailKey: req.ThumbnailKey,
+		IsPrimary:    req.IsPrimary,
+	}
+}
+
+func (h *AppointmentGrpcHandler) ListServiceImages(ctx context.Context, req *pb.ListServiceImagesRequest) (*pb.ListServiceImagesResponse, error) {
+	images, err := h.appointmentService.ListServiceImages(ctx, req.ServiceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	pbImages := make([]*pb.ServiceImage, len(images))
+	for i, img := range images {
+		pbImages[i] = toProtoServiceImage(&img)
+	}
+	return &pb.ListServiceImagesResponse{Images: pbImages}, nil
+}
+
+func (h *AppointmentGrpcHandler) DeleteServiceImage(ctx context.Context, req *pb.DeleteServiceImageRequest) (*pb.ServiceImage, error) {
+	image, err := h.appointmentService.DeleteServiceImage(ctx, req.ImageId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "image %d not found", req.ImageId)
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return toProtoServiceImage(image), nil
+}
+
+// toGrpcError chuyển lỗi nghiệp vụ sang mã gRPC tương ứng
+func toGrpcError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrInvalidState), errors.Is(err, ErrServiceArchived), errors.Is(err, ErrSlotUnavailable),
+		errors.Is(err, ErrSeriesInactive), errors.Is(err, ErrOccurrenceExists):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrBookingBusy):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// parseOptionalTime - value rỗng trả về nil
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s, must be RFC3339: %v", field, err)
+	}
+	return &t, nil
+}
+
+// --- LỊCH SỬ GIÁ DỊCH VỤ ---
+func (h *AppointmentGrpcHandler) ScheduleServicePriceChange(ctx context.Context, req *pb.ScheduleServicePriceChangeRequest) (*pb.ServicePriceChange, error) {
+	effectiveAt, err := parseOptionalTime("effective_at", req.EffectiveAt)
+	if err != nil {
+		return nil, err
+	}
+	if effectiveAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_at is required")
+	}
+	change, err := h.appointmentService.ScheduleServicePriceChange(ctx, &ServicePriceChange{
+		ServiceID:   req.ServiceId,
+		Price:       req.Price,
+		EffectiveAt: *effectiveAt,
+		CreatedBy:   req.CreatedBy,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoServicePriceChange(change), nil
+}
+
+func (h *AppointmentGrpcHandler) CancelServicePriceChange(ctx context.Context, req *pb.CancelServicePriceChangeRequest) (*pb.CancelServicePriceChangeResponse, error) {
+	statusMsg, err := h.appointmentService.CancelServicePriceChange(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CancelServicePriceChangeResponse{Status: statusMsg}, nil
+}
+
+func (h *AppointmentGrpcHandler) ListServicePriceHistory(ctx context.Context, req *pb.ListServicePriceHistoryRequest) (*pb.ListServicePriceHistoryResponse, error) {
+	changes, current, err := h.appointmentService.ListServicePriceHistory(ctx, req.ServiceId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListServicePriceHistoryResponse{CurrentPrice: current}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, toProtoServicePriceChange(&c))
+	}
+	return resp, nil
+}
+
+func (h *AppointmentGrpcHandler) GetServicePriceAt(ctx context.Context, req *pb.GetServicePriceAtRequest) (*pb.GetServicePriceAtResponse, error) {
+	at, err := parseOptionalTime("at", req.At)
+	if err != nil {
+		return nil, err
+	}
+	if at == nil {
+		now := time.Now()
+		at = &now
+	}
+	price, err := h.appointmentService.GetServicePriceAt(ctx, req.ServiceId, *at)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.GetServicePriceAtResponse{Price: price}, nil
+}
+
+// --- KHUNG GIỜ TRỐNG & CA LÀM VIỆC ---
+func (h *AppointmentGrpcHandler) GetAvailableSlots(ctx context.Context, req *pb.GetAvailableSlotsRequest) (*pb.GetAvailableSlotsResponse, error) {
+	availability, err := h.appointmentService.GetAvailableSlots(ctx, req.BranchId, req.ServiceIds, req.Date, req.CustomerAddress)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.GetAvailableSlotsResponse{
+		Timezone:        availability.Timezone,
+		DurationMinutes: int32(availability.Duration / time.Minute),
+	}
+	for _, slot := range availability.Slots {
+		resp.Slots = append(resp.Slots, &pb.AvailableSlot{
+			StartTime:            slot.Start.Format(time.RFC3339),
+			EndTime:              slot.End.Format(time.RFC3339),
+			RemainingCapacity:    slot.RemainingCapacity,
+			AvailableEmployeeIds: slot.EmployeeIDs,
+		})
+	}
+	return resp, nil
+}
+
+func (h *AppointmentGrpcHandler) CreateEmployeeShift(ctx context.Context, req *pb.CreateEmployeeShiftRequest) (*pb.EmployeeShift, error) {
+	start, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_time, must be RFC3339: %v", err)
+	}
+	end, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end_time, must be RFC3339: %v", err)
+	}
+	shift, err := h.appointmentService.CreateEmployeeShift(ctx, &EmployeeShift{
+		EmployeeID: req.EmployeeId,
+		BranchID:   req.BranchId,
+		StartTime:  start,
+		EndTime:    end,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoEmployeeShift(shift), nil
+}
+
+func (h *AppointmentGrpcHandler) ListEmployeeShifts(ctx context.Context, req *pb.ListEmployeeShiftsRequest) (*pb.ListEmployeeShiftsResponse, error) {
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from, must be RFC3339: %v", err)
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to, must be RFC3339: %v", err)
+	}
+	shifts, err := h.appointmentService.ListEmployeeShifts(ctx, req.BranchId, req.EmployeeId, from, to)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListEmployeeShiftsResponse{}
+	for _, shift := range shifts {
+		resp.Shifts = append(resp.Shifts, toProtoEmployeeShift(&shift))
+	}
+	return resp, nil
+}
+
+func (h *AppointmentGrpcHandler) DeleteEmployeeShift(ctx context.Context, req *pb.DeleteEmployeeShiftRequest) (*pb.DeleteEmployeeShiftResponse, error) {
+	statusMsg, reassignments, err := h.appointmentService.DeleteEmployeeShift(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.DeleteEmployeeShiftResponse{Status: statusMsg, Reassignments: toProtoReassignments(reassignments)}, nil
+}
+
+// --- LỊCH LÀM VIỆC CỐ ĐỊNH ---
+func (h *AppointmentGrpcHandler) CreateShiftTemplate(ctx context.Context, req *pb.CreateShiftTemplateRequest) (*pb.ShiftTemplate, error) {
+	template, err := h.appointmentService.CreateShiftTemplate(ctx, &ShiftTemplate{
+		EmployeeID: req.EmployeeId,
+		BranchID:   req.BranchId,
+		Weekday:    req.Weekday,
+		StartClock: req.StartTime,
+		EndClock:   req.EndTime,
+		ValidFrom:  req.ValidFrom,
+		ValidUntil: req.ValidUntil,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoShiftTemplate(template), nil
+}
+
+func (h *AppointmentGrpcHandler) ListShiftTemplates(ctx context.Context, req *pb.ListShiftTemplatesRequest) (*pb.ListShiftTemplatesResponse, error) {
+	templates, err := h.appointmentService.ListShiftTemplates(ctx, req.BranchId, req.EmployeeId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListShiftTemplatesResponse{}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toProtoShiftTemplate(&t))
+	}
+	return resp, nil
+}
+
+func (h *AppointmentGrpcHandler) DeleteShiftTemplate(ctx context.Context, req *pb.DeleteShiftTemplateRequest) (*pb.DeleteShiftTemplateResponse, error) {
+	statusMsg, reassignments, err := h.appointmentService.DeleteShiftTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.DeleteShiftTemplateResponse{Status: statusMsg, Reassignments: toProtoReassignments(reassignments)}, nil
+}
+
+func (h *AppointmentGrpcHandler) GenerateShifts(ctx context.Context, req *pb.GenerateShiftsRequest) (*pb.GenerateShiftsResponse, error) {
+	created, err := h.appointmentService.GenerateShiftsFromTemplates(ctx, req.BranchId, req.FromDate, req.ToDate)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, toGrpcError(err)
+	}
+	return &pb.GenerateShiftsResponse{Created: int32(created)}, nil
+}
+
+// --- NGHỈ PHÉP ---
+func (h *AppointmentGrpcHandler) RequestLeave(ctx context.Context, req *pb.RequestLeaveRequest) (*pb.EmployeeLeave, error) {
+	start, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_time, must be RFC3339: %v", err)
+	}
+	end, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end_time, must be RFC3339: %v", err)
+	}
+	leave, err := h.appointmentService.RequestLeave(ctx, &EmployeeLeave{
+		EmployeeID: req.EmployeeId,
+		StartTime:  start,
+		EndTime:    end,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoEmployeeLeave(leave), nil
+}
+
+func (h *AppointmentGrpcHandler) ListLeaves(ctx context.Context, req *pb.ListLeavesRequest) (*pb.ListLeavesResponse, error) {
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from, must be RFC3339: %v", err)
+	}
+	to, err := time.Parse(time.RFC3339, req.To)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to, must be RFC3339: %v", err)
+	}
+	leaves, err := h.appointmentService.ListLeaves(ctx, req.EmployeeId, LeaveStatus(req.Status), from, to)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListLeavesResponse{}
+	for _, l := range leaves {
+		resp.Leaves = append(resp.Leaves, toProtoEmployeeLeave(&l))
+	}
+	return resp, nil
+}
+
+func (h *AppointmentGrpcHandler) ReviewLeave(ctx context.Context, req *pb.ReviewLeaveRequest) (*pb.ReviewLeaveResponse, error) {
+	leave, affected, err := h.appointmentService.ReviewLeave(ctx, req.Id, req.Approve, req.ReviewerId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.ReviewLeaveResponse{Leave: toProtoEmployeeLeave(leave), AffectedAppointmentIds: affected}, nil
+}
+
+// --- PHÂN CÔNG TỰ ĐỘNG ---
+func (h *AppointmentGrpcHandler) AutoAssignAppointment(ctx context.Context, req *pb.AutoAssignAppointmentRequest) (*pb.AutoAssignAppointmentResponse, error) {
+	employeeID, statusMsg, err := h.appointmentService.AutoAssignAppointment(ctx, req.AppointmentId)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, toGrpcError(err)
+	}
+	return &pb.AutoAssignAppointmentResponse{EmployeeId: employeeID, Status: statusMsg}, nil
+}
+
+func (h *AppointmentGrpcHandler) SetEmployeeSkills(ctx context.Context, req *pb.SetEmployeeSkillsRequest) (*pb.SetEmployeeSkillsResponse, error) {
+	statusMsg, err := h.appointmentService.SetEmployeeSkills(ctx, req.EmployeeId, req.ServiceIds)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.SetEmployeeSkillsResponse{Status: statusMsg}, nil
+}
+
+func (h *AppointmentGrpcHandler) ListEmployeeSkills(ctx context.Context, req *pb.ListEmployeeSkillsRequest) (*pb.ListEmployeeSkillsResponse, error) {
+	serviceIDs, err := h.appointmentService.ListEmployeeSkills(ctx, req.EmployeeId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.ListEmployeeSkillsResponse{ServiceIds: serviceIDs}, nil
+}
@@ -1,16 +1,24 @@
 package main
 
 import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"github.com/quanbin27/commons/cache"
 	"github.com/quanbin27/commons/config"
+	pbProduct "github.com/quanbin27/commons/genproto/products"
+	pbRecord "github.com/quanbin27/commons/genproto/records"
+	pbUser "github.com/quanbin27/commons/genproto/users"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"log"
 	"net"
+	"time"
 )
 
 func NewMySQLStorage(dsn string) (*gorm.DB, error) {
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -36,17 +44,68 @@ func main() {
 		log.Fatal(err)
 	}
 	initStorage(db)
-	db.AutoMigrate(Appointment{}, AppointmentDetail{}, Service{})
+	if err := migrate(db); err != nil {
+		log.Fatal(err)
+	}
 	grpcServer := grpc.NewServer()
 	l, err := net.Listen("tcp", grpcAddr)
 	if err != nil {
 		log.Fatal("failed to listen")
 	}
 	defer l.Close()
-	appointmentStore := NewStore(db)
-	standardPriceStrategy := &StandardPriceStrategy{}
-	appointmentService := NewAppointmentService(appointmentStore, standardPriceStrategy)
-	NewAppointmentGrpcHandler(grpcServer, appointmentService)
+	redisClient := redis.NewClient(&redis.Options{Addr: config.Envs.RedisAddr})
+	serviceCache := cache.New(redisClient, "appointments:")
+	appointmentStore := newCachedStore(NewStore(db), serviceCache)
+	productsConn, err := grpc.NewClient(config.Envs.ProductsGrpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to dial product server: %v", err)
+	}
+	// Giá lịch hẹn được tính qua promotion engine của Products service
+	productClient := pbProduct.NewProductServiceClient(productsConn)
+	priceStrategy := NewPromotionPriceStrategy(productClient)
+	assigner, err := NewAssignmentStrategy(config.Envs.AssignmentStrategy, appointmentStore)
+	if err != nil {
+		log.Fatal(err)
+	}
+	reminderOffsets, err := parseReminderOffsets(config.Envs.AppointmentReminderOffsets)
+	if err != nil {
+		log.Fatal(err)
+	}
+	policy := BookingPolicy{
+		CancelDeadline:       time.Duration(config.Envs.AppointmentCancelDeadlineHours) * time.Hour,
+		LateCancelFeePercent: float32(config.Envs.AppointmentLateCancelFeePercent),
+		MaxReschedules:       int32(config.Envs.AppointmentMaxReschedules),
+		ReminderOffsets:      reminderOffsets,
+		TravelSpeedKmh:       float64(config.Envs.HomeVisitSpeedKmh),
+	}
+	if policy.TravelSpeedKmh > 0 && (config.Envs.Geocoder == "stub" || config.Envs.Geocoder == "") {
+		// Toạ độ của stub geocoder là giả lập, không dùng để chặn lịch theo thời gian di chuyển
+		log.Println("HOME_VISIT_SPEED_KMH is ignored with the stub geocoder, travel time between home visits is not enforced")
+		policy.TravelSpeedKmh = 0
+	}
+	usersConn, err := grpc.NewClient(config.Envs.UsersGrpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to dial user server: %v", err)
+	}
+	// Thông báo lịch hẹn gửi qua Kafka cho Notification service
+	userClient := pbUser.NewUserServiceClient(usersConn)
+	notifier := NewKafkaNotifier(config.Envs.KafkaAddr, userClient)
+	recordsConn, err := grpc.NewClient(config.Envs.RecordsGrpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to dial records server: %v", err)
+	}
+	// Thú cưng của lịch hẹn và phiếu khám khi hoàn thành nằm ở Records service
+	recordsClient := pbRecord.NewPetRecordServiceClient(recordsConn)
+	geocoder, err := NewGeocoder(config.Envs.Geocoder, config.Envs.GeocoderURL, serviceCache)
+	if err != nil {
+		log.Fatal(err)
+	}
+	appointmentService := NewAppointmentService(appointmentStore, priceStrategy, productClient, assigner, policy, notifier, recordsClient, userClient, geocoder)
+	NewAppointmentGrpcHandler(grpcServer, appointmentService, serviceCache)
+	go runPriceScheduler(context.Background(), appointmentService, priceSweepInterval)
+	go runShiftPlanner(context.Background(), appointmentService, shiftPlannerInterval)
+	go runReminderScheduler(context.Background(), appointmentService, reminderInterval)
+	go runSeriesPlanner(context.Background(), appointmentService, seriesPlannerInterval)
 	log.Println("Appointment Service Listening on", grpcAddr)
 	grpcServer.Serve(l)
 }
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Migration của Appointments Service. AutoMigrate chỉ thêm bảng, cột và index còn thiếu: không đổi khoá chính
+// và không biến index thường thành unique, nên các thay đổi đó được làm tay quanh AutoMigrate.
+// Mỗi bước kiểm tra trạng thái hiện tại trước nên chạy lại nhiều lần vẫn an toàn.
+
+func migrate(db *gorm.DB) error {
+	if err := migrateSeriesRefs(db); err != nil {
+		return err
+	}
+	if err := migrateDetailPets(db); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(Appointment{}, AppointmentDetail{}, Service{}, ServiceImage{}, AppointmentPromotion{}, ServicePriceChange{},
+		EmployeeShift{}, ShiftTemplate{}, EmployeeLeave{}, EmployeeSkill{}, AppointmentStatusHistory{}, AppointmentReminder{},
+		AppointmentSeries{}, SeriesService{}, SeriesPet{}, AppointmentPet{}); err != nil {
+		return err
+	}
+	// Cùng dịch vụ cho nhiều thú cưng: bảng tạo trước đó vẫn giữ khoá (appointment_id, service_id)
+	if err := ensurePrimaryKey(db, &AppointmentDetail{}, "appointment_id", "service_id", "pet_id"); err != nil {
+		return err
+	}
+	return ensurePrimaryKey(db, &SeriesService{}, "series_id", "service_id", "pet_id")
+}
+
+// ensurePrimaryKey tạo lại khoá chính của bảng thành columns nếu khoá hiện tại khác.
+// Drop và add trong cùng một câu ALTER TABLE nên bảng không lúc nào mất khoá.
+func ensurePrimaryKey(db *gorm.DB, model interface{}, columns ...string) error {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return err
+	}
+	table := stmt.Schema.Table
+	var current []string
+	if err := db.Raw(`SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY'
+		ORDER BY ORDINAL_POSITION`, table).Scan(&current).Error; err != nil {
+		return err
+	}
+	if strings.Join(current, ",") == strings.Join(columns, ",") {
+		return nil
+	}
+	return db.Exec(fmt.Sprintf("ALTER TABLE `%s` DROP PRIMARY KEY, ADD PRIMARY KEY (`%s`)", table, strings.Join(columns, "`, `"))).Error
+}
+
+// migrateDetailPets đổi pet_id NULL của appointment_details thành rỗng, để AutoMigrate chuyển được cột
+// sang NOT NULL trước khi nó vào khoá chính.
+func migrateDetailPets(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&AppointmentDetail{}) || !m.HasColumn(&AppointmentDetail{}, "PetID") {
+		return nil
+	}
+	return db.Model(&AppointmentDetail{}).Where("pet_id IS NULL").Update("pet_id", "").Error
+}
+
+// migrateSeriesRefs bỏ index thường cũ của appointments.series_id, cho phép cột NULL và đổi 0 (lịch đặt lẻ) thành NULL,
+// để AutoMigrate tạo được unique index (series_id, series_index) (xem SeriesRef). Lần lặp trùng có sẵn phải sửa tay trước.
+func migrateSeriesRefs(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&Appointment{}) || !m.HasIndex(&Appointment{}, "idx_appointments_series_id") {
+		return nil
+	}
+	if err := m.AlterColumn(&Appointment{}, "SeriesID"); err != nil {
+		return err
+	}
+	if err := db.Model(&Appointment{}).Where("series_id = ?", 0).Update("series_id", nil).Error; err != nil {
+		return err
+	}
+	return m.DropIndex(&Appointment{}, "idx_appointments_series_id")
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	pbUser "github.com/quanbin27/commons/genproto/users"
+	"github.com/segmentio/kafka-go"
+)
+
+// Thông báo lịch hẹn đi qua Kafka, Notification service đọc và gửi email.
+// Email người nhận được tra qua Users service ngay lúc gửi nên sự kiện tự đủ thông tin cho bên đọc.
+
+const (
+	topicAppointmentRescheduled = "appointment.rescheduled"
+	topicAppointmentReminder    = "appointment.reminder"
+)
+
+// AppointmentNotifier gửi thông báo về lịch hẹn; lỗi gửi chỉ ghi log, không làm hỏng thao tác trên lịch hẹn
+type AppointmentNotifier interface {
+	// loc - múi giờ chi nhánh để hiển thị giờ hẹn
+	AppointmentRescheduled(appointment *Appointment, previous *Appointment, services []NotifiedService, loc *time.Location)
+	// AppointmentReminder gửi đồng bộ và trả lỗi để bộ nhắc lịch thử lại ở lần quét sau
+	AppointmentReminder(ctx context.Context, appointment *Appointment, remindBefore time.Duration, loc *time.Location) error
+}
+
+// NotifiedService - dịch vụ của lịch hẹn hiển thị trong thông báo
+type NotifiedService struct {
+	Name     string `json:"name"`
+	Quantity int32  `json:"quantity"`
+}
+
+// NotifiedUser - người nhận thông báo
+type NotifiedUser struct {
+	UserID int32  `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	Role   string `json:"role"` // customer | employee
+}
+
+// RescheduledEvent - nội dung sự kiện appointment.rescheduled
+type RescheduledEvent struct {
+	AppointmentID         int32             `json:"appointment_id"`
+	BranchID              int32             `json:"branch_id"`
+	PreviousBranchID      int32             `json:"previous_branch_id"`
+	ScheduledTime         string            `json:"scheduled_time"` // RFC3339 theo giờ chi nhánh
+	PreviousScheduledTime string            `json:"previous_scheduled_time"`
+	EmployeeID            int32             `json:"employee_id"`
+	Services              []NotifiedService `json:"services"`
+	Recipients            []NotifiedUser    `json:"recipients"`
+}
+
+// ReminderEvent - nội dung sự kiện appointment.reminder; danh sách dịch vụ bên đọc lấy qua GetAppointmentDetails
+type ReminderEvent struct {
+	AppointmentID       int32          `json:"appointment_id"`
+	BranchID            int32          `json:"branch_id"`
+	ScheduledTime       string         `json:"scheduled_time"` // RFC3339 theo giờ chi nhánh
+	RemindBeforeMinutes int32          `json:"remind_before_minutes"`
+	Recipients          []NotifiedUser `json:"recipients"`
+}
+
+type kafkaNotifier struct {
+	addr       string
+	writers    map[string]*kafka.Writer
+	userClient pbUser.UserServiceClient
+}
+
+func NewKafkaNotifier(kafkaAddr string, userClient pbUser.UserServiceClient) AppointmentNotifier {
+	n := &kafkaNotifier{addr: kafkaAddr, writers: make(map[string]*kafka.Writer), userClient: userClient}
+	n.writers[topicAppointmentRescheduled] = n.newWriter(topicAppointmentRescheduled)
+	n.writers[topicAppointmentReminder] = n.newWriter(topicAppointmentReminder)
+	return n
+}
+
+func (n *kafkaNotifier) newWriter(topic string) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:                   kafka.TCP(n.addr),
+		Topic:                  topic,
+		Balancer:               &kafka.LeastBytes{},
+		AllowAutoTopicCreation: true,
+	}
+}
+
+// AppointmentRescheduled báo cho khách, nhân viên đang phân công và nhân viên trước đó (nếu bị đổi)
+func (n *kafkaNotifier) AppointmentRescheduled(appointment *Appointment, previous *Appointment, services []NotifiedService, loc *time.Location) {
+	event := RescheduledEvent{
+		AppointmentID:         appointment.ID,
+		BranchID:              appointment.BranchID,
+		PreviousBranchID:      previous.BranchID,
+		ScheduledTime:         appointment.ScheduledTime.In(loc).Format(time.RFC3339),
+		PreviousScheduledTime: previous.ScheduledTime.In(loc).Format(time.RFC3339),
+		EmployeeID:            appointment.EmployeeID,
+		Services:              services,
+	}
+	customerID, employeeIDs := appointment.CustomerID, []int32{appointment.EmployeeID, previous.EmployeeID}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		event.Recipients = n.recipients(ctx, customerID, employeeIDs)
+		if err := n.publish(ctx, topicAppointmentRescheduled, event.AppointmentID, event); err != nil {
+			log.Printf("Failed to publish reschedule of appointment %d: %v", event.AppointmentID, err)
+		}
+	}()
+}
+
+func (n *kafkaNotifier) AppointmentReminder(ctx context.Context, appointment *Appointment, remindBefore time.Duration, loc *time.Location) error {
+	recipients := n.recipients(ctx, appointment.CustomerID, nil)
+	if len(recipients) == 0 {
+		return fmt.Errorf("customer %d has no email", appointment.CustomerID)
+	}
+	event := ReminderEvent{
+		AppointmentID:       appointment.ID,
+		BranchID:            appointment.BranchID,
+		ScheduledTime:       appointment.ScheduledTime.In(loc).Format(time.RFC3339),
+		RemindBeforeMinutes: int32(remindBefore / time.Minute),
+		Recipients:          recipients,
+	}
+	return n.publish(ctx, topicAppointmentReminder, appointment.ID, event)
+}
+
+// recipients tra email của khách và các nhân viên (bỏ qua 0 và trùng lặp)
+func (n *kafkaNotifier) recipients(ctx context.Context, customerID int32, employeeIDs []int32) []NotifiedUser {
+	var users []NotifiedUser
+	seen := make(map[int32]bool)
+	add := func(id int32, role string) {
+		if id <= 0 || seen[id] {
+			return
+		}
+		seen[id] = true
+		user, err := n.userClient.GetUserInfo(ctx, &pbUser.GetUserInfoRequest{ID: id})
+		if err != nil {
+			log.Printf("Failed to get user %d for notification: %v", id, err)
+			return
+		}
+		if user.Email != "" {
+			users = append(users, NotifiedUser{UserID: id, Email: user.Email, Name: user.Name, Role: role})
+		}
+	}
+	add(customerID, "customer")
+	for _, id := range employeeIDs {
+		add(id, "employee")
+	}
+	return users
+}
+
+func (n *kafkaNotifier) publish(ctx context.Context, topic string, appointmentID int32, event interface{}) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	msg := kafka.Message{Key: []byte(strconv.FormatInt(int64(appointmentID), 10)), Value: value}
+	return n.writers[topic].WriteMessages(ctx, msg)
+}
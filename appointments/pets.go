@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	pbRecord "github.com/quanbin27/commons/genproto/records"
+	pbUser "github.com/quanbin27/commons/genproto/users"
+)
+
+// Thú cưng trong lịch hẹn là Pet bên Records service, lịch hẹn chỉ lưu Pet.id.
+// Khi lịch hẹn hoàn thành có thể tạo sẵn phiếu khám (Examination) cho từng thú cưng, bác sĩ bổ sung chẩn đoán sau.
+
+// checkPets chuẩn hoá danh sách thú cưng của lịch hẹn và kiểm tra chúng thuộc khách hàng.
+// PetID của từng dịch vụ được thêm vào danh sách thú cưng nếu chưa có.
+func (s *AppService) checkPets(ctx context.Context, appointment *Appointment, services []AppointmentDetail) error {
+	seen := make(map[string]bool)
+	var pets []AppointmentPet
+	add := func(petID string) error {
+		if petID == "" {
+			return fmt.Errorf("%w: pet ID must not be empty", ErrInvalidArgument)
+		}
+		if !seen[petID] {
+			seen[petID] = true
+			pets = append(pets, AppointmentPet{PetID: petID})
+		}
+		return nil
+	}
+	for _, p := range appointment.Pets {
+		if err := add(strings.TrimSpace(p.PetID)); err != nil {
+			return err
+		}
+	}
+	// Mỗi dòng chi tiết là một dịch vụ cho một thú cưng (PetID rỗng = cả lịch hẹn), khoá (dịch vụ, thú cưng) không được trùng
+	type detailKey struct {
+		serviceID int32
+		petID     string
+	}
+	listed := make(map[detailKey]bool, len(services))
+	for i := range services {
+		services[i].PetID = strings.TrimSpace(services[i].PetID)
+		key := detailKey{services[i].ServiceID, services[i].PetID}
+		if listed[key] {
+			return fmt.Errorf("%w: service %d is listed more than once for the same pet", ErrInvalidArgument, key.serviceID)
+		}
+		listed[key] = true
+		if services[i].PetID == "" {
+			continue
+		}
+		if err := add(services[i].PetID); err != nil {
+			return err
+		}
+	}
+	appointment.Pets = pets
+	if len(pets) == 0 {
+		return nil
+	}
+
+	resp, err := s.recordsClient.ListPets(ctx, &pbRecord.ListPetsRequest{OwnerId: strconv.Itoa(int(appointment.CustomerID))})
+	if err != nil {
+		return err
+	}
+	owned := make(map[string]bool, len(resp.Pets))
+	for _, p := range resp.Pets {
+		owned[p.Id] = true
+	}
+	for _, p := range pets {
+		if !owned[p.PetID] {
+			return fmt.Errorf("%w: pet %s does not belong to customer %d", ErrInvalidArgument, p.PetID, appointment.CustomerID)
+		}
+	}
+	return nil
+}
+
+// CreateAppointmentExaminations tạo phiếu khám cho từng thú cưng của lịch hẹn đã hoàn thành, ghi sẵn ngày hẹn,
+// nhân viên phụ trách và các dịch vụ thú cưng đó dùng. Phiếu nào tạo lỗi chỉ ghi log; trả về ID các phiếu đã tạo.
+func (s *AppService) CreateAppointmentExaminations(ctx context.Context, appointmentID int32) ([]string, error) {
+	appointment, _, err := s.store.GetAppointmentDetails(ctx, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+	if appointment.Status != StatusCompleted {
+		return nil, fmt.Errorf("%w: appointment %d is not completed", ErrInvalidState, appointmentID)
+	}
+	if len(appointment.Pets) == 0 {
+		return nil, nil
+	}
+
+	loc := time.UTC
+	if _, branchLoc, err := s.branchSchedule(ctx, appointment.BranchID); err == nil {
+		loc = branchLoc
+	}
+	var vetID, vetName string
+	if appointment.EmployeeID > 0 {
+		vetID = strconv.Itoa(int(appointment.EmployeeID))
+		if user, err := s.userClient.GetUserInfo(ctx, &pbUser.GetUserInfoRequest{ID: appointment.EmployeeID}); err == nil {
+			vetName = user.Name
+		} else {
+			log.Printf("Failed to get employee %d for appointment %d: %v", appointment.EmployeeID, appointmentID, err)
+		}
+	}
+
+	var ids []string
+	for _, pet := range appointment.Pets {
+		resp, err := s.recordsClient.CreateExamination(ctx, &pbRecord.CreateExaminationRequest{
+			PetId:   pet.PetID,
+			Date:    appointment.ScheduledTime.In(loc).Format("2006-01-02"),
+			VetId:   vetID,
+			VetName: vetName,
+			Notes:   examinationNotes(appointment, pet.PetID),
+		})
+		if err != nil {
+			log.Printf("Failed to create examination for pet %s of appointment %d: %v", pet.PetID, appointmentID, err)
+			continue
+		}
+		ids = append(ids, resp.Id)
+	}
+	return ids, nil
+}
+
+// examinationNotes - ghi chú phiếu khám: mã lịch hẹn, dịch vụ thú cưng dùng (kể cả dịch vụ chung) và ghi chú của khách
+func examinationNotes(appointment *Appointment, petID string) string {
+	var services []string
+	for _, d := range appointment.Details {
+		if d.PetID != "" && d.PetID != petID {
+			continue
+		}
+		services = append(services, fmt.Sprintf("%s x%d", d.Service.Name, d.Quantity))
+	}
+	notes := fmt.Sprintf("Lịch hẹn #%d", appointment.ID)
+	if len(services) > 0 {
+		notes += ": " + strings.Join(services, ", ")
+	}
+	if note := strings.TrimSpace(appointment.Note); note != "" {
+		notes += "\nGhi chú: " + note
+	}
+	return notes
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/quanbin27/commons/pricing"
+)
+
+// Lịch sử giá dịch vụ: mỗi lần đổi giá (sửa trực tiếp hoặc giá hẹn trước) được ghi kèm giá ngay trước đó,
+// nên giá tại một thời điểm tính được từ giá hiện tại và các thay đổi sau thời điểm đó.
+
+const (
+	// priceSweepInterval - Chu kỳ áp dụng các giá hẹn trước đã đến hạn
+	priceSweepInterval = time.Minute
+	priceSweepBatch    = 100
+)
+
+// recordServicePriceChange ghi lịch sử khi giá được sửa trực tiếp. Giá đã được lưu nên lỗi ở đây chỉ ghi log.
+func (s *AppService) recordServicePriceChange(ctx context.Context, serviceID int32, previous, price float32) {
+	if previous == price {
+		return
+	}
+	now := time.Now()
+	change := &ServicePriceChange{ServiceID: serviceID, Price: price, PreviousPrice: previous, EffectiveAt: now, AppliedAt: &now}
+	if err := s.store.CreateServicePriceChange(ctx, change); err != nil {
+		log.Printf("Failed to record price change of service %d: %v", serviceID, err)
+	}
+}
+
+// ScheduleServicePriceChange hẹn giá mới cho dịch vụ, tự áp dụng khi đến EffectiveAt
+func (s *AppService) ScheduleServicePriceChange(ctx context.Context, change *ServicePriceChange) (*ServicePriceChange, error) {
+	if change.Price < 0 {
+		return nil, fmt.Errorf("%w: price must not be negative", ErrInvalidArgument)
+	}
+	if !change.EffectiveAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: effective_at must be in the future", ErrInvalidArgument)
+	}
+	if _, err := s.store.GetServiceByID(ctx, change.ServiceID); err != nil {
+		return nil, err
+	}
+	change.ID = 0
+	change.PreviousPrice = 0
+	change.AppliedAt = nil
+	change.Note = strings.TrimSpace(change.Note)
+	if err := s.store.CreateServicePriceChange(ctx, change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// CancelServicePriceChange huỷ giá hẹn trước chưa áp dụng; lịch sử đã áp dụng không xoá được
+func (s *AppService) CancelServicePriceChange(ctx context.Context, id int32) (string, error) {
+	change, err := s.store.GetServicePriceChangeByID(ctx, id)
+	if err != nil {
+		return "Failed", err
+	}
+	if change.AppliedAt != nil {
+		return "Failed", fmt.Errorf("%w: price change %d was already applied", ErrInvalidState, id)
+	}
+	if err := s.store.DeleteServicePriceChange(ctx, id); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+// ListServicePriceHistory trả về các thay đổi giá (cả giá hẹn trước) và giá đang lưu của dịch vụ
+func (s *AppService) ListServicePriceHistory(ctx context.Context, serviceID int32) ([]ServicePriceChange, float32, error) {
+	service, err := s.store.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	changes, err := s.store.ListServicePriceChanges(ctx, serviceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return changes, service.Price, nil
+}
+
+// GetServicePriceAt trả về giá dịch vụ tại thời điểm at (quá khứ hoặc tương lai)
+func (s *AppService) GetServicePriceAt(ctx context.Context, serviceID int32, at time.Time) (float32, error) {
+	changes, current, err := s.ListServicePriceHistory(ctx, serviceID)
+	if err != nil {
+		return 0, err
+	}
+	return pricing.PriceAt(current, changes, at), nil
+}
+
+// ApplyScheduledServicePrices áp dụng các giá hẹn trước đã đến hạn theo từng lô
+func (s *AppService) ApplyScheduledServicePrices(ctx context.Context) (int, error) {
+	now := time.Now()
+	total := 0
+	for {
+		applied, err := s.store.ApplyDueServicePriceChanges(ctx, now, priceSweepBatch)
+		total += len(applied)
+		if err != nil || len(applied) < priceSweepBatch {
+			return total, err
+		}
+	}
+}
+
+// runPriceScheduler áp dụng định kỳ các giá hẹn trước đã đến hạn cho tới khi ctx bị huỷ
+func runPriceScheduler(ctx context.Context, service AppointmentService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			applied, err := service.ApplyScheduledServicePrices(ctx)
+			if err != nil {
+				log.Printf("Failed to apply scheduled service prices: %v", err)
+			}
+			if applied > 0 {
+				log.Printf("Applied %d scheduled service price changes", applied)
+			}
+		}
+	}
+}
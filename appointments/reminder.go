@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Nhắc lịch hẹn: định kỳ quét các lịch sắp tới và phát sự kiện appointment.reminder cho Notification service.
+// Mỗi lịch chỉ nhận mốc nhắc nhỏ nhất đã đến hạn (đặt lịch sát giờ thì không nhận liền cả nhắc 24h lẫn 2h).
+// Mốc đã gửi được ghi vào AppointmentReminder trước khi phát nên khởi động lại không gửi trùng.
+
+const reminderInterval = time.Minute
+
+// parseReminderOffsets đọc danh sách mốc nhắc dạng "24h,2h", trả về theo thứ tự tăng dần
+func parseReminderOffsets(value string) ([]time.Duration, error) {
+	var offsets []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		offset, err := time.ParseDuration(part)
+		if err != nil || offset < time.Minute {
+			return nil, fmt.Errorf("invalid reminder offset %q, must be a duration of at least 1m", part)
+		}
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets, nil
+}
+
+// dueReminderOffset - mốc nhắc nhỏ nhất đã đến hạn với lịch bắt đầu sau until, false nếu chưa tới mốc nào
+func dueReminderOffset(offsets []time.Duration, until time.Duration) (time.Duration, bool) {
+	for _, offset := range offsets {
+		if until <= offset {
+			return offset, true
+		}
+	}
+	return 0, false
+}
+
+// SendDueReminders phát nhắc lịch cho các lịch đã tới mốc nhắc, trả về số nhắc đã gửi
+func (s *AppService) SendDueReminders(ctx context.Context, now time.Time) (int, error) {
+	offsets := s.policy.ReminderOffsets
+	if len(offsets) == 0 {
+		return 0, nil
+	}
+	appointments, err := s.store.ListUpcomingAppointments(ctx, now, now.Add(offsets[len(offsets)-1]))
+	if err != nil {
+		return 0, err
+	}
+	locations := make(map[int32]*time.Location)
+	sent := 0
+	for i := range appointments {
+		a := &appointments[i]
+		offset, ok := dueReminderOffset(offsets, a.ScheduledTime.Sub(now))
+		if !ok {
+			continue
+		}
+		reminder := &AppointmentReminder{AppointmentID: a.ID, OffsetMinutes: int32(offset / time.Minute), ScheduledTime: a.ScheduledTime}
+		claimed, err := s.store.ClaimReminder(ctx, reminder)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			continue
+		}
+		loc, ok := locations[a.BranchID]
+		if !ok {
+			loc = time.UTC
+			if _, branchLoc, err := s.branchSchedule(ctx, a.BranchID); err == nil {
+				loc = branchLoc
+			}
+			locations[a.BranchID] = loc
+		}
+		if err := s.notifier.AppointmentReminder(ctx, a, offset, loc); err != nil {
+			log.Printf("Failed to send reminder for appointment %d: %v", a.ID, err)
+			if err := s.store.ReleaseReminder(ctx, reminder); err != nil {
+				log.Printf("Failed to release reminder for appointment %d: %v", a.ID, err)
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// runReminderScheduler định kỳ gửi nhắc lịch cho tới khi ctx bị huỷ
+func runReminderScheduler(ctx context.Context, service AppointmentService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := service.SendDueReminders(ctx, time.Now())
+			if err != nil {
+				log.Printf("Failed to send appointment reminders: %v", err)
+			}
+			if sent > 0 {
+				log.Printf("Sent %d appointment reminders", sent)
+			}
+		}
+	}
+}
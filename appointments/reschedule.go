@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RescheduleAppointment dời lịch hẹn chưa bắt đầu sang giờ mới (branchID > 0 thì đổi cả chi nhánh).
+// Chi tiết lịch không đổi nên khách giữ giá lúc đặt (AppointmentDetail.ServicePrice) và thời lượng lúc đặt.
+// Nhân viên đang phân công được giữ nếu vẫn nhận được lịch ở giờ mới, không thì hệ thống tự phân công lại.
+// customerID > 0 là khách tự dời lịch: chỉ dời được lịch của chính mình.
+func (s *AppService) RescheduleAppointment(ctx context.Context, appointmentID, customerID int32, scheduledTime time.Time, branchID int32) (*Appointment, string, error) {
+	appointment, details, err := s.store.GetAppointmentDetails(ctx, appointmentID)
+	if err != nil {
+		return nil, "Failed", err
+	}
+	if customerID > 0 && appointment.CustomerID != customerID {
+		return nil, "Failed", fmt.Errorf("appointment %d: %w", appointmentID, gorm.ErrRecordNotFound)
+	}
+	if !isUpcoming(appointment.Status) {
+		return nil, "Failed", fmt.Errorf("%w: appointment %d is %s", ErrInvalidState, appointmentID, appointment.Status)
+	}
+	if !time.Now().Before(appointment.ScheduledTime) {
+		return nil, "Failed", fmt.Errorf("%w: appointment %d has already started", ErrInvalidState, appointmentID)
+	}
+	if appointment.RescheduleCount >= s.policy.MaxReschedules {
+		return nil, "Failed", fmt.Errorf("%w: appointment %d has already been rescheduled %d times", ErrInvalidState, appointmentID, appointment.RescheduleCount)
+	}
+	if branchID <= 0 {
+		branchID = appointment.BranchID
+	}
+	if branchID == appointment.BranchID && scheduledTime.Equal(appointment.ScheduledTime) {
+		return nil, "Failed", fmt.Errorf("%w: appointment is already scheduled at that time", ErrInvalidArgument)
+	}
+
+	previous := *appointment
+	err = s.withBranchLock(ctx, branchID, func() error {
+		if err := s.placeAppointment(ctx, appointment, details, scheduledTime, branchID, 0); err != nil {
+			return err
+		}
+		appointment.RescheduleCount++
+		return s.store.RescheduleAppointment(ctx, &previous, appointment)
+	})
+	if err != nil {
+		return nil, "Failed", err
+	}
+
+	loc := time.UTC
+	if _, branchLoc, err := s.branchSchedule(ctx, branchID); err == nil {
+		loc = branchLoc
+	}
+	s.notifier.AppointmentRescheduled(appointment, &previous, s.notifiedServices(ctx, details), loc)
+	return appointment, "Success", nil
+}
+
+// placeAppointment xếp lịch sang giờ/chi nhánh mới (chưa lưu): kiểm tra còn chỗ, rồi giữ nhân viên đang phân công
+// (employeeID > 0 thì chuyển sang người này) nếu vẫn nhận được lịch, không thì tự phân công lại.
+// Gọi khi đang giữ khoá đặt lịch của branchID (withBranchLock) và lưu lịch trước khi nhả khoá.
+func (s *AppService) placeAppointment(ctx context.Context, appointment *Appointment, details []AppointmentDetail, scheduledTime time.Time, branchID, employeeID int32) error {
+	duration := appointment.EndTime().Sub(appointment.ScheduledTime)
+	if err := s.checkBookable(ctx, branchID, 0, scheduledTime, duration, appointment.ID, appointment.location()); err != nil {
+		return err
+	}
+	appointment.ScheduledTime = scheduledTime
+	appointment.BranchID = branchID
+	if employeeID > 0 {
+		appointment.EmployeeID = employeeID
+	}
+	if appointment.EmployeeID > 0 {
+		snapshot, err := s.loadBookingSnapshot(ctx, branchID, 0, timeRange{start: scheduledTime, end: appointment.EndTime()}, appointment.ID, appointment.location())
+		if err != nil {
+			return err
+		}
+		if err := snapshot.checkEmployee(appointment.EmployeeID, scheduledTime, appointment.EndTime()); err != nil {
+			if !errors.Is(err, ErrSlotUnavailable) {
+				return err
+			}
+			appointment.EmployeeID = 0
+		}
+	}
+	if appointment.EmployeeID == 0 {
+		employeeID, err := s.pickEmployee(ctx, appointment, details)
+		if err != nil {
+			return err
+		}
+		appointment.EmployeeID = employeeID
+	}
+	return nil
+}
+
+// notifiedServices lấy tên dịch vụ cho thông báo; lỗi thì gửi thông báo không kèm dịch vụ
+func (s *AppService) notifiedServices(ctx context.Context, details []AppointmentDetail) []NotifiedService {
+	ids := make([]int32, len(details))
+	for i, d := range details {
+		ids[i] = d.ServiceID
+	}
+	services, err := s.store.GetServicesByIDs(ctx, ids)
+	if err != nil {
+		return nil
+	}
+	names := make(map[int32]string, len(services))
+	for _, svc := range services {
+		names[svc.ID] = svc.Name
+	}
+	result := make([]NotifiedService, len(details))
+	for i, d := range details {
+		result[i] = NotifiedService{Name: names[d.ServiceID], Quantity: d.Quantity}
+	}
+	return result
+}
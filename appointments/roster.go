@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Phân ca nhân viên: ca cụ thể (EmployeeShift), lịch cố định hằng tuần (ShiftTemplate) được sinh trước
+// thành ca cụ thể, và đơn nghỉ phép (EmployeeLeave) — đơn đã duyệt che các ca trong khoảng nghỉ.
+
+const (
+	// shiftPlanningHorizon - số ngày tới luôn có sẵn ca sinh từ lịch cố định
+	shiftPlanningHorizon = 14
+	shiftPlannerInterval = time.Hour
+	maxGenerateDays      = 62
+)
+
+// --- LỊCH LÀM VIỆC CỐ ĐỊNH ---
+func (s *AppService) CreateShiftTemplate(ctx context.Context, template *ShiftTemplate) (*ShiftTemplate, error) {
+	if template.EmployeeID <= 0 || template.BranchID <= 0 {
+		return nil, fmt.Errorf("%w: employee_id and branch_id are required", ErrInvalidArgument)
+	}
+	if template.Weekday < 0 || template.Weekday > 6 {
+		return nil, fmt.Errorf("%w: weekday must be between 0 (Sunday) and 6 (Saturday)", ErrInvalidArgument)
+	}
+	start, err := time.Parse(clockLayout, template.StartClock)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid start_time %q, must be HH:MM", ErrInvalidArgument, template.StartClock)
+	}
+	end, err := time.Parse(clockLayout, template.EndClock)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid end_time %q, must be HH:MM", ErrInvalidArgument, template.EndClock)
+	}
+	if !end.After(start) {
+		return nil, fmt.Errorf("%w: end_time must be after start_time", ErrInvalidArgument)
+	}
+	// Lưu dạng "HH:MM" có số 0 đứng trước ("9:00" -> "09:00")
+	template.StartClock, template.EndClock = start.Format(clockLayout), end.Format(clockLayout)
+	if template.ValidFrom == "" {
+		template.ValidFrom = time.Now().Format(dateLayout)
+	}
+	if _, err := time.Parse(dateLayout, template.ValidFrom); err != nil {
+		return nil, fmt.Errorf("%w: invalid valid_from, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	if template.ValidUntil != "" {
+		if _, err := time.Parse(dateLayout, template.ValidUntil); err != nil {
+			return nil, fmt.Errorf("%w: invalid valid_until, must be YYYY-MM-DD", ErrInvalidArgument)
+		}
+		if template.ValidUntil < template.ValidFrom {
+			return nil, fmt.Errorf("%w: valid_until must not be before valid_from", ErrInvalidArgument)
+		}
+	}
+
+	// Hai lịch cố định của cùng nhân viên không được chồng giờ trong cùng thứ khi thời gian hiệu lực giao nhau
+	existing, err := s.store.ListShiftTemplates(ctx, 0, template.EmployeeID)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range existing {
+		if t.Weekday != template.Weekday || !datesOverlap(t.ValidFrom, t.ValidUntil, template.ValidFrom, template.ValidUntil) {
+			continue
+		}
+		// So sánh giờ đã parse, lịch cũ có thể còn lưu dạng "9:00"
+		tStart, err := time.Parse(clockLayout, t.StartClock)
+		if err != nil {
+			continue
+		}
+		tEnd, err := time.Parse(clockLayout, t.EndClock)
+		if err != nil {
+			continue
+		}
+		if tStart.Before(end) && start.Before(tEnd) {
+			return nil, fmt.Errorf("%w: template overlaps template %d of employee %d", ErrInvalidState, t.ID, template.EmployeeID)
+		}
+	}
+	if err := s.store.CreateShiftTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	today := time.Now()
+	if _, err := s.GenerateShiftsFromTemplates(ctx, template.BranchID, today.Format(dateLayout), today.AddDate(0, 0, shiftPlanningHorizon).Format(dateLayout)); err != nil {
+		log.Printf("Failed to generate shifts for template %d: %v", template.ID, err)
+	}
+	return template, nil
+}
+
+// datesOverlap - hai khoảng ngày "YYYY-MM-DD" (until rỗng = không hết hạn) có giao nhau không
+func datesOverlap(fromA, untilA, fromB, untilB string) bool {
+	if untilA != "" && untilA < fromB {
+		return false
+	}
+	if untilB != "" && untilB < fromA {
+		return false
+	}
+	return true
+}
+
+func (s *AppService) ListShiftTemplates(ctx context.Context, branchID, employeeID int32) ([]ShiftTemplate, error) {
+	return s.store.ListShiftTemplates(ctx, branchID, employeeID)
+}
+
+// DeleteShiftTemplate xoá lịch cố định cùng các ca tương lai sinh từ nó, rồi phân công lại
+// các lịch hẹn trong khoảng đã sinh ca mà nhân viên không còn ca phủ
+func (s *AppService) DeleteShiftTemplate(ctx context.Context, id int32) (string, []Reassignment, error) {
+	template, err := s.store.GetShiftTemplateByID(ctx, id)
+	if err != nil {
+		return "Failed", nil, err
+	}
+	if err := s.store.DeleteShiftTemplate(ctx, id); err != nil {
+		return "Failed", nil, err
+	}
+	now := time.Now()
+	return "Success", s.reassignUncovered(ctx, template.EmployeeID, template.BranchID, now, now.AddDate(0, 0, shiftPlanningHorizon+1)), nil
+}
+
+// GenerateShiftsFromTemplates sinh ca cụ thể cho các ngày fromDate..toDate (tính cả hai ngày).
+// Ngày đã có ca chồng giờ của nhân viên (tạo tay hoặc sinh lần trước) được bỏ qua nên gọi lại nhiều lần vẫn an toàn.
+func (s *AppService) GenerateShiftsFromTemplates(ctx context.Context, branchID int32, fromDate, toDate string) (int, error) {
+	from, err := time.Parse(dateLayout, fromDate)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid from_date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	to, err := time.Parse(dateLayout, toDate)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid to_date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	if to.Before(from) {
+		return 0, fmt.Errorf("%w: to_date must not be before from_date", ErrInvalidArgument)
+	}
+	if to.Sub(from) > maxGenerateDays*24*time.Hour {
+		return 0, fmt.Errorf("%w: at most %d days can be generated at once", ErrInvalidArgument, maxGenerateDays)
+	}
+	templates, err := s.store.ListShiftTemplates(ctx, branchID, 0)
+	if err != nil || len(templates) == 0 {
+		return 0, err
+	}
+	// Lấy dư một ngày mỗi đầu để không lệch múi giờ giữa ngày UTC và giờ địa phương chi nhánh
+	existing, err := s.store.ListEmployeeShifts(ctx, 0, 0, from.AddDate(0, 0, -1), to.AddDate(0, 0, 2))
+	if err != nil {
+		return 0, err
+	}
+	shiftsByEmployee := make(map[int32][]EmployeeShift)
+	for _, shift := range existing {
+		shiftsByEmployee[shift.EmployeeID] = append(shiftsByEmployee[shift.EmployeeID], shift)
+	}
+
+	locations := make(map[int32]*time.Location)
+	now := time.Now()
+	created := 0
+	for _, t := range templates {
+		loc, ok := locations[t.BranchID]
+		if !ok {
+			if _, loc, err = s.branchSchedule(ctx, t.BranchID); err != nil {
+				return created, err
+			}
+			locations[t.BranchID] = loc
+		}
+		startClock, _ := time.Parse(clockLayout, t.StartClock)
+		endClock, _ := time.Parse(clockLayout, t.EndClock)
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			date := day.Format(dateLayout)
+			if int32(day.Weekday()) != t.Weekday || date < t.ValidFrom || (t.ValidUntil != "" && date > t.ValidUntil) {
+				continue
+			}
+			y, m, d := day.Date()
+			shift := EmployeeShift{
+				EmployeeID: t.EmployeeID,
+				BranchID:   t.BranchID,
+				StartTime:  time.Date(y, m, d, startClock.Hour(), startClock.Minute(), 0, 0, loc),
+				EndTime:    time.Date(y, m, d, endClock.Hour(), endClock.Minute(), 0, 0, loc),
+				TemplateID: t.ID,
+			}
+			if !shift.EndTime.After(now) || shiftOverlaps(shiftsByEmployee[t.EmployeeID], shift.StartTime, shift.EndTime) {
+				continue
+			}
+			if err := s.store.CreateEmployeeShift(ctx, &shift); err != nil {
+				return created, err
+			}
+			shiftsByEmployee[t.EmployeeID] = append(shiftsByEmployee[t.EmployeeID], shift)
+			created++
+		}
+	}
+	return created, nil
+}
+
+func shiftOverlaps(shifts []EmployeeShift, start, end time.Time) bool {
+	for _, shift := range shifts {
+		if shift.StartTime.Before(end) && shift.EndTime.After(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// runShiftPlanner định kỳ sinh ca từ lịch cố định cho shiftPlanningHorizon ngày tới cho tới khi ctx bị huỷ
+func runShiftPlanner(ctx context.Context, service AppointmentService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			today := time.Now()
+			created, err := service.GenerateShiftsFromTemplates(ctx, 0, today.Format(dateLayout), today.AddDate(0, 0, shiftPlanningHorizon).Format(dateLayout))
+			if err != nil {
+				log.Printf("Failed to generate shifts from templates: %v", err)
+			}
+			if created > 0 {
+				log.Printf("Generated %d shifts from templates", created)
+			}
+		}
+	}
+}
+
+// --- NGHỈ PHÉP ---
+func (s *AppService) RequestLeave(ctx context.Context, leave *EmployeeLeave) (*EmployeeLeave, error) {
+	if leave.EmployeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee_id is required", ErrInvalidArgument)
+	}
+	if !leave.EndTime.After(leave.StartTime) {
+		return nil, fmt.Errorf("%w: end_time must be after start_time", ErrInvalidArgument)
+	}
+	if !leave.EndTime.After(time.Now()) {
+		return nil, fmt.Errorf("%w: leave must end in the future", ErrInvalidArgument)
+	}
+	existing, err := s.store.ListLeaves(ctx, []int32{leave.EmployeeID}, []LeaveStatus{LeavePending, LeaveApproved}, leave.StartTime, leave.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("%w: leave overlaps leave request %d", ErrInvalidState, existing[0].ID)
+	}
+	leave.Status = LeavePending
+	if err := s.store.CreateLeave(ctx, leave); err != nil {
+		return nil, err
+	}
+	return leave, nil
+}
+
+func (s *AppService) ListLeaves(ctx context.Context, employeeID int32, status LeaveStatus, from, to time.Time) ([]EmployeeLeave, error) {
+	if !to.After(from) {
+		return nil, fmt.Errorf("%w: to must be after from", ErrInvalidArgument)
+	}
+	var employeeIDs []int32
+	if employeeID > 0 {
+		employeeIDs = []int32{employeeID}
+	}
+	var statuses []LeaveStatus
+	switch status {
+	case "":
+	case LeavePending, LeaveApproved, LeaveRejected:
+		statuses = []LeaveStatus{status}
+	default:
+		return nil, fmt.Errorf("%w: unknown leave status %q", ErrInvalidArgument, status)
+	}
+	return s.store.ListLeaves(ctx, employeeIDs, statuses, from, to)
+}
+
+// ReviewLeave duyệt hoặc từ chối đơn nghỉ. Khi duyệt, các lịch hẹn đã phân công cho nhân viên
+// trong khoảng nghỉ được trả về để quản lý phân công lại.
+func (s *AppService) ReviewLeave(ctx context.Context, id int32, approve bool, reviewerID int32) (*EmployeeLeave, []int32, error) {
+	status := LeaveRejected
+	if approve {
+		status = LeaveApproved
+	}
+	leave, err := s.store.ReviewLeave(ctx, id, status, reviewerID)
+	if err != nil || !approve {
+		return leave, nil, err
+	}
+	appointments, err := s.store.ListEmployeeAppointmentsBetween(ctx, []int32{leave.EmployeeID}, leave.StartTime.Add(-maxAppointmentDuration), leave.EndTime)
+	if err != nil {
+		return leave, nil, err
+	}
+	var affected []int32
+	for i := range appointments {
+		if overlaps(&appointments[i], leave.StartTime, leave.EndTime) {
+			affected = append(affected, appointments[i].ID)
+		}
+	}
+	return leave, affected, nil
+}
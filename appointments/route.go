@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	pbProduct "github.com/quanbin27/commons/genproto/products"
+)
+
+// Lộ trình lịch tại nhà của nhân viên trong ngày. Thời gian di chuyển ước lượng từ khoảng cách đường chim bay
+// nhân roadFactor và BookingPolicy.TravelSpeedKmh. Thứ tự đề xuất tính bằng láng giềng gần nhất rồi cải thiện bằng 2-opt,
+// xuất phát từ chi nhánh của nhân viên; lộ trình chỉ để tham khảo khi xếp/dời lịch, không đổi giờ hẹn.
+
+const (
+	// roadFactor - quãng đường thực tế so với đường chim bay
+	roadFactor = 1.3
+	// maxTravelTime - thời gian di chuyển tối đa chừa giữa hai lịch tại nhà
+	maxTravelTime = 2 * time.Hour
+)
+
+// roadDistanceKm - quãng đường ước lượng giữa hai điểm
+func roadDistanceKm(from, to GeoPoint) float64 {
+	return distanceKm(from, to) * roadFactor
+}
+
+// travelTime - thời gian di chuyển ước lượng, làm tròn lên phút
+func (p BookingPolicy) travelTime(from, to GeoPoint) time.Duration {
+	if p.TravelSpeedKmh <= 0 {
+		return 0
+	}
+	minutes := math.Ceil(roadDistanceKm(from, to) / p.TravelSpeedKmh * 60)
+	if travel := time.Duration(minutes) * time.Minute; travel < maxTravelTime {
+		return travel
+	}
+	return maxTravelTime
+}
+
+// planRoute trả về thứ tự đi qua points. start là điểm xuất phát (nil = xuất phát từ points[0]), đường đi không quay về.
+func planRoute(start *GeoPoint, points []GeoPoint) []int {
+	if len(points) == 0 {
+		return nil
+	}
+	nodes, offset := points, 0
+	if start != nil {
+		nodes, offset = append([]GeoPoint{*start}, points...), 1
+	}
+	dist := make([][]float64, len(nodes))
+	for i := range nodes {
+		dist[i] = make([]float64, len(nodes))
+		for j := range nodes {
+			dist[i][j] = distanceKm(nodes[i], nodes[j])
+		}
+	}
+
+	// Láng giềng gần nhất từ điểm xuất phát
+	path := []int{0}
+	visited := make([]bool, len(nodes))
+	visited[0] = true
+	for len(path) < len(nodes) {
+		last, next := path[len(path)-1], -1
+		for j := range nodes {
+			if !visited[j] && (next < 0 || dist[last][j] < dist[last][next]) {
+				next = j
+			}
+		}
+		visited[next] = true
+		path = append(path, next)
+	}
+
+	// 2-opt: đảo đoạn path[i..j] khi làm đường đi ngắn hơn, giữ nguyên điểm xuất phát
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < len(path)-1; i++ {
+			for j := i + 1; j < len(path); j++ {
+				delta := dist[path[i-1]][path[j]] - dist[path[i-1]][path[i]]
+				if j+1 < len(path) {
+					delta += dist[path[i]][path[j+1]] - dist[path[j]][path[j+1]]
+				}
+				if delta < -1e-9 {
+					for a, b := i, j; a < b; a, b = a+1, b-1 {
+						path[a], path[b] = path[b], path[a]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+
+	order := make([]int, 0, len(points))
+	for _, node := range path {
+		if node >= offset {
+			order = append(order, node-offset)
+		}
+	}
+	return order
+}
+
+// RouteStop - một điểm dừng trong lộ trình, DistanceKm/Travel tính từ điểm dừng trước (hoặc chi nhánh)
+type RouteStop struct {
+	Appointment Appointment
+	Point       GeoPoint
+	DistanceKm  float64
+	Travel      time.Duration
+}
+
+type EmployeeRoute struct {
+	Start               *GeoPoint // chi nhánh, nil khi chi nhánh chưa có toạ độ
+	Stops               []RouteStop
+	DistanceKm          float64
+	Travel              time.Duration
+	ScheduledDistanceKm float64       // quãng đường nếu đi theo thứ tự giờ hẹn, để so sánh
+	Unlocated           []Appointment // lịch chưa tra được toạ độ địa chỉ
+	Location            *time.Location
+}
+
+// GetEmployeeRoute lập lộ trình các lịch hẹn của nhân viên trong ngày date (theo giờ chi nhánh của nhân viên).
+// Lịch cũ chưa có toạ độ được tra và lưu lại.
+func (s *AppService) GetEmployeeRoute(ctx context.Context, employeeID int32, date string) (*EmployeeRoute, error) {
+	if employeeID <= 0 {
+		return nil, fmt.Errorf("%w: employee_id is required", ErrInvalidArgument)
+	}
+	day, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	branchID, loc := s.employeeBranch(ctx, employeeID)
+	window := localDay(time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, loc), loc)
+	appointments, err := s.store.ListEmployeeAppointmentsBetween(ctx, []int32{employeeID}, window.start, window.end)
+	if err != nil {
+		return nil, err
+	}
+
+	route := &EmployeeRoute{Location: loc}
+	if branchID > 0 {
+		if branch, err := s.productClient.GetBranchByID(ctx, &pbProduct.GetBranchRequest{Id: branchID}); err == nil {
+			if branch.Latitude != 0 || branch.Longitude != 0 {
+				route.Start = &GeoPoint{Lat: branch.Latitude, Lng: branch.Longitude}
+			}
+		} else {
+			log.Printf("Failed to get branch %d for employee %d route: %v", branchID, employeeID, err)
+		}
+	}
+
+	var located []Appointment
+	var points []GeoPoint
+	for i := range appointments {
+		a := &appointments[i]
+		if !a.Geocoded {
+			s.locate(ctx, a)
+			if a.Geocoded {
+				if err := s.store.SaveAppointmentLocation(ctx, a.ID, *a.location()); err != nil {
+					log.Printf("Failed to save location of appointment %d: %v", a.ID, err)
+				}
+			}
+		}
+		if point := a.location(); point != nil {
+			located = append(located, *a)
+			points = append(points, *point)
+		} else {
+			route.Unlocated = append(route.Unlocated, *a)
+		}
+	}
+
+	// Thứ tự theo giờ hẹn (danh sách đã sắp theo scheduled_time)
+	prev := route.Start
+	for i := range points {
+		if prev != nil {
+			route.ScheduledDistanceKm += roadDistanceKm(*prev, points[i])
+		}
+		prev = &points[i]
+	}
+
+	prev = route.Start
+	for _, idx := range planRoute(route.Start, points) {
+		stop := RouteStop{Appointment: located[idx], Point: points[idx]}
+		if prev != nil {
+			stop.DistanceKm = roadDistanceKm(*prev, stop.Point)
+			stop.Travel = s.policy.travelTime(*prev, stop.Point)
+		}
+		route.DistanceKm += stop.DistanceKm
+		route.Travel += stop.Travel
+		route.Stops = append(route.Stops, stop)
+		prev = &points[idx]
+	}
+	return route, nil
+}
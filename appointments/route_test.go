@@ -0,0 +1,43 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Các điểm nằm trên xích đạo, cách nhau theo kinh độ, nên khoảng cách tỉ lệ với hiệu kinh độ và dễ tính tay.
+func onEquator(lngs ...float64) []GeoPoint {
+	points := make([]GeoPoint, len(lngs))
+	for i, lng := range lngs {
+		points[i] = GeoPoint{Lng: lng}
+	}
+	return points
+}
+
+func TestPlanRoute(t *testing.T) {
+	origin := &GeoPoint{}
+	tests := []struct {
+		name   string
+		start  *GeoPoint
+		points []GeoPoint
+		want   []int
+	}{
+		{name: "no points", start: origin, points: nil, want: nil},
+		{name: "single point", start: origin, points: onEquator(0.3), want: []int{0}},
+		{name: "without start keeps first point first", start: nil, points: onEquator(0, 0.3, 0.1, 0.2), want: []int{0, 2, 3, 1}},
+		{name: "nearest neighbour along a line", start: origin, points: onEquator(0.3, 0.1, 0.2), want: []int{1, 2, 0}},
+		// Láng giềng gần nhất đi 0 -> 1 -> -2 -> 4 (10), 2-opt đảo đoạn giữa thành 0 -> -2 -> 1 -> 4 (8)
+		{name: "2-opt improves nearest neighbour", start: origin, points: onEquator(0.1, -0.2, 0.4), want: []int{1, 0, 2}},
+		// Đường đi không quay về điểm xuất phát: 0 -> 4 -> 1 -> -1 -> -5 (13) ngắn nhất.
+		// Nếu tính như chu trình khép kín (cộng đoạn quay về) sẽ ra 0 -> -5 -> -1 -> 1 -> 4 (14).
+		{name: "open path does not count the way back", start: origin, points: onEquator(0.1, -0.1, -0.5, 0.4), want: []int{3, 0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planRoute(tt.start, tt.points)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planRoute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
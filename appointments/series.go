@@ -0,0 +1,471 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Lịch hẹn định kỳ (vd. tỉa lông mỗi 4 tuần). Các lần hẹn được sinh trước thành lịch hẹn thường trong
+// seriesPlanningHorizon ngày tới (lúc tạo chuỗi và định kỳ bởi runSeriesPlanner), qua cùng kiểm tra chỗ trống,
+// tính giá và phân công như đặt lịch lẻ. Lần hẹn không đặt được (kín chỗ, ngoài giờ mở cửa) bị bỏ qua và không sinh lại.
+// Sửa/huỷ một lần hẹn dùng RescheduleAppointment/UpdateAppointmentStatus; sửa/huỷ cả chuỗi chỉ đụng tới các lần hẹn chưa tới.
+
+const (
+	// seriesPlanningHorizon - số ngày tới luôn có sẵn lịch hẹn sinh từ lịch định kỳ
+	seriesPlanningHorizon = 56
+	seriesPlannerInterval = time.Hour
+	maxSeriesInterval     = 52
+	maxSeriesCount        = 104
+)
+
+// occurrenceAt - giờ hẹn của lần lặp index theo múi giờ chi nhánh; false nếu tháng đó không có ngày hẹn (vd. ngày 31)
+func (r *AppointmentSeries) occurrenceAt(index int32, loc *time.Location) (time.Time, bool) {
+	start := r.StartTime.In(loc)
+	y, m, d := start.Date()
+	hour, minute, sec := start.Clock()
+	if r.Frequency == FrequencyMonthly {
+		t := time.Date(y, m+time.Month(index*r.Interval), d, hour, minute, sec, 0, loc)
+		return t, t.Day() == d
+	}
+	return time.Date(y, m, d+int(index*r.Interval)*7, hour, minute, sec, 0, loc), true
+}
+
+// ended - chuỗi đã sinh đủ Count lần hẹn hoặc lần lặp rơi vào sau UntilDate
+func (r *AppointmentSeries) ended(at time.Time, loc *time.Location) bool {
+	if r.Count > 0 && r.Occurrences >= r.Count {
+		return true
+	}
+	return r.UntilDate != "" && at.In(loc).Format(dateLayout) > r.UntilDate
+}
+
+// isBookingError - lỗi do khung giờ không đặt được (bỏ qua lần hẹn), khác với lỗi hệ thống (thử lại lần sau)
+func isBookingError(err error) bool {
+	return errors.Is(err, ErrSlotUnavailable) || errors.Is(err, ErrInvalidState) ||
+		errors.Is(err, ErrInvalidArgument) || errors.Is(err, ErrServiceArchived)
+}
+
+// CreateAppointmentSeries tạo lịch định kỳ; lần hẹn đầu tiên (StartTime) phải đặt được như một lịch hẹn lẻ
+func (s *AppService) CreateAppointmentSeries(ctx context.Context, series *AppointmentSeries) ([]Appointment, []SkippedOccurrence, error) {
+	if series.CustomerID <= 0 || series.BranchID <= 0 {
+		return nil, nil, fmt.Errorf("%w: customer_id and branch_id are required", ErrInvalidArgument)
+	}
+	if series.Frequency != FrequencyWeekly && series.Frequency != FrequencyMonthly {
+		return nil, nil, fmt.Errorf("%w: frequency must be WEEKLY or MONTHLY", ErrInvalidArgument)
+	}
+	if series.Interval == 0 {
+		series.Interval = 1
+	}
+	if series.Interval < 0 || series.Interval > maxSeriesInterval {
+		return nil, nil, fmt.Errorf("%w: interval must be between 1 and %d", ErrInvalidArgument, maxSeriesInterval)
+	}
+	if series.Count < 0 || series.Count > maxSeriesCount {
+		return nil, nil, fmt.Errorf("%w: count must be between 0 and %d", ErrInvalidArgument, maxSeriesCount)
+	}
+	if series.Count > 0 && series.UntilDate != "" {
+		return nil, nil, fmt.Errorf("%w: only one of until_date and count can be set", ErrInvalidArgument)
+	}
+	if len(series.Services) == 0 {
+		return nil, nil, fmt.Errorf("%w: at least one service is required", ErrInvalidArgument)
+	}
+
+	var serviceIDs []int32
+	details := make([]AppointmentDetail, len(series.Services))
+	seen := make(map[int32]bool)
+	for i, svc := range series.Services {
+		if svc.Quantity <= 0 {
+			return nil, nil, fmt.Errorf("%w: quantity of service %d must be positive", ErrInvalidArgument, svc.ServiceID)
+		}
+		if !seen[svc.ServiceID] {
+			seen[svc.ServiceID] = true
+			serviceIDs = append(serviceIDs, svc.ServiceID)
+		}
+		details[i] = AppointmentDetail{ServiceID: svc.ServiceID, Quantity: svc.Quantity, PetID: svc.PetID}
+	}
+	// Thú cưng kiểm tra như lịch hẹn lẻ (thuộc khách, mỗi dịch vụ một lần cho mỗi thú cưng) rồi lưu bản đã chuẩn hoá
+	first := &Appointment{CustomerID: series.CustomerID, Pets: seriesAppointmentPets(series.Pets)}
+	if err := s.checkPets(ctx, first, details); err != nil {
+		return nil, nil, err
+	}
+	series.Pets = make([]SeriesPet, len(first.Pets))
+	for i, p := range first.Pets {
+		series.Pets[i] = SeriesPet{PetID: p.PetID}
+	}
+	for i := range details {
+		series.Services[i].PetID = details[i].PetID
+	}
+	serviceList, err := s.store.GetServicesByIDs(ctx, serviceIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(serviceList) != len(serviceIDs) {
+		return nil, nil, fmt.Errorf("%w: unknown service in %v", ErrInvalidArgument, serviceIDs)
+	}
+	serviceMap := make(map[int32]Service, len(serviceList))
+	for _, svc := range serviceList {
+		if svc.IsArchived {
+			return nil, nil, fmt.Errorf("%w: service ID %d", ErrServiceArchived, svc.ID)
+		}
+		serviceMap[svc.ID] = svc
+	}
+
+	_, loc, err := s.branchSchedule(ctx, series.BranchID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if series.UntilDate != "" {
+		if _, err := time.Parse(dateLayout, series.UntilDate); err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid until_date, must be YYYY-MM-DD", ErrInvalidArgument)
+		}
+		if series.UntilDate < series.StartTime.In(loc).Format(dateLayout) {
+			return nil, nil, fmt.Errorf("%w: until_date must not be before the first appointment", ErrInvalidArgument)
+		}
+	}
+	if err := s.checkBookable(ctx, series.BranchID, series.EmployeeID, series.StartTime, bookingDuration(serviceMap, details), 0, s.geocode(ctx, series.CustomerAddress)); err != nil {
+		return nil, nil, err
+	}
+
+	series.Status = SeriesActive
+	series.NextIndex, series.Occurrences = 0, 0
+	if err := s.store.CreateAppointmentSeries(ctx, series); err != nil {
+		return nil, nil, err
+	}
+	created, skipped, err := s.generateOccurrences(ctx, series, loc, time.Now().AddDate(0, 0, seriesPlanningHorizon))
+	if err != nil {
+		// Chuỗi đã tạo, bộ sinh lịch định kỳ sẽ sinh tiếp các lần hẹn còn thiếu
+		log.Printf("Failed to generate appointments for series %d: %v", series.ID, err)
+	}
+	return created, skipped, nil
+}
+
+// generateOccurrences sinh các lần hẹn từ NextIndex tới until rồi lưu tiến độ. Lần lặp đã có lịch hẹn
+// (sinh dở ở lượt trước) được bỏ qua nên gọi lại sau lỗi hệ thống vẫn an toàn.
+func (s *AppService) generateOccurrences(ctx context.Context, series *AppointmentSeries, loc *time.Location, until time.Time) ([]Appointment, []SkippedOccurrence, error) {
+	if at, _ := series.occurrenceAt(series.NextIndex, loc); at.After(until) && !series.ended(at, loc) {
+		return nil, nil, nil
+	}
+	existing, err := s.store.ListSeriesAppointments(ctx, series.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	generated := make(map[int32]bool, len(existing))
+	for _, a := range existing {
+		generated[a.SeriesIndex] = true
+	}
+
+	fromIndex := series.NextIndex
+	now := time.Now()
+	var created []Appointment
+	var skipped []SkippedOccurrence
+	for {
+		at, ok := series.occurrenceAt(series.NextIndex, loc)
+		if series.ended(at, loc) {
+			series.Status = SeriesEnded
+			break
+		}
+		if at.After(until) {
+			break
+		}
+		if ok {
+			switch {
+			case generated[series.NextIndex]:
+			case !at.After(now):
+				skipped = append(skipped, SkippedOccurrence{ScheduledTime: at, Reason: "occurrence time has already passed"})
+			default:
+				appointment, err := s.createOccurrence(ctx, series, series.NextIndex, at)
+				if err != nil {
+					if errors.Is(err, ErrSeriesInactive) {
+						// Lịch bị huỷ giữa chừng: dừng sinh, không lưu tiến độ
+						return created, skipped, err
+					}
+					if errors.Is(err, ErrOccurrenceExists) {
+						// Lượt sinh khác vừa tạo lần hẹn này
+						series.Occurrences++
+						series.NextIndex++
+						continue
+					}
+					if !isBookingError(err) {
+						return created, skipped, err
+					}
+					skipped = append(skipped, SkippedOccurrence{ScheduledTime: at, Reason: err.Error()})
+				} else {
+					created = append(created, *appointment)
+				}
+			}
+			series.Occurrences++
+		}
+		series.NextIndex++
+	}
+	if series.NextIndex == fromIndex && series.Status == SeriesActive {
+		return created, skipped, nil
+	}
+	return created, skipped, s.store.SaveSeriesProgress(ctx, series, fromIndex)
+}
+
+// seriesAppointmentPets - thú cưng của lịch định kỳ dưới dạng thú cưng của một lần hẹn
+func seriesAppointmentPets(pets []SeriesPet) []AppointmentPet {
+	result := make([]AppointmentPet, len(pets))
+	for i, p := range pets {
+		result[i] = AppointmentPet{PetID: p.PetID}
+	}
+	return result
+}
+
+// createOccurrence đặt một lần hẹn của chuỗi như lịch hẹn lẻ (giá theo bảng giá hiện tại, khuyến mãi tự động)
+func (s *AppService) createOccurrence(ctx context.Context, series *AppointmentSeries, index int32, at time.Time) (*Appointment, error) {
+	book := func(employeeID int32) (*Appointment, error) {
+		appointment := &Appointment{
+			CustomerID:      series.CustomerID,
+			EmployeeID:      employeeID,
+			CustomerAddress: series.CustomerAddress,
+			ScheduledTime:   at,
+			Note:            series.Note,
+			BranchID:        series.BranchID,
+			SeriesID:        SeriesRef(series.ID),
+			SeriesIndex:     index,
+		}
+		appointment.Pets = seriesAppointmentPets(series.Pets)
+		details := make([]AppointmentDetail, len(series.Services))
+		for i, svc := range series.Services {
+			details[i] = AppointmentDetail{ServiceID: svc.ServiceID, Quantity: svc.Quantity, PetID: svc.PetID}
+		}
+		if _, _, _, err := s.CreateAppointment(ctx, appointment, details, nil); err != nil {
+			return nil, err
+		}
+		return appointment, nil
+	}
+	appointment, err := book(series.EmployeeID)
+	if err != nil && series.EmployeeID > 0 && errors.Is(err, ErrSlotUnavailable) {
+		// Nhân viên ưu tiên bận, nghỉ hoặc chưa được xếp ca ngày đó: để hệ thống tự phân công
+		appointment, err = book(0)
+	}
+	return appointment, err
+}
+
+// GenerateSeriesAppointments sinh lịch hẹn cho mọi chuỗi còn hiệu lực tới seriesPlanningHorizon ngày tới
+func (s *AppService) GenerateSeriesAppointments(ctx context.Context) (int, error) {
+	list, err := s.store.ListActiveAppointmentSeries(ctx)
+	if err != nil {
+		return 0, err
+	}
+	until := time.Now().AddDate(0, 0, seriesPlanningHorizon)
+	locations := make(map[int32]*time.Location)
+	created := 0
+	for i := range list {
+		series := &list[i]
+		loc, ok := locations[series.BranchID]
+		if !ok {
+			_, branchLoc, err := s.branchSchedule(ctx, series.BranchID)
+			if err != nil {
+				log.Printf("Failed to generate appointments for series %d: %v", series.ID, err)
+				continue
+			}
+			loc = branchLoc
+			locations[series.BranchID] = loc
+		}
+		appointments, skipped, err := s.generateOccurrences(ctx, series, loc, until)
+		created += len(appointments)
+		for _, o := range skipped {
+			log.Printf("Skipped appointment of series %d at %s: %s", series.ID, o.ScheduledTime.Format(time.RFC3339), o.Reason)
+		}
+		if err != nil {
+			log.Printf("Failed to generate appointments for series %d: %v", series.ID, err)
+		}
+	}
+	return created, nil
+}
+
+// loadSeries - customerID > 0 thì chỉ trả về chuỗi lịch của khách đó
+func (s *AppService) loadSeries(ctx context.Context, seriesID, customerID int32) (*AppointmentSeries, error) {
+	series, err := s.store.GetAppointmentSeriesByID(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if customerID > 0 && series.CustomerID != customerID {
+		return nil, fmt.Errorf("series %d: %w", seriesID, gorm.ErrRecordNotFound)
+	}
+	return series, nil
+}
+
+func (s *AppService) GetAppointmentSeries(ctx context.Context, seriesID, customerID int32) (*AppointmentSeries, []Appointment, error) {
+	series, err := s.loadSeries(ctx, seriesID, customerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	appointments, err := s.store.ListSeriesAppointments(ctx, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return series, appointments, nil
+}
+
+// seriesShift dời giờ hẹn theo số ngày và sang giờ mới theo múi giờ chi nhánh
+type seriesShift struct {
+	days, hour, minute int
+	loc                *time.Location
+}
+
+func newSeriesShift(from, to time.Time, loc *time.Location) *seriesShift {
+	fy, fm, fd := from.In(loc).Date()
+	ty, tm, td := to.In(loc).Date()
+	days := time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC).Sub(time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour)
+	hour, minute, _ := to.In(loc).Clock()
+	return &seriesShift{days: int(days), hour: hour, minute: minute, loc: loc}
+}
+
+func (sh *seriesShift) apply(t time.Time) time.Time {
+	y, m, d := t.In(sh.loc).Date()
+	return time.Date(y, m, d+sh.days, sh.hour, sh.minute, 0, 0, sh.loc)
+}
+
+// UpdateAppointmentSeries sửa cả chuỗi: scheduledTime (khác zero) là giờ mới của lần hẹn sắp tới, các lần sau dời
+// cùng số ngày và sang cùng giờ; employeeID > 0 đổi nhân viên ưu tiên. Chỉ các lần hẹn chưa tới còn đúng giờ theo chuỗi
+// được dời — lần hẹn đã dời riêng giữ nguyên. Lần hẹn không dời được giữ giờ cũ và được trả về trong skipped.
+func (s *AppService) UpdateAppointmentSeries(ctx context.Context, seriesID, customerID int32, scheduledTime time.Time, employeeID int32) (*AppointmentSeries, []Appointment, []SkippedOccurrence, error) {
+	series, err := s.loadSeries(ctx, seriesID, customerID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if series.Status == SeriesCancelled {
+		return nil, nil, nil, fmt.Errorf("%w: series %d has been cancelled", ErrInvalidState, seriesID)
+	}
+	if scheduledTime.IsZero() && (employeeID <= 0 || employeeID == series.EmployeeID) {
+		return nil, nil, nil, fmt.Errorf("%w: nothing to update", ErrInvalidArgument)
+	}
+	now := time.Now()
+	if !scheduledTime.IsZero() && !scheduledTime.After(now) {
+		return nil, nil, nil, fmt.Errorf("%w: scheduled time must be in the future", ErrInvalidArgument)
+	}
+	_, loc, err := s.branchSchedule(ctx, series.BranchID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	appointments, err := s.store.ListSeriesAppointments(ctx, seriesID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var upcoming []Appointment
+	for _, a := range appointments {
+		at, _ := series.occurrenceAt(a.SeriesIndex, loc)
+		if isUpcoming(a.Status) && a.ScheduledTime.After(now) && a.ScheduledTime.Equal(at) {
+			upcoming = append(upcoming, a)
+		}
+	}
+
+	var shift *seriesShift
+	if !scheduledTime.IsZero() {
+		// Mốc dời là lần hẹn sắp tới, chưa sinh lần nào thì là lần lặp tiếp theo
+		next, _ := series.occurrenceAt(series.NextIndex, loc)
+		if len(upcoming) > 0 {
+			next = upcoming[0].ScheduledTime
+		}
+		shift = newSeriesShift(next, scheduledTime, loc)
+		series.StartTime = shift.apply(series.StartTime)
+	}
+	if employeeID > 0 {
+		series.EmployeeID = employeeID
+	}
+	if err := s.store.UpdateAppointmentSeries(ctx, series); err != nil {
+		return nil, nil, nil, err
+	}
+
+	var moved []Appointment
+	var skipped []SkippedOccurrence
+	for i := range upcoming {
+		appointment := upcoming[i]
+		target := appointment.ScheduledTime
+		if shift != nil {
+			target = shift.apply(target)
+		}
+		if target.Equal(appointment.ScheduledTime) && (employeeID <= 0 || employeeID == appointment.EmployeeID) {
+			continue
+		}
+		_, details, err := s.store.GetAppointmentDetails(ctx, appointment.ID)
+		if err != nil {
+			return series, moved, skipped, err
+		}
+		previous := appointment
+		err = s.withBranchLock(ctx, appointment.BranchID, func() error {
+			if err := s.placeAppointment(ctx, &appointment, details, target, appointment.BranchID, employeeID); err != nil {
+				return err
+			}
+			return s.store.RescheduleAppointment(ctx, &previous, &appointment)
+		})
+		if err != nil {
+			if !isBookingError(err) && !errors.Is(err, ErrInvalidState) {
+				return series, moved, skipped, err
+			}
+			skipped = append(skipped, SkippedOccurrence{ScheduledTime: previous.ScheduledTime, Reason: err.Error()})
+			continue
+		}
+		s.notifier.AppointmentRescheduled(&appointment, &previous, s.notifiedServices(ctx, details), loc)
+		moved = append(moved, appointment)
+	}
+	return series, moved, skipped, nil
+}
+
+// CancelAppointmentSeries dừng sinh lịch và huỷ các lần hẹn chưa tới (tính phí huỷ muộn như huỷ lịch lẻ)
+func (s *AppService) CancelAppointmentSeries(ctx context.Context, seriesID, customerID int32, reason string, changedBy int32) ([]int32, float32, string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, 0, "Failed", fmt.Errorf("%w: reason is required to cancel a series", ErrInvalidArgument)
+	}
+	series, err := s.loadSeries(ctx, seriesID, customerID)
+	if err != nil {
+		return nil, 0, "Failed", err
+	}
+	if series.Status == SeriesCancelled {
+		return nil, 0, "Failed", fmt.Errorf("%w: series %d has already been cancelled", ErrInvalidState, seriesID)
+	}
+	if err := s.store.CancelAppointmentSeries(ctx, seriesID); err != nil {
+		return nil, 0, "Failed", err
+	}
+	// Liệt kê sau khi đổi trạng thái: lần hẹn đang được sinh giữ khoá dòng lịch định kỳ nên đã commit
+	// trước lệnh cập nhật trên, các lần hẹn sau đó không thể được tạo thêm
+	appointments, err := s.store.ListSeriesAppointments(ctx, seriesID)
+	if err != nil {
+		return nil, 0, "Failed", err
+	}
+
+	now := time.Now()
+	var cancelled []int32
+	var total float32
+	for _, a := range appointments {
+		if !isUpcoming(a.Status) || !a.ScheduledTime.After(now) {
+			continue
+		}
+		fee, _, err := s.UpdateAppointmentStatus(ctx, a.ID, StatusCancelled, reason, changedBy, 0)
+		if err != nil {
+			log.Printf("Failed to cancel appointment %d of series %d: %v", a.ID, seriesID, err)
+			continue
+		}
+		cancelled = append(cancelled, a.ID)
+		total += fee
+	}
+	return cancelled, total, "Success", nil
+}
+
+// runSeriesPlanner định kỳ sinh lịch hẹn từ lịch định kỳ cho seriesPlanningHorizon ngày tới cho tới khi ctx bị huỷ
+func runSeriesPlanner(ctx context.Context, service AppointmentService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			created, err := service.GenerateSeriesAppointments(ctx)
+			if err != nil {
+				log.Printf("Failed to generate appointments from series: %v", err)
+			}
+			if created > 0 {
+				log.Printf("Generated %d appointments from series", created)
+			}
+		}
+	}
+}
@@ -3,54 +3,128 @@ package main
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
+
+	pbProduct "github.com/quanbin27/commons/genproto/products"
+	pbRecord "github.com/quanbin27/commons/genproto/records"
+	pbUser "github.com/quanbin27/commons/genproto/users"
+	"github.com/quanbin27/commons/pricing"
 )
 
 type AppService struct {
 	store         AppointmentStore
 	priceStrategy PriceCalculationStrategy
+	productClient pbProduct.ProductServiceClient // lịch mở cửa & sức chứa chi nhánh
+	assigner      AssignmentStrategy
+	policy        BookingPolicy
+	notifier      AppointmentNotifier
+	recordsClient pbRecord.PetRecordServiceClient // thú cưng & phiếu khám
+	userClient    pbUser.UserServiceClient
+	geocoder      Geocoder // toạ độ địa chỉ hẹn
+}
+
+// BookingPolicy - chính sách huỷ, dời và nhắc lịch hẹn
+type BookingPolicy struct {
+	CancelDeadline       time.Duration   // huỷ trước giờ hẹn ít nhất CancelDeadline thì miễn phí
+	LateCancelFeePercent float32         // % tổng tiền tính khi huỷ muộn, 0 = không tính phí
+	MaxReschedules       int32           // số lần tối đa một lịch hẹn được dời
+	ReminderOffsets      []time.Duration // các mốc nhắc trước giờ hẹn, tăng dần
+	TravelSpeedKmh       float64         // tốc độ di chuyển giữa các lịch tại nhà, 0 = không chừa thời gian di chuyển
 }
 
-func NewAppointmentService(store AppointmentStore, strategy PriceCalculationStrategy) AppointmentService {
-	return &AppService{store: store, priceStrategy: strategy}
+func NewAppointmentService(store AppointmentStore, strategy PriceCalculationStrategy, productClient pbProduct.ProductServiceClient, assigner AssignmentStrategy, policy BookingPolicy, notifier AppointmentNotifier, recordsClient pbRecord.PetRecordServiceClient, userClient pbUser.UserServiceClient, geocoder Geocoder) AppointmentService {
+	return &AppService{store: store, priceStrategy: strategy, productClient: productClient, assigner: assigner, policy: policy, notifier: notifier, recordsClient: recordsClient, userClient: userClient, geocoder: geocoder}
 }
 
+// PriceCalculationStrategy tính tổng tiền lịch hẹn từ giá dịch vụ (ServicePrice đã được gán từ DB)
 type PriceCalculationStrategy interface {
-	CalculateTotal(services []AppointmentDetail, servicePriceMap map[int32]float32) (float32, error)
+	CalculateTotal(ctx context.Context, customerID int32, services []AppointmentDetail, couponCodes []string) (*PriceQuote, error)
+	// Cancel huỷ những gì CalculateTotal đã ghi nhận (lượt dùng khuyến mãi) khi lịch hẹn không tạo được
+	Cancel(ctx context.Context, quote *PriceQuote)
 }
-type StandardPriceStrategy struct{}
 
-func (s *StandardPriceStrategy) CalculateTotal(services []AppointmentDetail, servicePriceMap map[int32]float32) (float32, error) {
+func subtotalOf(services []AppointmentDetail) float32 {
 	var total float32
 	for _, item := range services {
-		price, exists := servicePriceMap[item.ServiceID]
-		if !exists {
-			return 0, fmt.Errorf("service ID %d not found", item.ServiceID)
-		}
-		total += float32(item.Quantity) * price
+		total += float32(item.Quantity) * item.ServicePrice
 	}
-	return total, nil
+	return total
 }
 
-type DiscountPriceStrategy struct {
-	discount float32
+// StandardPriceStrategy tính theo giá niêm yết, không áp dụng khuyến mãi
+type StandardPriceStrategy struct{}
+
+func (s *StandardPriceStrategy) CalculateTotal(ctx context.Context, customerID int32, services []AppointmentDetail, couponCodes []string) (*PriceQuote, error) {
+	total := subtotalOf(services)
+	return &PriceQuote{Subtotal: total, Total: total}, nil
 }
 
-func (s *DiscountPriceStrategy) CalculateTotal(services []AppointmentDetail, servicePriceMap map[int32]float32) (float32, error) {
-	var total float32
-	for _, item := range services {
-		price, exists := servicePriceMap[item.ServiceID]
-		if !exists {
-			return 0, fmt.Errorf("service ID %d not found", item.ServiceID)
+func (s *StandardPriceStrategy) Cancel(ctx context.Context, quote *PriceQuote) {}
+
+// PromotionPriceStrategy tính giá qua promotion engine của Products service và ghi nhận lượt dùng khuyến mãi
+type PromotionPriceStrategy struct {
+	productClient pbProduct.ProductServiceClient
+}
+
+func NewPromotionPriceStrategy(productClient pbProduct.ProductServiceClient) *PromotionPriceStrategy {
+	return &PromotionPriceStrategy{productClient: productClient}
+}
+
+func (s *PromotionPriceStrategy) CalculateTotal(ctx context.Context, customerID int32, services []AppointmentDetail, couponCodes []string) (*PriceQuote, error) {
+	items := make([]*pbProduct.CartItem, len(services))
+	for i, item := range services {
+		items[i] = &pbProduct.CartItem{
+			ItemType:  "service",
+			ItemId:    item.ServiceID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.ServicePrice,
 		}
-		total += float32(item.Quantity) * price
 	}
-	return total * (1 - s.discount), nil
+	pricing, err := s.productClient.RedeemPromotions(ctx, &pbProduct.PriceCartRequest{
+		CustomerId:  customerID,
+		Items:       items,
+		CouponCodes: couponCodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	quote := &PriceQuote{
+		Subtotal: pricing.Subtotal,
+		Discount: pricing.Discount,
+		Total:    pricing.Total,
+	}
+	for _, p := range pricing.AppliedPromotions {
+		quote.Promotions = append(quote.Promotions, AppointmentPromotion{
+			PromotionID:  p.PromotionId,
+			RedemptionID: p.RedemptionId,
+			Code:         p.Code,
+			Name:         p.Name,
+			Discount:     p.Discount,
+		})
+	}
+	return quote, nil
+}
+
+func (s *PromotionPriceStrategy) Cancel(ctx context.Context, quote *PriceQuote) {
+	var ids []int32
+	for _, p := range quote.Promotions {
+		ids = append(ids, p.RedemptionID)
+	}
+	if len(ids) == 0 {
+		return
+	}
+	if _, err := s.productClient.ReleasePromotionRedemptions(ctx, &pbProduct.ReleasePromotionRedemptionsRequest{RedemptionIds: ids}); err != nil {
+		log.Printf("Failed to release promotion redemptions %v: %v", ids, err)
+	}
 }
 
 // --- LỊCH HẸN ---
-// Tạo lịch hẹn
-func (s *AppService) CreateAppointment(ctx context.Context, customerID int32, customerAddress string, scheduledTime time.Time, services []AppointmentDetail, note string, branchID int32) (int32, string, error) {
+// Tạo lịch hẹn. Giờ hẹn phải ở tương lai, nằm trong giờ mở cửa, chi nhánh còn chỗ và
+// nhân viên được chọn (EmployeeID > 0) đang trong ca tại chi nhánh, không nghỉ phép và không bận lịch khác.
+// Thú cưng (appointment.Pets và PetID từng dịch vụ) phải thuộc khách hàng.
+// Không chọn nhân viên thì hệ thống tự phân công theo AssignmentStrategy.
+func (s *AppService) CreateAppointment(ctx context.Context, appointment *Appointment, services []AppointmentDetail, couponCodes []string) (int32, *PriceQuote, string, error) {
 	// Lấy danh sách service IDs từ request
 	var serviceIDs []int32
 	for _, item := range services {
@@ -60,30 +134,66 @@ func (s *AppService) CreateAppointment(ctx context.Context, customerID int32, cu
 	// Truy vấn database để lấy giá dịch vụ
 	serviceList, err := s.store.GetServicesByIDs(ctx, serviceIDs)
 	if err != nil {
-		return 0, "Failed", err
+		return 0, nil, "Failed", err
 	}
 
-	// Tạo map giá dịch vụ từ database
+	// Tạo map giá dịch vụ theo bảng giá tại thời điểm đặt lịch (tính cả giá hẹn trước vừa đến hạn)
+	bookedAt := time.Now()
 	servicePriceMap := make(map[int32]float32)
+	serviceMap := make(map[int32]Service, len(serviceList))
 	for _, svc := range serviceList {
-		servicePriceMap[svc.ID] = svc.Price
+		if svc.IsArchived {
+			return 0, nil, "Failed", fmt.Errorf("%w: service ID %d", ErrServiceArchived, svc.ID)
+		}
+		serviceMap[svc.ID] = svc
+		changes, err := s.store.ListServicePriceChanges(ctx, svc.ID)
+		if err != nil {
+			return 0, nil, "Failed", err
+		}
+		servicePriceMap[svc.ID] = pricing.PriceAt(svc.Price, changes, bookedAt)
 	}
 	for i, item := range services {
 		price, exists := servicePriceMap[item.ServiceID]
 		if !exists {
-			return 0, "Failed", fmt.Errorf("service ID %d not found", item.ServiceID)
+			return 0, nil, "Failed", fmt.Errorf("service ID %d not found", item.ServiceID)
 		}
 		services[i].ServicePrice = price
 	}
-	total, err := s.priceStrategy.CalculateTotal(services, servicePriceMap)
+	if err := s.checkPets(ctx, appointment, services); err != nil {
+		return 0, nil, "Failed", err
+	}
 
-	// Gọi Store để tạo lịch hẹn
-	id, err := s.store.CreateAppointment(ctx, customerID, customerAddress, scheduledTime, services, total, note, branchID)
+	duration := bookingDuration(serviceMap, services)
+	s.locate(ctx, appointment)
+	appointment.DurationMinutes = int32(duration / time.Minute)
+
+	// Kiểm tra còn chỗ, ghi lịch và tự phân công trong cùng khoá chi nhánh để hai lượt đặt không cùng lấy một chỗ
+	var id int32
+	var quote *PriceQuote
+	err = s.withBranchLock(ctx, appointment.BranchID, func() error {
+		if err := s.checkBookable(ctx, appointment.BranchID, appointment.EmployeeID, appointment.ScheduledTime, duration, 0, appointment.location()); err != nil {
+			return err
+		}
+		if quote, err = s.priceStrategy.CalculateTotal(ctx, appointment.CustomerID, services, couponCodes); err != nil {
+			return err
+		}
+
+		// Gọi Store để tạo lịch hẹn
+		if id, err = s.store.CreateAppointment(ctx, appointment, services, quote); err != nil {
+			s.priceStrategy.Cancel(ctx, quote)
+			return err
+		}
+
+		// Khách không chọn nhân viên thì tự phân công; lỗi phân công không làm hỏng lịch đã tạo
+		if appointment.EmployeeID == 0 {
+			s.autoAssignNew(ctx, appointment, services)
+		}
+		return nil
+	})
 	if err != nil {
-		return 0, "Failed", err
+		return 0, nil, "Failed", err
 	}
-
-	return id, "Success", nil
+	return id, quote, "Success", nil
 }
 
 // Lấy lịch hẹn theo khách hàng
@@ -101,47 +211,44 @@ func (s *AppService) GetAppointmentsByBranch(ctx context.Context, branchID int32
 	return s.store.GetAppointmentsByBranch(ctx, branchID)
 }
 
-// Cập nhật trạng thái lịch hẹn
-func (s *AppService) UpdateAppointmentStatus(ctx context.Context, appointmentID int32, status AppointmentStatus) (string, error) {
-	if err := s.store.UpdateAppointmentStatus(ctx, appointmentID, status); err != nil {
-		return "Failed", err
-	}
-	return "Success", nil
-}
-
 func (s *AppService) GetAppointmentDetails(ctx context.Context, appointmentID int32) (*Appointment, []AppointmentDetailWithService, error) {
 	// First, get the appointment
-	appointment, details, err := s.store.GetAppointmentDetails(ctx, appointmentID)
+	appointment, _, err := s.store.GetAppointmentDetails(ctx, appointmentID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get appointment: %w", err)
 	}
 
-	// Enhance details with service names
-	detailsWithService := make([]AppointmentDetailWithService, len(details))
-	for i, detail := range details {
-		// Get service name from service ID
-		service, err := s.store.GetServiceByID(ctx, detail.ServiceID)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to get service information: %w", err)
-		}
+	// Tên dịch vụ có sẵn trong Details.Service đã preload
+	return appointment, detailsWithServiceNames(appointment.Details), nil
+}
 
-		detailsWithService[i] = AppointmentDetailWithService{
+func detailsWithServiceNames(details []AppointmentDetail) []AppointmentDetailWithService {
+	result := make([]AppointmentDetailWithService, len(details))
+	for i, detail := range details {
+		result[i] = AppointmentDetailWithService{
 			AppointmentDetail: detail,
-			ServiceName:       service.Name,
+			ServiceName:       detail.Service.Name,
 		}
 	}
-
-	return appointment, detailsWithService, nil
+	return result
 }
 
 // --- DỊCH VỤ ---
 // Tạo dịch vụ
-func (s *AppService) CreateService(ctx context.Context, name, description string, price float32) (int32, string, error) {
+func (s *AppService) CreateService(ctx context.Context, name, description string, price float32, imgURL string, durationMinutes int32) (int32, string, error) {
+	if durationMinutes < 0 {
+		return 0, "Failed", fmt.Errorf("%w: duration_minutes must not be negative", ErrInvalidArgument)
+	}
+	if durationMinutes == 0 {
+		durationMinutes = int32(defaultServiceDuration / time.Minute)
+	}
 	service := &Service{
-		Name:        name,
-		Description: description,
-		Price:       price,
-		CreatedAt:   time.Now(),
+		Name:            name,
+		Description:     description,
+		Price:           price,
+		ImgUrl:          imgURL,
+		CreatedAt:       time.Now(),
+		DurationMinutes: durationMinutes,
 	}
 	if err := s.store.CreateService(ctx, service); err != nil {
 		return 0, "Failed", err
@@ -155,17 +262,31 @@ func (s *AppService) GetServices(ctx context.Context) ([]Service, error) {
 }
 
 // Cập nhật dịch vụ
-func (s *AppService) UpdateService(ctx context.Context, serviceID int32, name, description string, price float32) (string, error) {
+func (s *AppService) UpdateService(ctx context.Context, serviceID int32, name, description string, price float32, imgURL string, durationMinutes int32) (string, error) {
+	if durationMinutes < 0 {
+		return "Failed", fmt.Errorf("%w: duration_minutes must not be negative", ErrInvalidArgument)
+	}
+	existing, err := s.store.GetServiceByID(ctx, serviceID)
+	if err != nil {
+		return "Failed", err
+	}
+	// Updates bỏ qua trường rỗng nên ImgUrl = "" giữ nguyên ảnh hiện tại, DurationMinutes = 0 giữ nguyên thời lượng
 	service := &Service{
-		ID:          serviceID,
-		Name:        name,
-		Description: description,
-		Price:       price,
-		UpdatedAt:   time.Now(),
+		ID:              serviceID,
+		Name:            name,
+		Description:     description,
+		Price:           price,
+		ImgUrl:          imgURL,
+		UpdatedAt:       time.Now(),
+		DurationMinutes: durationMinutes,
 	}
 	if err := s.store.UpdateService(ctx, service); err != nil {
 		return "Failed", err
 	}
+	// Updates bỏ qua giá 0 nên chỉ ghi lịch sử khi giá thực sự được sửa
+	if price != 0 {
+		s.recordServicePriceChange(ctx, serviceID, existing.Price, price)
+	}
 	return "Success", nil
 }
 
@@ -176,8 +297,43 @@ func (s *AppService) DeleteService(ctx context.Context, serviceID int32) (string
 	}
 	return "Success", nil
 }
+
+// Khôi phục dịch vụ đã xoá
+func (s *AppService) RestoreService(ctx context.Context, serviceID int32) (string, error) {
+	if err := s.store.RestoreService(ctx, serviceID); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *AppService) ListArchivedServices(ctx context.Context) ([]Service, error) {
+	return s.store.ListArchivedServices(ctx)
+}
+
+// UpdateEmployeeForAppointment phân công nhân viên (employeeID = 0 là bỏ phân công). Nhân viên phải
+// có ca tại chi nhánh của lịch hẹn suốt thời gian hẹn, không nghỉ phép và không có lịch khác chồng giờ.
 func (s *AppService) UpdateEmployeeForAppointment(ctx context.Context, appointmentID, employeeID int32) (string, error) {
-	if err := s.store.UpdateAppointmentEmployee(ctx, appointmentID, employeeID); err != nil {
+	appointment, err := s.store.GetAppointmentByID(ctx, appointmentID)
+	if err != nil {
+		return "Failed", err
+	}
+	if !isUpcoming(appointment.Status) {
+		return "Failed", fmt.Errorf("%w: appointment %d is %s", ErrInvalidState, appointmentID, appointment.Status)
+	}
+	err = s.withBranchLock(ctx, appointment.BranchID, func() error {
+		if employeeID > 0 {
+			start, end := appointment.ScheduledTime, appointment.EndTime()
+			snapshot, err := s.loadBookingSnapshot(ctx, appointment.BranchID, 0, timeRange{start: start, end: end}, appointmentID, appointment.location())
+			if err != nil {
+				return err
+			}
+			if err := snapshot.checkEmployee(employeeID, start, end); err != nil {
+				return err
+			}
+		}
+		return s.store.UpdateAppointmentEmployee(ctx, appointmentID, employeeID)
+	})
+	if err != nil {
 		return "Failed", err
 	}
 	return "Success", nil
@@ -185,3 +341,33 @@ func (s *AppService) UpdateEmployeeForAppointment(ctx context.Context, appointme
 func (s *AppService) GetAllAppointments(ctx context.Context) ([]Appointment, error) {
 	return s.store.GetAllAppointments(ctx)
 }
+
+// --- ẢNH DỊCH VỤ ---
+func (s *AppService) AddServiceImages(ctx context.Context, images []ServiceImage) ([]ServiceImage, error) {
+	if len(images) == 0 {
+		return nil, fmt.Errorf("%w: at least one image is required", ErrInvalidArgument)
+	}
+	for _, image := range images {
+		if image.URL == "" {
+			return nil, fmt.Errorf("%w: image url is required", ErrInvalidArgument)
+		}
+		if image.ServiceID != images[0].ServiceID {
+			return nil, fmt.Errorf("%w: all images must belong to the same service", ErrInvalidArgument)
+		}
+	}
+	if _, err := s.store.GetServiceByID(ctx, images[0].ServiceID); err != nil {
+		return nil, fmt.Errorf("service %d: %w", images[0].ServiceID, err)
+	}
+	if err := s.store.AddServiceImages(ctx, images); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (s *AppService) ListServiceImages(ctx context.Context, serviceID int32) ([]ServiceImage, error) {
+	return s.store.ListServiceImages(ctx, serviceID)
+}
+
+func (s *AppService) DeleteServiceImage(ctx context.Context, imageID int32) (*ServiceImage, error) {
+	return s.store.DeleteServiceImage(ctx, imageID)
+}
@@ -0,0 +1,497 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+	_ "time/tzdata" // image chạy service không chắc có sẵn zoneinfo
+
+	pbProduct "github.com/quanbin27/commons/genproto/products"
+)
+
+// Khung giờ trống và chống đặt trùng lịch.
+// Giờ mở cửa, ngày nghỉ và sức chứa lịch hẹn của chi nhánh do Products service quản lý (GetBranchSchedule),
+// ca làm việc của nhân viên lưu ở đây. Một lịch hẹn chưa huỷ (và không bị đánh dấu vắng mặt) chiếm chỗ trong [ScheduledTime, EndTime()).
+
+const (
+	defaultServiceDuration = 30 * time.Minute
+	// maxAppointmentDuration giới hạn tổng thời gian một lịch hẹn, cũng là khoảng lùi khi tìm các lịch đang diễn ra
+	maxAppointmentDuration = 12 * time.Hour
+	maxShiftDuration       = 24 * time.Hour
+	slotInterval           = 30 * time.Minute
+	defaultBranchTimezone  = "Asia/Ho_Chi_Minh"
+	dateLayout             = "2006-01-02"
+	clockLayout            = "15:04"
+)
+
+type timeRange struct {
+	start, end time.Time
+}
+
+func serviceDuration(svc *Service) time.Duration {
+	if svc.DurationMinutes <= 0 {
+		return defaultServiceDuration
+	}
+	return time.Duration(svc.DurationMinutes) * time.Minute
+}
+
+// bookingDuration cộng thời gian các dịch vụ, mỗi lượt (Quantity) tính một lần
+func bookingDuration(services map[int32]Service, details []AppointmentDetail) time.Duration {
+	var total time.Duration
+	for _, item := range details {
+		svc := services[item.ServiceID]
+		quantity := item.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+		total += time.Duration(quantity) * serviceDuration(&svc)
+	}
+	return total
+}
+
+func branchLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		tz = defaultBranchTimezone
+	}
+	return time.LoadLocation(tz)
+}
+
+// localDay trả về khoảng [00:00, 24:00) của ngày chứa t theo giờ địa phương loc
+func localDay(t time.Time, loc *time.Location) timeRange {
+	local := t.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	return timeRange{start: start, end: start.AddDate(0, 0, 1)}
+}
+
+// openingWindows trả về các khoảng mở cửa của chi nhánh trong ngày day.
+// Giống Products service, chi nhánh chưa cấu hình giờ mở cửa được coi là mở cả ngày.
+func openingWindows(schedule *pbProduct.BranchSchedule, day timeRange) ([]timeRange, error) {
+	if !schedule.IsActive {
+		return nil, nil
+	}
+	date := day.start.Format(dateLayout)
+	for _, c := range schedule.Closures {
+		if date >= c.StartDate && date <= c.EndDate {
+			return nil, nil
+		}
+	}
+	if len(schedule.OpeningHours) == 0 {
+		return []timeRange{day}, nil
+	}
+	var windows []timeRange
+	for _, h := range schedule.OpeningHours {
+		if h.Weekday != int32(day.start.Weekday()) {
+			continue
+		}
+		open, err := time.Parse(clockLayout, h.OpenTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid branch opening time %q", h.OpenTime)
+		}
+		closeAt, err := time.Parse(clockLayout, h.CloseTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid branch closing time %q", h.CloseTime)
+		}
+		y, m, d := day.start.Date()
+		loc := day.start.Location()
+		windows = append(windows, timeRange{
+			start: time.Date(y, m, d, open.Hour(), open.Minute(), 0, 0, loc),
+			end:   time.Date(y, m, d, closeAt.Hour(), closeAt.Minute(), 0, 0, loc),
+		})
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i].start.Before(windows[j].start) })
+	return windows, nil
+}
+
+func overlaps(a *Appointment, start, end time.Time) bool {
+	return a.ScheduledTime.Before(end) && a.EndTime().After(start)
+}
+
+// peakOverlap - số lịch hẹn diễn ra đồng thời nhiều nhất trong [start, end).
+// Số lịch chỉ tăng tại start hoặc lúc một lịch bắt đầu nên chỉ cần đếm tại các thời điểm đó.
+func peakOverlap(appointments []Appointment, start, end time.Time) int {
+	points := []time.Time{start}
+	for i := range appointments {
+		a := &appointments[i]
+		if overlaps(a, start, end) && a.ScheduledTime.After(start) {
+			points = append(points, a.ScheduledTime)
+		}
+	}
+	peak := 0
+	for _, p := range points {
+		count := 0
+		for i := range appointments {
+			a := &appointments[i]
+			if !a.ScheduledTime.After(p) && a.EndTime().After(p) {
+				count++
+			}
+		}
+		if count > peak {
+			peak = count
+		}
+	}
+	return peak
+}
+
+// bookingSnapshot - lịch hẹn và ca làm việc của chi nhánh quanh một ngày, tải một lần rồi đánh giá nhiều khung giờ.
+// Lịch hẹn excludeID (đang được xếp lại) không được tính. visit là địa chỉ của lịch đang xếp: khi có, nhân viên
+// phải kịp di chuyển từ lịch tại nhà trước đó tới visit và từ visit tới lịch kế tiếp (lịch chưa có toạ độ bỏ qua).
+type bookingSnapshot struct {
+	capacity     int32                     // 0 = không giới hạn
+	appointments []Appointment             // lịch còn giữ chỗ của chi nhánh
+	shifts       []EmployeeShift           // ca tại chi nhánh trong ngày
+	busy         map[int32][]Appointment   // lịch của các nhân viên có ca, ở mọi chi nhánh
+	leaves       map[int32][]EmployeeLeave // đơn nghỉ đã duyệt của các nhân viên có ca
+	visit        *GeoPoint
+	travel       func(from, to GeoPoint) time.Duration
+}
+
+func (s *AppService) loadBookingSnapshot(ctx context.Context, branchID, capacity int32, window timeRange, excludeID int32, visit *GeoPoint) (*bookingSnapshot, error) {
+	appointments, err := s.store.ListBranchAppointmentsBetween(ctx, branchID, window.start.Add(-maxAppointmentDuration), window.end)
+	if err != nil {
+		return nil, err
+	}
+	shifts, err := s.store.ListEmployeeShifts(ctx, branchID, 0, window.start, window.end)
+	if err != nil {
+		return nil, err
+	}
+	var employeeIDs []int32
+	seen := make(map[int32]bool)
+	for _, shift := range shifts {
+		if !seen[shift.EmployeeID] {
+			seen[shift.EmployeeID] = true
+			employeeIDs = append(employeeIDs, shift.EmployeeID)
+		}
+	}
+	// Lấy thêm maxTravelTime sau window để thấy lịch kế tiếp khi tính thời gian di chuyển
+	employeeAppointments, err := s.store.ListEmployeeAppointmentsBetween(ctx, employeeIDs, window.start.Add(-maxAppointmentDuration), window.end.Add(maxTravelTime))
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &bookingSnapshot{
+		capacity: capacity,
+		shifts:   shifts,
+		busy:     make(map[int32][]Appointment),
+		leaves:   make(map[int32][]EmployeeLeave),
+		visit:    visit,
+		travel:   s.policy.travelTime,
+	}
+	if len(employeeIDs) > 0 {
+		leaves, err := s.store.ListLeaves(ctx, employeeIDs, []LeaveStatus{LeaveApproved}, window.start, window.end)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range leaves {
+			snapshot.leaves[l.EmployeeID] = append(snapshot.leaves[l.EmployeeID], l)
+		}
+	}
+	for _, a := range appointments {
+		if a.ID != excludeID {
+			snapshot.appointments = append(snapshot.appointments, a)
+		}
+	}
+	for _, a := range employeeAppointments {
+		if a.ID != excludeID {
+			snapshot.busy[a.EmployeeID] = append(snapshot.busy[a.EmployeeID], a)
+		}
+	}
+	return snapshot, nil
+}
+
+// employeeBusy kiểm tra nhân viên đã có lịch hẹn giao với [start, end) hoặc không kịp di chuyển tới/từ lịch khác
+func (b *bookingSnapshot) employeeBusy(employeeID int32, start, end time.Time) bool {
+	return b.employeeOverlap(employeeID, start, end) || b.travelConflict(employeeID, start, end)
+}
+
+func (b *bookingSnapshot) employeeOverlap(employeeID int32, start, end time.Time) bool {
+	for i := range b.busy[employeeID] {
+		if overlaps(&b.busy[employeeID][i], start, end) {
+			return true
+		}
+	}
+	return false
+}
+
+// travelConflict - lịch [start, end) tại visit không chừa đủ thời gian di chuyển với lịch liền trước/liền sau của nhân viên
+func (b *bookingSnapshot) travelConflict(employeeID int32, start, end time.Time) bool {
+	if b.visit == nil {
+		return false
+	}
+	for i := range b.busy[employeeID] {
+		a := &b.busy[employeeID][i]
+		other := a.location()
+		if other == nil {
+			continue
+		}
+		if !a.ScheduledTime.Before(end) && a.ScheduledTime.Before(end.Add(b.travel(*b.visit, *other))) {
+			return true
+		}
+		if !a.EndTime().After(start) && a.EndTime().Add(b.travel(*other, *b.visit)).After(start) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *bookingSnapshot) onLeave(employeeID int32, start, end time.Time) bool {
+	for _, l := range b.leaves[employeeID] {
+		if l.StartTime.Before(end) && l.EndTime.After(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// onShift - nhân viên có ca tại chi nhánh phủ kín [start, end) và không nghỉ phép trong khoảng đó
+func (b *bookingSnapshot) onShift(employeeID int32, start, end time.Time) bool {
+	if b.onLeave(employeeID, start, end) {
+		return false
+	}
+	for _, shift := range b.shifts {
+		if shift.EmployeeID == employeeID && !shift.StartTime.After(start) && !shift.EndTime.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// freeEmployees - nhân viên có ca phủ kín [start, end) và chưa có lịch hẹn khác trong khoảng đó
+func (b *bookingSnapshot) freeEmployees(start, end time.Time) []int32 {
+	var free []int32
+	seen := make(map[int32]bool)
+	for _, shift := range b.shifts {
+		if seen[shift.EmployeeID] {
+			continue
+		}
+		seen[shift.EmployeeID] = true
+		if b.onShift(shift.EmployeeID, start, end) && !b.employeeBusy(shift.EmployeeID, start, end) {
+			free = append(free, shift.EmployeeID)
+		}
+	}
+	return free
+}
+
+// evaluate trả về số chỗ còn lại (-1 = không giới hạn) và nhân viên còn rảnh trong [start, end),
+// hoặc ErrSlotUnavailable kèm lý do khi khung giờ đã kín
+func (b *bookingSnapshot) evaluate(start, end time.Time) (int32, []int32, error) {
+	remaining := int32(-1)
+	if b.capacity > 0 {
+		remaining = b.capacity - int32(peakOverlap(b.appointments, start, end))
+		if remaining <= 0 {
+			return 0, nil, fmt.Errorf("%w: branch is fully booked at %s", ErrSlotUnavailable, start.Format(time.RFC3339))
+		}
+	}
+	if len(b.shifts) == 0 {
+		return remaining, nil, nil
+	}
+	// Lịch chưa phân công nhân viên cũng cần một người trong ca
+	var unassigned []Appointment
+	for _, a := range b.appointments {
+		if a.EmployeeID == 0 {
+			unassigned = append(unassigned, a)
+		}
+	}
+	free := b.freeEmployees(start, end)
+	if len(free) <= peakOverlap(unassigned, start, end) {
+		return 0, nil, fmt.Errorf("%w: no staff on shift is free at %s", ErrSlotUnavailable, start.Format(time.RFC3339))
+	}
+	return remaining, free, nil
+}
+
+// checkEmployee kiểm tra nhân viên có thể nhận lịch [start, end): đang trong ca tại chi nhánh,
+// không nghỉ phép và không có lịch hẹn khác chồng giờ
+func (b *bookingSnapshot) checkEmployee(employeeID int32, start, end time.Time) error {
+	if b.onLeave(employeeID, start, end) {
+		return fmt.Errorf("%w: employee %d is on leave at that time", ErrSlotUnavailable, employeeID)
+	}
+	if !b.onShift(employeeID, start, end) {
+		return fmt.Errorf("%w: employee %d is not on shift at this branch at that time", ErrSlotUnavailable, employeeID)
+	}
+	if b.employeeOverlap(employeeID, start, end) {
+		return fmt.Errorf("%w: employee %d already has an appointment at that time", ErrSlotUnavailable, employeeID)
+	}
+	if b.travelConflict(employeeID, start, end) {
+		return fmt.Errorf("%w: employee %d cannot travel between home visits in time", ErrSlotUnavailable, employeeID)
+	}
+	return nil
+}
+
+func (s *AppService) branchSchedule(ctx context.Context, branchID int32) (*pbProduct.BranchSchedule, *time.Location, error) {
+	schedule, err := s.productClient.GetBranchSchedule(ctx, &pbProduct.GetBranchScheduleRequest{BranchId: branchID})
+	if err != nil {
+		return nil, nil, err
+	}
+	loc, err := branchLocation(schedule.Timezone)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule, loc, nil
+}
+
+// withBranchLock chạy fn khi đang giữ khoá đặt lịch của chi nhánh. Mọi chỗ kiểm tra còn chỗ / nhân viên rảnh
+// rồi mới ghi lịch (tạo, dời, phân công) phải kiểm tra lại bên trong fn, nếu không hai request cùng lúc
+// đều thấy còn chỗ và cùng ghi. Không gọi lồng nhau: khoá nằm trên connection riêng nên lần gọi trong sẽ chờ tới hết hạn.
+func (s *AppService) withBranchLock(ctx context.Context, branchID int32, fn func() error) error {
+	unlock, err := s.store.LockBranchBookings(ctx, branchID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return fn()
+}
+
+// checkBookable kiểm tra lịch [start, start+duration) tại chi nhánh: trong giờ mở cửa, còn chỗ
+// và (nếu có) nhân viên được chọn đang trong ca, rảnh. excludeID bỏ qua chính lịch hẹn đang được xếp lại.
+// visit - toạ độ địa chỉ hẹn (nil = không tính thời gian di chuyển của nhân viên)
+func (s *AppService) checkBookable(ctx context.Context, branchID, employeeID int32, start time.Time, duration time.Duration, excludeID int32, visit *GeoPoint) error {
+	if !start.After(time.Now()) {
+		return fmt.Errorf("%w: scheduled time must be in the future", ErrInvalidArgument)
+	}
+	if duration > maxAppointmentDuration {
+		return fmt.Errorf("%w: appointment must not last longer than %s", ErrInvalidArgument, maxAppointmentDuration)
+	}
+	schedule, loc, err := s.branchSchedule(ctx, branchID)
+	if err != nil {
+		return err
+	}
+	end := start.Add(duration)
+	day := localDay(start, loc)
+	windows, err := openingWindows(schedule, day)
+	if err != nil {
+		return err
+	}
+	open := false
+	for _, w := range windows {
+		if !start.Before(w.start) && !end.After(w.end) {
+			open = true
+			break
+		}
+	}
+	if !open {
+		return fmt.Errorf("%w: %s - %s is outside branch opening hours", ErrInvalidState, start.In(loc).Format(clockLayout), end.In(loc).Format(clockLayout))
+	}
+	snapshot, err := s.loadBookingSnapshot(ctx, branchID, schedule.AppointmentCapacity, day, excludeID, visit)
+	if err != nil {
+		return err
+	}
+	if _, _, err := snapshot.evaluate(start, end); err != nil {
+		return err
+	}
+	if employeeID > 0 {
+		return snapshot.checkEmployee(employeeID, start, end)
+	}
+	return nil
+}
+
+// GetAvailableSlots liệt kê các khung giờ (cách nhau slotInterval) trong ngày date mà chi nhánh còn nhận được
+// lịch gồm các dịch vụ serviceIDs; khung giờ đã qua không được trả về
+func (s *AppService) GetAvailableSlots(ctx context.Context, branchID int32, serviceIDs []int32, date, customerAddress string) (*SlotAvailability, error) {
+	if len(serviceIDs) == 0 {
+		return nil, fmt.Errorf("%w: at least one service is required", ErrInvalidArgument)
+	}
+	services, err := s.store.GetServicesByIDs(ctx, serviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	serviceMap := make(map[int32]Service, len(services))
+	for _, svc := range services {
+		if svc.IsArchived {
+			return nil, fmt.Errorf("%w: service ID %d", ErrServiceArchived, svc.ID)
+		}
+		serviceMap[svc.ID] = svc
+	}
+	details := make([]AppointmentDetail, len(serviceIDs))
+	for i, id := range serviceIDs {
+		if _, ok := serviceMap[id]; !ok {
+			return nil, fmt.Errorf("%w: service ID %d not found", ErrInvalidArgument, id)
+		}
+		details[i] = AppointmentDetail{ServiceID: id, Quantity: 1}
+	}
+	duration := bookingDuration(serviceMap, details)
+	if duration > maxAppointmentDuration {
+		return nil, fmt.Errorf("%w: appointment must not last longer than %s", ErrInvalidArgument, maxAppointmentDuration)
+	}
+
+	schedule, loc, err := s.branchSchedule(ctx, branchID)
+	if err != nil {
+		return nil, err
+	}
+	dayStart, err := time.ParseInLocation(dateLayout, date, loc)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	day := localDay(dayStart, loc)
+	result := &SlotAvailability{Timezone: loc.String(), Duration: duration}
+	windows, err := openingWindows(schedule, day)
+	if err != nil || len(windows) == 0 {
+		return result, err
+	}
+	snapshot, err := s.loadBookingSnapshot(ctx, branchID, schedule.AppointmentCapacity, day, 0, s.geocode(ctx, customerAddress))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, w := range windows {
+		for start := w.start; !start.Add(duration).After(w.end); start = start.Add(slotInterval) {
+			if !start.After(now) {
+				continue
+			}
+			end := start.Add(duration)
+			remaining, employees, err := snapshot.evaluate(start, end)
+			if err != nil {
+				continue
+			}
+			result.Slots = append(result.Slots, AvailableSlot{
+				Start:             start,
+				End:               end,
+				RemainingCapacity: remaining,
+				EmployeeIDs:       employees,
+			})
+		}
+	}
+	return result, nil
+}
+
+// --- CA LÀM VIỆC ---
+func (s *AppService) CreateEmployeeShift(ctx context.Context, shift *EmployeeShift) (*EmployeeShift, error) {
+	if shift.EmployeeID <= 0 || shift.BranchID <= 0 {
+		return nil, fmt.Errorf("%w: employee_id and branch_id are required", ErrInvalidArgument)
+	}
+	if !shift.EndTime.After(shift.StartTime) {
+		return nil, fmt.Errorf("%w: end_time must be after start_time", ErrInvalidArgument)
+	}
+	if shift.EndTime.Sub(shift.StartTime) > maxShiftDuration {
+		return nil, fmt.Errorf("%w: shift must not last longer than %s", ErrInvalidArgument, maxShiftDuration)
+	}
+	// Một nhân viên không thể có hai ca chồng nhau, kể cả ở hai chi nhánh khác nhau
+	existing, err := s.store.ListEmployeeShifts(ctx, 0, shift.EmployeeID, shift.StartTime, shift.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, fmt.Errorf("%w: shift overlaps shift %d of employee %d", ErrInvalidState, existing[0].ID, shift.EmployeeID)
+	}
+	if err := s.store.CreateEmployeeShift(ctx, shift); err != nil {
+		return nil, err
+	}
+	return shift, nil
+}
+
+func (s *AppService) ListEmployeeShifts(ctx context.Context, branchID, employeeID int32, from, to time.Time) ([]EmployeeShift, error) {
+	if !to.After(from) {
+		return nil, fmt.Errorf("%w: to must be after from", ErrInvalidArgument)
+	}
+	return s.store.ListEmployeeShifts(ctx, branchID, employeeID, from, to)
+}
+
+// DeleteEmployeeShift xoá ca và phân công lại các lịch hẹn của nhân viên không còn ca phủ
+func (s *AppService) DeleteEmployeeShift(ctx context.Context, id int32) (string, []Reassignment, error) {
+	shift, err := s.store.GetEmployeeShiftByID(ctx, id)
+	if err != nil {
+		return "Failed", nil, err
+	}
+	if err := s.store.DeleteEmployeeShift(ctx, id); err != nil {
+		return "Failed", nil, err
+	}
+	return "Success", s.reassignUncovered(ctx, shift.EmployeeID, shift.BranchID, shift.StartTime, shift.EndTime), nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTravelConflict(t *testing.T) {
+	const employeeID = 7
+	visit := &GeoPoint{Lat: 10.78, Lng: 106.70}
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	// Lịch tại nhà khác của nhân viên, kéo dài 60 phút
+	homeVisit := func(at time.Time) Appointment {
+		return Appointment{EmployeeID: employeeID, ScheduledTime: at, DurationMinutes: 60, Geocoded: true, Latitude: 10.80, Longitude: 106.65}
+	}
+	fixedTravel := func(from, to GeoPoint) time.Duration { return 30 * time.Minute }
+
+	tests := []struct {
+		name   string
+		visit  *GeoPoint
+		travel func(from, to GeoPoint) time.Duration
+		busy   []Appointment
+		want   bool
+	}{
+		{name: "no visit address", visit: nil, busy: []Appointment{homeVisit(end)}, want: false},
+		{name: "no other appointments", visit: visit, want: false},
+		{name: "other appointment without location", visit: visit, busy: []Appointment{{EmployeeID: employeeID, ScheduledTime: end, DurationMinutes: 60}}, want: false},
+		// Lịch kế tiếp: phải kịp đi từ visit tới đó sau khi xong
+		{name: "next visit starts before travel ends", visit: visit, busy: []Appointment{homeVisit(end.Add(20 * time.Minute))}, want: true},
+		{name: "next visit starts right at end", visit: visit, busy: []Appointment{homeVisit(end)}, want: true},
+		{name: "next visit leaves exactly the travel time", visit: visit, busy: []Appointment{homeVisit(end.Add(30 * time.Minute))}, want: false},
+		// Lịch liền trước: phải kịp đi từ đó tới visit trước start
+		{name: "previous visit ends too late", visit: visit, busy: []Appointment{homeVisit(start.Add(-80 * time.Minute))}, want: true},
+		{name: "previous visit ends right at start", visit: visit, busy: []Appointment{homeVisit(start.Add(-time.Hour))}, want: true},
+		{name: "previous visit leaves exactly the travel time", visit: visit, busy: []Appointment{homeVisit(start.Add(-90 * time.Minute))}, want: false},
+		// Lịch giao nhau do employeeOverlap xử lý, không tính là thiếu thời gian di chuyển
+		{name: "overlapping appointment", visit: visit, busy: []Appointment{homeVisit(start.Add(30 * time.Minute))}, want: false},
+		{name: "travel time disabled", visit: visit, travel: BookingPolicy{}.travelTime, busy: []Appointment{homeVisit(end)}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			travel := tt.travel
+			if travel == nil {
+				travel = fixedTravel
+			}
+			b := &bookingSnapshot{busy: map[int32][]Appointment{employeeID: tt.busy}, visit: tt.visit, travel: travel}
+			if got := b.travelConflict(employeeID, start, end); got != tt.want {
+				t.Errorf("travelConflict() = %v, want %v", got, tt.want)
+			}
+			// Lịch của nhân viên khác không ảnh hưởng
+			if b.travelConflict(employeeID+1, start, end) {
+				t.Errorf("travelConflict() of another employee = true, want false")
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Trạng thái lịch hẹn: pending -> confirmed -> in_progress -> completed.
+// Lịch chưa bắt đầu làm (pending/confirmed) có thể bị huỷ trước giờ hẹn, hoặc đánh dấu khách không đến sau giờ hẹn.
+// Huỷ trong vòng BookingPolicy.CancelDeadline trước giờ hẹn bị tính phí theo LateCancelFeePercent tổng tiền.
+
+var appointmentTransitions = map[AppointmentStatus][]AppointmentStatus{
+	StatusPending:    {StatusConfirmed, StatusCancelled, StatusNoShow},
+	StatusConfirmed:  {StatusInProgress, StatusCancelled, StatusNoShow},
+	StatusInProgress: {StatusCompleted},
+}
+
+func canTransition(from, to AppointmentStatus) bool {
+	for _, next := range appointmentTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// isUpcoming - lịch chưa bắt đầu làm và chưa kết thúc (còn giữ chỗ, còn phân công được)
+func isUpcoming(status AppointmentStatus) bool {
+	return status == StatusPending || status == StatusConfirmed
+}
+
+// lateCancelFee - phí huỷ lịch tại thời điểm now
+func (p BookingPolicy) lateCancelFee(appointment *Appointment, now time.Time) float32 {
+	if p.LateCancelFeePercent <= 0 || now.Before(appointment.ScheduledTime.Add(-p.CancelDeadline)) {
+		return 0
+	}
+	return appointment.Total * p.LateCancelFeePercent / 100
+}
+
+// UpdateAppointmentStatus đổi trạng thái theo luồng cho phép và ghi lịch sử; trả về phí huỷ muộn (nếu có).
+// customerID > 0 là khách tự thao tác: chỉ được huỷ lịch của chính mình.
+func (s *AppService) UpdateAppointmentStatus(ctx context.Context, appointmentID int32, status AppointmentStatus, reason string, changedBy, customerID int32) (float32, string, error) {
+	if status == "" {
+		return 0, "Failed", fmt.Errorf("%w: unknown appointment status", ErrInvalidArgument)
+	}
+	if customerID > 0 && status != StatusCancelled {
+		return 0, "Failed", fmt.Errorf("%w: customers can only cancel appointments", ErrInvalidArgument)
+	}
+	appointment, err := s.store.GetAppointmentByID(ctx, appointmentID)
+	if err != nil {
+		return 0, "Failed", err
+	}
+	if customerID > 0 && appointment.CustomerID != customerID {
+		return 0, "Failed", fmt.Errorf("appointment %d: %w", appointmentID, gorm.ErrRecordNotFound)
+	}
+	if !canTransition(appointment.Status, status) {
+		return 0, "Failed", fmt.Errorf("%w: cannot change appointment %d from %s to %s", ErrInvalidState, appointmentID, appointment.Status, status)
+	}
+
+	reason = strings.TrimSpace(reason)
+	now := time.Now()
+	var fee float32
+	switch status {
+	case StatusCancelled:
+		if reason == "" {
+			return 0, "Failed", fmt.Errorf("%w: reason is required to cancel an appointment", ErrInvalidArgument)
+		}
+		if !now.Before(appointment.ScheduledTime) {
+			return 0, "Failed", fmt.Errorf("%w: appointment %d has already started, mark it as no_show instead", ErrInvalidState, appointmentID)
+		}
+		fee = s.policy.lateCancelFee(appointment, now)
+	case StatusNoShow:
+		if now.Before(appointment.ScheduledTime) {
+			return 0, "Failed", fmt.Errorf("%w: appointment %d has not started yet", ErrInvalidState, appointmentID)
+		}
+	}
+
+	change := &AppointmentStatusHistory{
+		AppointmentID:   appointmentID,
+		ToStatus:        status,
+		Reason:          reason,
+		ChangedBy:       changedBy,
+		CancellationFee: fee,
+	}
+	if err := s.store.ChangeAppointmentStatus(ctx, appointment.Status, change); err != nil {
+		return 0, "Failed", err
+	}
+	if status == StatusCancelled {
+		s.releasePromotions(ctx, appointmentID)
+	}
+	return fee, "Success", nil
+}
+
+// releasePromotions trả lại lượt dùng khuyến mãi của lịch vừa huỷ. Lịch đã huỷ xong nên lỗi chỉ ghi log.
+func (s *AppService) releasePromotions(ctx context.Context, appointmentID int32) {
+	appointment, _, err := s.store.GetAppointmentDetails(ctx, appointmentID)
+	if err != nil {
+		log.Printf("Failed to load promotions of cancelled appointment %d: %v", appointmentID, err)
+		return
+	}
+	s.priceStrategy.Cancel(ctx, &PriceQuote{Promotions: appointment.Promotions})
+}
+
+// GetAppointmentStatusHistory - customerID > 0: khách chỉ xem được lịch sử lịch hẹn của chính mình
+func (s *AppService) GetAppointmentStatusHistory(ctx context.Context, appointmentID, customerID int32) ([]AppointmentStatusHistory, error) {
+	appointment, err := s.store.GetAppointmentByID(ctx, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+	if customerID > 0 && appointment.CustomerID != customerID {
+		return nil, fmt.Errorf("appointment %d: %w", appointmentID, gorm.ErrRecordNotFound)
+	}
+	return s.store.ListAppointmentStatusHistory(ctx, appointmentID)
+}
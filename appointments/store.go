@@ -2,9 +2,15 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // --- APPOINTMENT STORE ---
@@ -17,23 +23,70 @@ func NewStore(db *gorm.DB) *Store {
 	return &Store{db: db}
 }
 
+// bookingLockTimeout - Thời gian tối đa chờ khoá đặt lịch của chi nhánh
+const bookingLockTimeout = 10 * time.Second
+
+// LockBranchBookings lấy khoá MySQL GET_LOCK theo chi nhánh trên một connection riêng, để mọi bước kiểm tra
+// còn chỗ rồi ghi lịch của cùng chi nhánh (kể cả từ nhiều instance) chạy lần lượt. Khoá gắn với connection nên
+// nhả khoá lỗi thì bỏ luôn connection, MySQL tự nhả khoá khi connection đóng.
+func (s *Store) LockBranchBookings(ctx context.Context, branchID int32) (func(), error) {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := fmt.Sprintf("appointments:branch:%d", branchID)
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", name, int(bookingLockTimeout/time.Second)).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if acquired.Int64 != 1 {
+		conn.Close()
+		return nil, fmt.Errorf("%w: branch %d", ErrBookingBusy, branchID)
+	}
+	return func() {
+		// Không dùng ctx của request: request bị huỷ vẫn phải nhả khoá
+		if _, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", name); err != nil {
+			log.Printf("Failed to release booking lock of branch %d: %v", branchID, err)
+			conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		}
+		conn.Close()
+	}, nil
+}
+
 // Tạo lịch hẹn + chi tiết dịch vụ
-func (s *Store) CreateAppointment(ctx context.Context, customerID int32, customerAddress string, scheduledTime time.Time, services []AppointmentDetail, total float32, note string, branchID int32) (int32, error) {
+func (s *Store) CreateAppointment(ctx context.Context, appointment *Appointment, services []AppointmentDetail, quote *PriceQuote) (int32, error) {
 	var appointmentID int32
 
 	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// Tạo lịch hẹn
-		appointment := Appointment{
-			CustomerID:      customerID,
-			CustomerAddress: customerAddress,
-			ScheduledTime:   scheduledTime,
-			Status:          StatusPending,
-			Total:           total,
-			Note:            note,
-			BranchID:        branchID,
+		appointment.Status = StatusPending
+		appointment.Subtotal = quote.Subtotal
+		appointment.Discount = quote.Discount
+		appointment.Total = quote.Total
+		appointment.Promotions = quote.Promotions // GORM tạo các dòng khuyến mãi cùng transaction
+
+		if appointment.SeriesID != 0 {
+			// Khoá dòng lịch định kỳ tới hết transaction: CancelAppointmentSeries phải chờ lần hẹn này
+			// commit (rồi huỷ nó), và không sinh thêm lần hẹn cho lịch đã bị huỷ
+			var series AppointmentSeries
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status").
+				First(&series, int32(appointment.SeriesID)).Error; err != nil {
+				return err
+			}
+			if series.Status != SeriesActive {
+				return fmt.Errorf("%w: series %d is %s", ErrSeriesInactive, series.ID, series.Status)
+			}
 		}
 
-		if err := tx.Create(&appointment).Error; err != nil {
+		if err := tx.Create(appointment).Error; err != nil {
+			if appointment.SeriesID != 0 && errors.Is(err, gorm.ErrDuplicatedKey) {
+				return fmt.Errorf("%w: series %d occurrence %d", ErrOccurrenceExists, appointment.SeriesID, appointment.SeriesIndex)
+			}
 			return err
 		}
 
@@ -45,6 +98,7 @@ func (s *Store) CreateAppointment(ctx context.Context, customerID int32, custome
 				ServiceID:     svc.ServiceID,
 				ServicePrice:  svc.ServicePrice, // Giá đã được lấy từ DB ở tầng AppService
 				Quantity:      svc.Quantity,
+				PetID:         svc.PetID,
 			}
 			details = append(details, detail)
 		}
@@ -56,6 +110,11 @@ func (s *Store) CreateAppointment(ctx context.Context, customerID int32, custome
 			}
 		}
 
+		// Dòng lịch sử đầu tiên: lịch hẹn được tạo
+		if err := tx.Create(&AppointmentStatusHistory{AppointmentID: appointment.ID, ToStatus: StatusPending}).Error; err != nil {
+			return err
+		}
+
 		appointmentID = appointment.ID
 		return nil
 	})
@@ -81,10 +140,55 @@ func (s *Store) GetServiceByID(ctx context.Context, serviceID int32) (Service, e
 	return service, nil
 }
 
+func (s *Store) GetAppointmentByID(ctx context.Context, appointmentID int32) (*Appointment, error) {
+	var appointment Appointment
+	if err := s.db.WithContext(ctx).First(&appointment, appointmentID).Error; err != nil {
+		return nil, err
+	}
+	return &appointment, nil
+}
+
+// inactiveStatuses - lịch hẹn không còn giữ chỗ của chi nhánh/nhân viên
+var inactiveStatuses = []AppointmentStatus{StatusCancelled, StatusNoShow}
+
+// Lịch hẹn còn giữ chỗ của chi nhánh bắt đầu trong [from, to)
+func (s *Store) ListBranchAppointmentsBetween(ctx context.Context, branchID int32, from, to time.Time) ([]Appointment, error) {
+	var appointments []Appointment
+	err := s.db.WithContext(ctx).
+		Where("branch_id = ? AND status NOT IN ? AND scheduled_time >= ? AND scheduled_time < ?", branchID, inactiveStatuses, from, to).
+		Order("scheduled_time").
+		Find(&appointments).Error
+	return appointments, err
+}
+
+// Lịch hẹn còn giữ chỗ của các nhân viên (ở mọi chi nhánh) bắt đầu trong [from, to)
+func (s *Store) ListEmployeeAppointmentsBetween(ctx context.Context, employeeIDs []int32, from, to time.Time) ([]Appointment, error) {
+	var appointments []Appointment
+	if len(employeeIDs) == 0 {
+		return appointments, nil
+	}
+	err := s.db.WithContext(ctx).
+		Where("employee_id IN ? AND status NOT IN ? AND scheduled_time >= ? AND scheduled_time < ?", employeeIDs, inactiveStatuses, from, to).
+		Order("scheduled_time").
+		Find(&appointments).Error
+	return appointments, err
+}
+
+func (s *Store) ListEmployeeAgenda(ctx context.Context, employeeID int32, from, to time.Time) ([]Appointment, error) {
+	var appointments []Appointment
+	err := s.db.WithContext(ctx).
+		Preload("Details.Service").
+		Preload("Pets").
+		Where("employee_id = ? AND status NOT IN ? AND scheduled_time >= ? AND scheduled_time < ?", employeeID, inactiveStatuses, from, to).
+		Order("scheduled_time").
+		Find(&appointments).Error
+	return appointments, err
+}
+
 // Lấy lịch hẹn theo khách hàng
 func (s *Store) GetAppointmentsByCustomer(ctx context.Context, customerID int32) ([]Appointment, error) {
 	var appointments []Appointment
-	err := s.db.WithContext(ctx).Where("customer_id = ?", customerID).Find(&appointments).Error
+	err := s.db.WithContext(ctx).Preload("Pets").Where("customer_id = ?", customerID).Find(&appointments).Error
 
 	return appointments, err
 }
@@ -92,27 +196,99 @@ func (s *Store) GetAppointmentsByCustomer(ctx context.Context, customerID int32)
 // Lấy lịch hẹn theo nhân viên
 func (s *Store) GetAppointmentsByEmployee(ctx context.Context, employeeID int32) ([]Appointment, error) {
 	var appointments []Appointment
-	err := s.db.WithContext(ctx).Where("employee_id = ?", employeeID).Find(&appointments).Error
+	err := s.db.WithContext(ctx).Preload("Pets").Where("employee_id = ?", employeeID).Find(&appointments).Error
 	return appointments, err
 }
 
 // Lấy lịch hẹn theo chi nhanh
 func (s *Store) GetAppointmentsByBranch(ctx context.Context, branchID int32) ([]Appointment, error) {
 	var appointments []Appointment
-	err := s.db.WithContext(ctx).Where("branch_id = ?", branchID).Find(&appointments).Error
+	err := s.db.WithContext(ctx).Preload("Pets").Where("branch_id = ?", branchID).Find(&appointments).Error
 	return appointments, err
 }
 func (s *Store) GetAllAppointments(ctx context.Context) ([]Appointment, error) {
 	var appointments []Appointment
-	err := s.db.WithContext(ctx).Find(&appointments).Error
+	err := s.db.WithContext(ctx).Preload("Pets").Find(&appointments).Error
 	return appointments, err
 }
 
-// Cập nhật trạng thái lịch hẹn
-func (s *Store) UpdateAppointmentStatus(ctx context.Context, appointmentID int32, status AppointmentStatus) error {
-	return s.db.WithContext(ctx).Model(&Appointment{}).
-		Where("id = ?", appointmentID).
-		Update("status", status).Error
+// Đổi trạng thái lịch hẹn. Điều kiện status = from chặn hai yêu cầu đổi trạng thái cùng lúc;
+// from khác change.ToStatus nên RowsAffected luôn phản ánh đúng số dòng khớp điều kiện.
+func (s *Store) ChangeAppointmentStatus(ctx context.Context, from AppointmentStatus, change *AppointmentStatusHistory) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&Appointment{}).
+			Where("id = ? AND status = ?", change.AppointmentID, from).
+			Updates(map[string]interface{}{
+				"status":           change.ToStatus,
+				"cancellation_fee": change.CancellationFee,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			var appointment Appointment
+			if err := tx.Select("id", "status").First(&appointment, change.AppointmentID).Error; err != nil {
+				return err
+			}
+			return fmt.Errorf("%w: appointment %d is already %s", ErrInvalidState, change.AppointmentID, appointment.Status)
+		}
+		change.FromStatus = from
+		return tx.Create(change).Error
+	})
+}
+
+// Dời lịch hẹn (số lần dời do tầng service tính). Điều kiện theo giờ hẹn và số lần dời cũ chặn hai yêu cầu dời lịch cùng lúc.
+func (s *Store) RescheduleAppointment(ctx context.Context, previous, updated *Appointment) error {
+	result := s.db.WithContext(ctx).Model(&Appointment{}).
+		Where("id = ? AND scheduled_time = ? AND reschedule_count = ? AND status IN ?",
+			previous.ID, previous.ScheduledTime, previous.RescheduleCount, []AppointmentStatus{StatusPending, StatusConfirmed}).
+		Updates(map[string]interface{}{
+			"scheduled_time":   updated.ScheduledTime,
+			"branch_id":        updated.BranchID,
+			"employee_id":      updated.EmployeeID,
+			"reschedule_count": updated.RescheduleCount,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: appointment %d was changed by another request", ErrInvalidState, previous.ID)
+	}
+	return nil
+}
+
+func (s *Store) ListUpcomingAppointments(ctx context.Context, from, to time.Time) ([]Appointment, error) {
+	var appointments []Appointment
+	err := s.db.WithContext(ctx).
+		Where("status IN ? AND scheduled_time > ? AND scheduled_time <= ?", []AppointmentStatus{StatusPending, StatusConfirmed}, from, to).
+		Order("scheduled_time").
+		Find(&appointments).Error
+	return appointments, err
+}
+
+// ClaimReminder ghi nhận mốc nhắc trước khi gửi; khoá chính trùng nghĩa là đã gửi (hoặc instance khác đang gửi)
+func (s *Store) ClaimReminder(ctx context.Context, reminder *AppointmentReminder) (bool, error) {
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(reminder)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseReminder huỷ ghi nhận khi gửi thất bại để lần quét sau gửi lại
+func (s *Store) ReleaseReminder(ctx context.Context, reminder *AppointmentReminder) error {
+	return s.db.WithContext(ctx).
+		Where("appointment_id = ? AND offset_minutes = ? AND scheduled_time = ?", reminder.AppointmentID, reminder.OffsetMinutes, reminder.ScheduledTime).
+		Delete(&AppointmentReminder{}).Error
+}
+
+func (s *Store) ListAppointmentStatusHistory(ctx context.Context, appointmentID int32) ([]AppointmentStatusHistory, error) {
+	var history []AppointmentStatusHistory
+	err := s.db.WithContext(ctx).
+		Where("appointment_id = ?", appointmentID).
+		Order("created_at ASC, id ASC").
+		Find(&history).Error
+	return history, err
 }
 
 // Lấy chi tiết lịch hẹn + dịch vụ
@@ -122,6 +298,8 @@ func (s *Store) GetAppointmentDetails(ctx context.Context, appointmentID int32)
 
 	err := s.db.WithContext(ctx).
 		Preload("Details.Service").
+		Preload("Promotions").
+		Preload("Pets").
 		Where("id = ?", appointmentID).
 		First(&appointment).Error
 	if err != nil {
@@ -138,6 +316,78 @@ func (s *Store) GetAppointmentDetails(ctx context.Context, appointmentID int32)
 	return &appointment, details, nil
 }
 
+// --- LỊCH HẸN ĐỊNH KỲ ---
+func (s *Store) CreateAppointmentSeries(ctx context.Context, series *AppointmentSeries) error {
+	return s.db.WithContext(ctx).Create(series).Error // GORM tạo các dòng SeriesService, SeriesPet cùng transaction
+}
+
+func (s *Store) GetAppointmentSeriesByID(ctx context.Context, id int32) (*AppointmentSeries, error) {
+	var series AppointmentSeries
+	if err := s.db.WithContext(ctx).Preload("Services").Preload("Pets").First(&series, id).Error; err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+func (s *Store) ListActiveAppointmentSeries(ctx context.Context) ([]AppointmentSeries, error) {
+	var series []AppointmentSeries
+	err := s.db.WithContext(ctx).Preload("Services").Preload("Pets").Where("status = ?", SeriesActive).Order("id").Find(&series).Error
+	return series, err
+}
+
+func (s *Store) ListSeriesAppointments(ctx context.Context, seriesID int32) ([]Appointment, error) {
+	var appointments []Appointment
+	err := s.db.WithContext(ctx).Where("series_id = ?", seriesID).Order("scheduled_time, id").Find(&appointments).Error
+	return appointments, err
+}
+
+// SaveSeriesProgress lưu lần lặp tiếp theo cần sinh; điều kiện next_index = fromIndex chặn hai lượt sinh cùng lúc
+func (s *Store) SaveSeriesProgress(ctx context.Context, series *AppointmentSeries, fromIndex int32) error {
+	result := s.db.WithContext(ctx).Model(&AppointmentSeries{}).
+		Where("id = ? AND next_index = ? AND status = ?", series.ID, fromIndex, SeriesActive).
+		Updates(map[string]interface{}{
+			"next_index":  series.NextIndex,
+			"occurrences": series.Occurrences,
+			"status":      series.Status,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: series %d was changed by another request", ErrInvalidState, series.ID)
+	}
+	return nil
+}
+
+func (s *Store) UpdateAppointmentSeries(ctx context.Context, series *AppointmentSeries) error {
+	result := s.db.WithContext(ctx).Model(&AppointmentSeries{}).
+		Where("id = ? AND status <> ?", series.ID, SeriesCancelled).
+		Updates(map[string]interface{}{
+			"start_time":  series.StartTime,
+			"employee_id": series.EmployeeID,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: series %d has been cancelled", ErrInvalidState, series.ID)
+	}
+	return nil
+}
+
+func (s *Store) CancelAppointmentSeries(ctx context.Context, id int32) error {
+	result := s.db.WithContext(ctx).Model(&AppointmentSeries{}).
+		Where("id = ? AND status <> ?", id, SeriesCancelled).
+		Update("status", SeriesCancelled)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: series %d has already been cancelled", ErrInvalidState, id)
+	}
+	return nil
+}
+
 // --- SERVICE STORE ---
 // Tạo dịch vụ mới
 func (s *Store) CreateService(ctx context.Context, service *Service) error {
@@ -147,7 +397,7 @@ func (s *Store) CreateService(ctx context.Context, service *Service) error {
 // Lấy danh sách dịch vụ
 func (s *Store) GetServices(ctx context.Context) ([]Service, error) {
 	var services []Service
-	err := s.db.WithContext(ctx).Find(&services).Error
+	err := s.db.WithContext(ctx).Where("is_archived = ?", false).Find(&services).Error
 	return services, err
 }
 
@@ -159,11 +409,36 @@ func (s *Store) UpdateService(ctx context.Context, service *Service) error {
 		Updates(service).Error
 }
 
-// Xóa dịch vụ
+// Xóa dịch vụ (lưu trữ, không xoá hẳn)
 func (s *Store) DeleteService(ctx context.Context, serviceID int32) error {
-	return s.db.WithContext(ctx).
-		Where("id = ?", serviceID).
-		Delete(&Service{}).Error
+	return s.setServiceArchived(ctx, serviceID, true)
+}
+
+// Khôi phục dịch vụ đã xoá
+func (s *Store) RestoreService(ctx context.Context, serviceID int32) error {
+	return s.setServiceArchived(ctx, serviceID, false)
+}
+
+// setServiceArchived trả về gorm.ErrRecordNotFound nếu không có dịch vụ
+func (s *Store) setServiceArchived(ctx context.Context, serviceID int32, archived bool) error {
+	var archivedAt *time.Time
+	if archived {
+		now := time.Now()
+		archivedAt = &now
+	}
+	db := s.db.WithContext(ctx)
+	var service Service
+	if err := db.First(&service, serviceID).Error; err != nil {
+		return err
+	}
+	return db.Model(&service).Updates(map[string]interface{}{"is_archived": archived, "archived_at": archivedAt}).Error
+}
+
+// Lấy danh sách dịch vụ đã xoá
+func (s *Store) ListArchivedServices(ctx context.Context) ([]Service, error) {
+	var services []Service
+	err := s.db.WithContext(ctx).Where("is_archived = ?", true).Order("id").Find(&services).Error
+	return services, err
 }
 func (s *Store) UpdateAppointmentEmployee(ctx context.Context, appointmentID, employeeID int32) error {
 	return s.db.WithContext(ctx).
@@ -171,3 +446,367 @@ func (s *Store) UpdateAppointmentEmployee(ctx context.Context, appointmentID, em
 		Where("id = ?", appointmentID).
 		Update("employee_id", employeeID).Error
 }
+
+// Lưu toạ độ tra được cho lịch hẹn cũ chưa có toạ độ
+func (s *Store) SaveAppointmentLocation(ctx context.Context, appointmentID int32, point GeoPoint) error {
+	return s.db.WithContext(ctx).
+		Model(&Appointment{}).
+		Where("id = ?", appointmentID).
+		Updates(map[string]interface{}{"latitude": point.Lat, "longitude": point.Lng, "geocoded": true}).Error
+}
+
+// --- SERVICE IMAGE STORE ---
+// setPrimaryServiceImage đánh dấu ảnh chính và đồng bộ img_url của dịch vụ (url rỗng = xoá ảnh)
+func setPrimaryServiceImage(tx *gorm.DB, serviceID, imageID int32, url string) error {
+	if err := tx.Model(&ServiceImage{}).
+		Where("service_id = ?", serviceID).
+		Update("is_primary", gorm.Expr("id = ?", imageID)).Error; err != nil {
+		return err
+	}
+	return tx.Model(&Service{}).Where("id = ?", serviceID).Update("img_url", url).Error
+}
+
+// imagePositions - số ảnh hiện có và position lớn nhất (-1 khi chưa có ảnh)
+type imagePositions struct {
+	Count       int64
+	MaxPosition int32
+}
+
+// Thêm các ảnh của cùng một dịch vụ trong một transaction, position nối tiếp sau MAX(position).
+// Ảnh đầu tiên của dịch vụ luôn là ảnh chính.
+func (s *Store) AddServiceImages(ctx context.Context, images []ServiceImage) error {
+	if len(images) == 0 {
+		return nil
+	}
+	serviceID := images[0].ServiceID
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing imagePositions
+		if err := tx.Model(&ServiceImage{}).
+			Select("COUNT(*) AS count, COALESCE(MAX(position), -1) AS max_position").
+			Where("service_id = ?", serviceID).
+			Scan(&existing).Error; err != nil {
+			return err
+		}
+		primary := -1
+		for i := range images {
+			images[i].Position = existing.MaxPosition + 1 + int32(i)
+			if images[i].IsPrimary && primary < 0 {
+				primary = i
+			}
+			images[i].IsPrimary = false
+		}
+		if primary < 0 && existing.Count == 0 {
+			primary = 0
+		}
+		if primary >= 0 {
+			images[primary].IsPrimary = true
+		}
+		if err := tx.Create(&images).Error; err != nil {
+			return err
+		}
+		if primary < 0 {
+			return nil
+		}
+		return setPrimaryServiceImage(tx, serviceID, images[primary].ID, images[primary].URL)
+	})
+}
+
+// Lấy danh sách ảnh dịch vụ theo thứ tự hiển thị
+func (s *Store) ListServiceImages(ctx context.Context, serviceID int32) ([]ServiceImage, error) {
+	var images []ServiceImage
+	err := s.db.WithContext(ctx).Where("service_id = ?", serviceID).Order("position").Find(&images).Error
+	return images, err
+}
+
+// Xoá ảnh dịch vụ, trả về bản ghi đã xoá để gateway dọn file; xoá ảnh chính thì ảnh kế tiếp thành ảnh chính
+func (s *Store) DeleteServiceImage(ctx context.Context, imageID int32) (*ServiceImage, error) {
+	var image ServiceImage
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&image, imageID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&ServiceImage{}, imageID).Error; err != nil {
+			return err
+		}
+		if !image.IsPrimary {
+			return nil
+		}
+		var next ServiceImage
+		err := tx.Where("service_id = ?", image.ServiceID).Order("position").First(&next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return setPrimaryServiceImage(tx, image.ServiceID, 0, "")
+		}
+		if err != nil {
+			return err
+		}
+		return setPrimaryServiceImage(tx, image.ServiceID, next.ID, next.URL)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+// --- SERVICE PRICE HISTORY STORE ---
+func (s *Store) CreateServicePriceChange(ctx context.Context, change *ServicePriceChange) error {
+	return s.db.WithContext(ctx).Create(change).Error
+}
+
+func (s *Store) GetServicePriceChangeByID(ctx context.Context, id int32) (*ServicePriceChange, error) {
+	var change ServicePriceChange
+	if err := s.db.WithContext(ctx).First(&change, id).Error; err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+func (s *Store) ListServicePriceChanges(ctx context.Context, serviceID int32) ([]ServicePriceChange, error) {
+	var changes []ServicePriceChange
+	err := s.db.WithContext(ctx).Where("service_id = ?", serviceID).Order("effective_at, id").Find(&changes).Error
+	return changes, err
+}
+
+func (s *Store) DeleteServicePriceChange(ctx context.Context, id int32) error {
+	result := s.db.WithContext(ctx).Where("id = ? AND applied_at IS NULL", id).Delete(&ServicePriceChange{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+func (s *Store) ApplyDueServicePriceChanges(ctx context.Context, now time.Time, limit int) ([]ServicePriceChange, error) {
+	var due []ServicePriceChange
+	if err := s.db.WithContext(ctx).
+		Where("applied_at IS NULL AND skipped_at IS NULL AND effective_at <= ?", now).
+		Order("effective_at, id").
+		Limit(limit).
+		Find(&due).Error; err != nil {
+		return nil, err
+	}
+
+	var applied []ServicePriceChange
+	for _, change := range due {
+		// Mỗi thay đổi một transaction; giá vừa bị huỷ sau khi lấy danh sách thì bỏ qua
+		superseded := false
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// Giá đã được sửa trực tiếp (hoặc áp dụng) sau thời điểm hiệu lực: giữ giá mới hơn, bỏ qua giá hẹn trước này
+			var newer int64
+			if err := tx.Model(&ServicePriceChange{}).
+				Where("service_id = ? AND applied_at IS NOT NULL AND effective_at > ?", change.ServiceID, change.EffectiveAt).
+				Count(&newer).Error; err != nil {
+				return err
+			}
+			if newer > 0 {
+				result := tx.Model(&ServicePriceChange{}).Where("id = ? AND applied_at IS NULL AND skipped_at IS NULL", change.ID).Update("skipped_at", now)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return ErrInvalidState
+				}
+				superseded = true
+				return nil
+			}
+			result := tx.Model(&ServicePriceChange{}).Where("id = ? AND applied_at IS NULL AND skipped_at IS NULL", change.ID).Update("applied_at", now)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrInvalidState
+			}
+			var service Service
+			if err := tx.First(&service, change.ServiceID).Error; err != nil {
+				return err
+			}
+			// Lấy giá cũ trước khi Update: GORM ghi giá mới ngược vào service
+			change.PreviousPrice = service.Price
+			if err := tx.Model(&service).Update("price", change.Price).Error; err != nil {
+				return err
+			}
+			change.AppliedAt = &now
+			return tx.Model(&ServicePriceChange{}).Where("id = ?", change.ID).Update("previous_price", change.PreviousPrice).Error
+		})
+		if errors.Is(err, ErrInvalidState) {
+			continue
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Dịch vụ đã bị xoá: đánh dấu bỏ qua để giá này không chặn cả lô ở mọi lần quét sau
+			log.Printf("Skipping price change %d: service %d not found", change.ID, change.ServiceID)
+			if err := s.db.WithContext(ctx).Model(&ServicePriceChange{}).Where("id = ?", change.ID).Update("skipped_at", now).Error; err != nil {
+				return applied, err
+			}
+			continue
+		}
+		if err != nil {
+			return applied, err
+		}
+		if superseded {
+			log.Printf("Skipping price change %d: service %d was repriced after %s", change.ID, change.ServiceID, change.EffectiveAt.Format(time.RFC3339))
+			continue
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+// --- CA LÀM VIỆC ---
+func (s *Store) CreateEmployeeShift(ctx context.Context, shift *EmployeeShift) error {
+	return s.db.WithContext(ctx).Create(shift).Error
+}
+
+// ListEmployeeShifts trả về các ca giao với [from, to); branchID/employeeID = 0 là không lọc
+func (s *Store) ListEmployeeShifts(ctx context.Context, branchID, employeeID int32, from, to time.Time) ([]EmployeeShift, error) {
+	query := s.db.WithContext(ctx).Where("start_time < ? AND end_time > ?", to, from)
+	if branchID > 0 {
+		query = query.Where("branch_id = ?", branchID)
+	}
+	if employeeID > 0 {
+		query = query.Where("employee_id = ?", employeeID)
+	}
+	var shifts []EmployeeShift
+	err := query.Order("start_time, id").Find(&shifts).Error
+	return shifts, err
+}
+
+func (s *Store) GetEmployeeShiftByID(ctx context.Context, id int32) (*EmployeeShift, error) {
+	var shift EmployeeShift
+	if err := s.db.WithContext(ctx).First(&shift, id).Error; err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+func (s *Store) DeleteEmployeeShift(ctx context.Context, id int32) error {
+	db := s.db.WithContext(ctx)
+	var shift EmployeeShift
+	if err := db.First(&shift, id).Error; err != nil {
+		return err
+	}
+	return db.Delete(&shift).Error
+}
+
+// --- LỊCH LÀM VIỆC CỐ ĐỊNH ---
+func (s *Store) CreateShiftTemplate(ctx context.Context, template *ShiftTemplate) error {
+	return s.db.WithContext(ctx).Create(template).Error
+}
+
+func (s *Store) GetShiftTemplateByID(ctx context.Context, id int32) (*ShiftTemplate, error) {
+	var template ShiftTemplate
+	if err := s.db.WithContext(ctx).First(&template, id).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (s *Store) ListShiftTemplates(ctx context.Context, branchID, employeeID int32) ([]ShiftTemplate, error) {
+	query := s.db.WithContext(ctx)
+	if branchID > 0 {
+		query = query.Where("branch_id = ?", branchID)
+	}
+	if employeeID > 0 {
+		query = query.Where("employee_id = ?", employeeID)
+	}
+	var templates []ShiftTemplate
+	err := query.Order("employee_id, weekday, start_clock").Find(&templates).Error
+	return templates, err
+}
+
+// DeleteShiftTemplate xoá lịch cố định cùng các ca đã sinh chưa bắt đầu; ca đã qua được giữ lại
+func (s *Store) DeleteShiftTemplate(ctx context.Context, id int32) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var template ShiftTemplate
+		if err := tx.First(&template, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("template_id = ? AND start_time > ?", id, time.Now()).Delete(&EmployeeShift{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&template).Error
+	})
+}
+
+// --- NGHỈ PHÉP ---
+func (s *Store) CreateLeave(ctx context.Context, leave *EmployeeLeave) error {
+	return s.db.WithContext(ctx).Create(leave).Error
+}
+
+func (s *Store) GetLeaveByID(ctx context.Context, id int32) (*EmployeeLeave, error) {
+	var leave EmployeeLeave
+	if err := s.db.WithContext(ctx).First(&leave, id).Error; err != nil {
+		return nil, err
+	}
+	return &leave, nil
+}
+
+func (s *Store) ListLeaves(ctx context.Context, employeeIDs []int32, statuses []LeaveStatus, from, to time.Time) ([]EmployeeLeave, error) {
+	query := s.db.WithContext(ctx).Where("start_time < ? AND end_time > ?", to, from)
+	if len(employeeIDs) > 0 {
+		query = query.Where("employee_id IN ?", employeeIDs)
+	}
+	if len(statuses) > 0 {
+		query = query.Where("status IN ?", statuses)
+	}
+	var leaves []EmployeeLeave
+	err := query.Order("start_time, id").Find(&leaves).Error
+	return leaves, err
+}
+
+// ReviewLeave duyệt hoặc từ chối đơn đang chờ; đơn đã xử lý trả về ErrInvalidState
+func (s *Store) ReviewLeave(ctx context.Context, id int32, status LeaveStatus, reviewerID int32) (*EmployeeLeave, error) {
+	var leave EmployeeLeave
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&leave, id).Error; err != nil {
+			return err
+		}
+		if leave.Status != LeavePending {
+			return fmt.Errorf("%w: leave request %d is already %s", ErrInvalidState, id, leave.Status)
+		}
+		now := time.Now()
+		leave.Status = status
+		leave.ReviewedBy = reviewerID
+		leave.ReviewedAt = &now
+		return tx.Model(&leave).Updates(map[string]interface{}{
+			"status":      status,
+			"reviewed_by": reviewerID,
+			"reviewed_at": now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &leave, nil
+}
+
+// --- KỸ NĂNG NHÂN VIÊN ---
+// SetEmployeeSkills thay toàn bộ kỹ năng của nhân viên
+func (s *Store) SetEmployeeSkills(ctx context.Context, employeeID int32, serviceIDs []int32) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("employee_id = ?", employeeID).Delete(&EmployeeSkill{}).Error; err != nil {
+			return err
+		}
+		if len(serviceIDs) == 0 {
+			return nil
+		}
+		skills := make([]EmployeeSkill, len(serviceIDs))
+		for i, id := range serviceIDs {
+			skills[i] = EmployeeSkill{EmployeeID: employeeID, ServiceID: id}
+		}
+		return tx.Create(&skills).Error
+	})
+}
+
+func (s *Store) ListEmployeeSkills(ctx context.Context, employeeIDs, serviceIDs []int32) ([]EmployeeSkill, error) {
+	query := s.db.WithContext(ctx)
+	if len(employeeIDs) > 0 {
+		query = query.Where("employee_id IN ?", employeeIDs)
+	}
+	if len(serviceIDs) > 0 {
+		query = query.Where("service_id IN ?", serviceIDs)
+	}
+	var skills []EmployeeSkill
+	err := query.Order("employee_id, service_id").Find(&skills).Error
+	return skills, err
+}
@@ -2,10 +2,16 @@ package main
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	pb "github.com/quanbin27/commons/genproto/appointments"
+	pbRecord "github.com/quanbin27/commons/genproto/records"
+	pbUser "github.com/quanbin27/commons/genproto/users"
+	"github.com/quanbin27/commons/pricing"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -14,20 +20,109 @@ type AppointmentStatus string
 
 const (
 	StatusPending    AppointmentStatus = "pending"
+	StatusConfirmed  AppointmentStatus = "confirmed"
 	StatusInProgress AppointmentStatus = "in_progress"
 	StatusCompleted  AppointmentStatus = "completed"
 	StatusCancelled  AppointmentStatus = "cancelled"
+	StatusNoShow     AppointmentStatus = "no_show"
 )
 
+// --- BẢNG NHẮC LỊCH ĐÃ GỬI ---
+// Mỗi mốc nhắc của một giờ hẹn chỉ gửi một lần; lịch bị dời thì được nhắc lại theo giờ mới
+type AppointmentReminder struct {
+	AppointmentID int32     `gorm:"primaryKey;autoIncrement:false"`
+	OffsetMinutes int32     `gorm:"primaryKey;autoIncrement:false"`
+	ScheduledTime time.Time `gorm:"primaryKey"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// --- BẢNG LỊCH SỬ TRẠNG THÁI LỊCH HẸN ---
+// Mỗi lần đổi trạng thái (kể cả lúc tạo lịch, FromStatus rỗng) ghi một dòng
+type AppointmentStatusHistory struct {
+	ID              int32             `gorm:"primaryKey"`
+	AppointmentID   int32             `gorm:"not null;index"`
+	FromStatus      AppointmentStatus `gorm:"type:varchar(20)"`
+	ToStatus        AppointmentStatus `gorm:"type:varchar(20);not null"`
+	Reason          string            `gorm:"type:text"`
+	ChangedBy       int32             // user thực hiện, 0 = hệ thống
+	CancellationFee float32           `gorm:"not null;default:0"`
+	CreatedAt       time.Time         `gorm:"autoCreateTime"`
+}
+
 // --- BẢNG DỊCH VỤ ---
+// Xoá dịch vụ chỉ lưu trữ (IsArchived) để chi tiết các lịch hẹn cũ vẫn hiển thị được.
+// DurationMinutes là thời gian thực hiện một lượt dịch vụ, dùng để tính giờ kết thúc lịch hẹn.
 type Service struct {
-	ID          int32     `gorm:"primaryKey"`
-	Name        string    `gorm:"not null"`
-	Description string    `gorm:"type:text"`
-	Price       float32   `gorm:"not null"`
-	ImgUrl      string    `gorm:"type:text"`
-	CreatedAt   time.Time `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	ID              int32     `gorm:"primaryKey"`
+	Name            string    `gorm:"not null"`
+	Description     string    `gorm:"type:text"`
+	Price           float32   `gorm:"not null"`
+	ImgUrl          string    `gorm:"type:text"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime"`
+	IsArchived      bool      `gorm:"not null;default:false;index"`
+	ArchivedAt      *time.Time
+	DurationMinutes int32 `gorm:"not null;default:30"`
+}
+
+var (
+	// ErrServiceArchived - Dịch vụ đã bị xoá (lưu trữ), không nhận đặt lịch mới
+	ErrServiceArchived = errors.New("service is archived")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrInvalidState    = errors.New("invalid state")
+	// ErrSlotUnavailable - Khung giờ đã kín (chi nhánh hết chỗ hoặc nhân viên bận)
+	ErrSlotUnavailable = errors.New("time slot is not available")
+	// ErrBookingBusy - Không lấy được khoá đặt lịch của chi nhánh kịp thời, client thử lại sau
+	ErrBookingBusy = errors.New("branch is busy with other bookings")
+	// ErrSeriesInactive - Lịch định kỳ đã bị huỷ/kết thúc trong lúc đang sinh lần hẹn
+	ErrSeriesInactive = errors.New("appointment series is no longer active")
+	// ErrOccurrenceExists - Lần lặp này của lịch định kỳ đã được sinh bởi lượt khác
+	ErrOccurrenceExists = errors.New("series occurrence already exists")
+)
+
+// --- BẢNG ẢNH DỊCH VỤ ---
+// Một dịch vụ có nhiều ảnh; ảnh chính được đồng bộ vào Service.ImgUrl
+type ServiceImage struct {
+	ID           int32     `gorm:"primaryKey"`
+	ServiceID    int32     `gorm:"index;not null"`
+	URL          string    `gorm:"type:text;not null"`
+	ThumbnailURL string    `gorm:"type:text"`
+	StorageKey   string    `gorm:"size:255"`
+	ThumbnailKey string    `gorm:"size:255"`
+	Position     int32     `gorm:"not null;default:0"`
+	IsPrimary    bool      `gorm:"default:false"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// --- BẢNG LỊCH SỬ GIÁ DỊCH VỤ ---
+// Giá sửa trực tiếp được ghi với AppliedAt = thời điểm sửa; giá hẹn trước có AppliedAt = nil
+// cho tới khi bộ hẹn giờ áp dụng vào Service.Price.
+type ServicePriceChange struct {
+	ID            int32     `gorm:"primaryKey"`
+	ServiceID     int32     `gorm:"index;not null"`
+	Price         float32   `gorm:"not null"`
+	PreviousPrice float32   // giá ngay trước khi thay đổi có hiệu lực, ghi lúc áp dụng
+	EffectiveAt   time.Time `gorm:"index;not null"`
+	AppliedAt     *time.Time
+	SkippedAt     *time.Time // giá hẹn trước đến hạn nhưng dịch vụ không còn, bộ hẹn giờ bỏ qua
+	CreatedBy     int32
+	Note          string    `gorm:"size:255"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// Status - "scheduled" khi chưa áp dụng, "applied" khi đã áp dụng, "skipped" khi không áp dụng được
+func (c *ServicePriceChange) Status() string {
+	switch {
+	case c.AppliedAt != nil:
+		return "applied"
+	case c.SkippedAt != nil:
+		return "skipped"
+	}
+	return "scheduled"
+}
+
+func (c ServicePriceChange) PriceChange() pricing.Change {
+	return pricing.Change{Price: c.Price, PreviousPrice: c.PreviousPrice, EffectiveAt: c.EffectiveAt, AppliedAt: c.AppliedAt, Skipped: c.SkippedAt != nil}
 }
 
 // --- BẢNG LỊCH HẸN ---
@@ -36,7 +131,7 @@ type Appointment struct {
 	CustomerID      int32             `gorm:"not null;index"`
 	EmployeeID      int32             `gorm:"index"`
 	CustomerAddress string            `gorm:"type:text;not null"`
-	ScheduledTime   time.Time         `gorm:"not null"`
+	ScheduledTime   time.Time         `gorm:"not null;index"`
 	Status          AppointmentStatus `gorm:"type:varchar(20);not null;default:'pending'"`
 	Total           float32           `gorm:"not null"`
 	Note            string
@@ -44,27 +139,304 @@ type Appointment struct {
 	CreatedAt       time.Time           `gorm:"autoCreateTime"`
 	UpdatedAt       time.Time           `gorm:"autoUpdateTime"`
 	Details         []AppointmentDetail `gorm:"foreignKey:AppointmentID"`
+	// Subtotal là tổng tiền trước khuyến mãi, Total = Subtotal - Discount
+	Subtotal   float32                `gorm:"not null;default:0"`
+	Discount   float32                `gorm:"not null;default:0"`
+	Promotions []AppointmentPromotion `gorm:"foreignKey:AppointmentID"`
+	// DurationMinutes - Tổng thời gian các dịch vụ lúc đặt; lịch hẹn cũ (0) được tính bằng defaultServiceDuration
+	DurationMinutes int32 `gorm:"not null;default:0"`
+	// CancellationFee - phí huỷ muộn, chỉ có khi lịch bị huỷ sau hạn huỷ miễn phí
+	CancellationFee float32 `gorm:"not null;default:0"`
+	RescheduleCount int32   `gorm:"not null;default:0"`
+	// SeriesID/SeriesIndex - lịch sinh từ lịch hẹn định kỳ và lần lặp thứ mấy (0 = lịch đặt lẻ)
+	// SeriesID lưu NULL cho lịch lẻ để unique index chỉ ràng buộc các lần lặp của cùng một lịch định kỳ
+	SeriesID    SeriesRef `gorm:"uniqueIndex:idx_appointment_series_occurrence,priority:1"`
+	SeriesIndex int32     `gorm:"not null;default:0;uniqueIndex:idx_appointment_series_occurrence,priority:2"`
+	// Pets - thú cưng của khách được đưa tới (Pet bên Records service)
+	Pets []AppointmentPet `gorm:"foreignKey:AppointmentID"`
+	// Latitude/Longitude - toạ độ CustomerAddress khi Geocoded, dùng tính thời gian di chuyển giữa các lịch tại nhà
+	Latitude  float64 `gorm:"not null;default:0"`
+	Longitude float64 `gorm:"not null;default:0"`
+	Geocoded  bool    `gorm:"not null;default:false"`
+}
+
+// location - toạ độ địa chỉ hẹn, nil khi chưa tra được
+func (a *Appointment) location() *GeoPoint {
+	if !a.Geocoded {
+		return nil
+	}
+	return &GeoPoint{Lat: a.Latitude, Lng: a.Longitude}
+}
+
+// AppointmentPet - thú cưng trong lịch hẹn, PetID là Pet.id (ObjectID hex) bên Records service
+type AppointmentPet struct {
+	AppointmentID int32  `gorm:"primaryKey"`
+	PetID         string `gorm:"primaryKey;size:24"`
+}
+
+// PetIDs - danh sách Pet.id của lịch hẹn
+func (a *Appointment) PetIDs() []string {
+	ids := make([]string, len(a.Pets))
+	for i, p := range a.Pets {
+		ids[i] = p.PetID
+	}
+	return ids
+}
+
+// EndTime - Thời điểm lịch hẹn kết thúc
+func (a *Appointment) EndTime() time.Time {
+	duration := time.Duration(a.DurationMinutes) * time.Minute
+	if duration <= 0 {
+		duration = defaultServiceDuration
+	}
+	return a.ScheduledTime.Add(duration)
+}
+
+// --- BẢNG LỊCH HẸN ĐỊNH KỲ ---
+// Lịch lặp lại mỗi Interval tuần/tháng từ StartTime, giờ tính theo múi giờ chi nhánh.
+// Các lần hẹn được sinh trước thành Appointment trong khoảng seriesPlanningHorizon; NextIndex là lần lặp
+// tiếp theo chưa sinh, Occurrences đếm các lần lặp đã sinh (kể cả bị bỏ qua vì trùng lịch) để so với Count.
+type RecurrenceFrequency string
+
+const (
+	FrequencyWeekly  RecurrenceFrequency = "weekly"
+	FrequencyMonthly RecurrenceFrequency = "monthly"
+)
+
+type SeriesStatus string
+
+const (
+	SeriesActive    SeriesStatus = "active"
+	SeriesEnded     SeriesStatus = "ended" // đã sinh hết các lần hẹn
+	SeriesCancelled SeriesStatus = "cancelled"
+)
+
+// SeriesRef - id lịch định kỳ của một lịch hẹn, lưu NULL khi bằng 0 (lịch đặt lẻ)
+type SeriesRef int32
+
+func (r SeriesRef) Value() (driver.Value, error) {
+	if r == 0 {
+		return nil, nil
+	}
+	return int64(r), nil
+}
+
+func (r *SeriesRef) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*r = 0
+	case int64:
+		*r = SeriesRef(v)
+	case []byte:
+		var n int32
+		if _, err := fmt.Sscan(string(v), &n); err != nil {
+			return fmt.Errorf("cannot scan %q into SeriesRef: %w", v, err)
+		}
+		*r = SeriesRef(n)
+	default:
+		return fmt.Errorf("cannot scan %T into SeriesRef", value)
+	}
+	return nil
+}
+
+type AppointmentSeries struct {
+	ID              int32  `gorm:"primaryKey"`
+	CustomerID      int32  `gorm:"not null;index"`
+	EmployeeID      int32  // nhân viên ưu tiên, 0 = tự phân công
+	BranchID        int32  `gorm:"not null;index"`
+	CustomerAddress string `gorm:"type:text;not null"`
+	Note            string
+	Frequency       RecurrenceFrequency `gorm:"type:varchar(10);not null"`
+	Interval        int32               `gorm:"column:repeat_interval;not null;default:1"`
+	StartTime       time.Time           `gorm:"not null"`
+	UntilDate       string              `gorm:"size:10"`            // YYYY-MM-DD theo giờ chi nhánh, rỗng = không giới hạn
+	Count           int32               `gorm:"not null;default:0"` // 0 = không giới hạn
+	Status          SeriesStatus        `gorm:"type:varchar(20);not null;default:'active';index"`
+	NextIndex       int32               `gorm:"not null;default:0"`
+	Occurrences     int32               `gorm:"not null;default:0"`
+	Services        []SeriesService     `gorm:"foreignKey:SeriesID"`
+	Pets            []SeriesPet         `gorm:"foreignKey:SeriesID"` // chép vào Appointment.Pets của mỗi lần hẹn
+	CreatedAt       time.Time           `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time           `gorm:"autoUpdateTime"`
+}
+
+// SeriesService - dịch vụ đặt cho mỗi lần hẹn của lịch định kỳ; giá lấy theo bảng giá lúc sinh lần hẹn.
+// PetID như AppointmentDetail.PetID: trống = dùng chung cho cả lịch hẹn
+type SeriesService struct {
+	SeriesID  int32  `gorm:"primaryKey"`
+	ServiceID int32  `gorm:"primaryKey"`
+	PetID     string `gorm:"primaryKey;size:24;not null;default:''"`
+	Quantity  int32  `gorm:"not null"`
+}
+
+// SeriesPet - thú cưng được đưa tới mỗi lần hẹn của lịch định kỳ (Pet bên Records service)
+type SeriesPet struct {
+	SeriesID int32  `gorm:"primaryKey"`
+	PetID    string `gorm:"primaryKey;size:24"`
+}
+
+// PetIDs - danh sách Pet.id của lịch định kỳ
+func (series *AppointmentSeries) PetIDs() []string {
+	ids := make([]string, len(series.Pets))
+	for i, p := range series.Pets {
+		ids[i] = p.PetID
+	}
+	return ids
+}
+
+// SkippedOccurrence - lần hẹn không sinh hoặc không dời được, kèm lý do
+type SkippedOccurrence struct {
+	ScheduledTime time.Time
+	Reason        string
+}
+
+// --- BẢNG CA LÀM VIỆC ---
+// Ca làm việc của nhân viên tại một chi nhánh. Ngày chi nhánh có xếp ca thì chỉ nhận lịch
+// khi còn nhân viên trong ca rảnh; ngày chưa xếp ca chỉ giới hạn theo sức chứa chi nhánh.
+// TemplateID > 0 là ca sinh ra từ lịch làm việc cố định hằng tuần.
+type EmployeeShift struct {
+	ID         int32     `gorm:"primaryKey"`
+	EmployeeID int32     `gorm:"index;not null"`
+	BranchID   int32     `gorm:"index;not null"`
+	StartTime  time.Time `gorm:"index;not null"`
+	EndTime    time.Time `gorm:"not null"`
+	TemplateID int32     `gorm:"index;not null;default:0"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// --- BẢNG LỊCH LÀM VIỆC CỐ ĐỊNH HẰNG TUẦN ---
+// Mỗi dòng là một ca lặp lại vào Weekday, giờ "HH:MM" theo múi giờ chi nhánh.
+// Ca cụ thể được sinh trước (EmployeeShift) trong khoảng shiftPlanningHorizon; ValidUntil rỗng = không hết hạn.
+type ShiftTemplate struct {
+	ID         int32     `gorm:"primaryKey"`
+	EmployeeID int32     `gorm:"index;not null"`
+	BranchID   int32     `gorm:"index;not null"`
+	Weekday    int32     `gorm:"not null"`        // 0 = Chủ nhật ... 6 = Thứ bảy
+	StartClock string    `gorm:"size:5;not null"` // "HH:MM"
+	EndClock   string    `gorm:"size:5;not null"` // "HH:MM", sau StartClock
+	ValidFrom  string    `gorm:"size:10;not null"`
+	ValidUntil string    `gorm:"size:10"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// --- BẢNG KỸ NĂNG NHÂN VIÊN ---
+// Dịch vụ nhân viên thực hiện được. Dịch vụ chưa gán kỹ năng cho ai thì mọi nhân viên đều làm được.
+type EmployeeSkill struct {
+	EmployeeID int32     `gorm:"primaryKey"`
+	ServiceID  int32     `gorm:"primaryKey;index"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// Reassignment - Kết quả phân công lại một lịch hẹn, EmployeeID = 0 là chưa tìm được nhân viên
+type Reassignment struct {
+	AppointmentID int32
+	EmployeeID    int32
+}
+
+// --- BẢNG ĐƠN NGHỈ PHÉP ---
+type LeaveStatus string
+
+const (
+	LeavePending  LeaveStatus = "pending"
+	LeaveApproved LeaveStatus = "approved"
+	LeaveRejected LeaveStatus = "rejected"
+)
+
+// EmployeeLeave - Đơn nghỉ phép; chỉ đơn đã duyệt mới làm nhân viên không nhận lịch trong khoảng nghỉ
+type EmployeeLeave struct {
+	ID         int32       `gorm:"primaryKey"`
+	EmployeeID int32       `gorm:"index;not null"`
+	StartTime  time.Time   `gorm:"index;not null"`
+	EndTime    time.Time   `gorm:"not null"`
+	Reason     string      `gorm:"size:255"`
+	Status     LeaveStatus `gorm:"type:varchar(20);not null;default:'pending'"`
+	ReviewedBy int32
+	ReviewedAt *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+// --- BẢNG KHUYẾN MÃI ĐÃ ÁP DỤNG CHO LỊCH HẸN ---
+// RedemptionID là lượt dùng khuyến mãi ghi nhận bên Products service
+type AppointmentPromotion struct {
+	ID            int32   `gorm:"primaryKey"`
+	AppointmentID int32   `gorm:"index;not null"`
+	PromotionID   int32   `gorm:"not null"`
+	RedemptionID  int32   `gorm:"index"`
+	Code          string  `gorm:"size:50"`
+	Name          string  `gorm:"size:255"`
+	Discount      float32 `gorm:"not null"`
+}
+
+// PriceQuote - Kết quả tính giá lịch hẹn
+type PriceQuote struct {
+	Subtotal   float32
+	Discount   float32
+	Total      float32
+	Promotions []AppointmentPromotion
+}
+
+// SlotAvailability - Các khung giờ còn nhận lịch của chi nhánh trong một ngày
+type SlotAvailability struct {
+	Timezone string
+	Duration time.Duration // tổng thời gian các dịch vụ được hỏi
+	Slots    []AvailableSlot
+}
+
+type AvailableSlot struct {
+	Start             time.Time
+	End               time.Time
+	RemainingCapacity int32   // -1 = chi nhánh không giới hạn số lịch cùng lúc
+	EmployeeIDs       []int32 // nhân viên trong ca còn rảnh, rỗng khi chi nhánh chưa xếp ca ngày này
 }
 
 // --- BẢNG CHI TIẾT LỊCH HẸN ---
 type AppointmentDetail struct {
-	AppointmentID int32       `gorm:"primaryKey"`
-	ServiceID     int32       `gorm:"primaryKey"`
-	ServicePrice  float32     `gorm:"not null"`
-	Quantity      int32       `gorm:"not null;default:0"`
-	Appointment   Appointment `gorm:"foreignKey:AppointmentID;constraint:OnDelete:CASCADE"`
-	Service       Service     `gorm:"foreignKey:ServiceID"`
+	AppointmentID int32   `gorm:"primaryKey"`
+	ServiceID     int32   `gorm:"primaryKey"`
+	ServicePrice  float32 `gorm:"not null"`
+	Quantity      int32   `gorm:"not null;default:0"`
+	// PetID - tuỳ chọn, thú cưng dùng dịch vụ; trống = dùng chung cho cả lịch hẹn.
+	// Thuộc khoá chính để cùng một dịch vụ đặt được cho nhiều thú cưng trong một lịch hẹn
+	PetID       string      `gorm:"primaryKey;size:24;not null;default:''"`
+	Appointment Appointment `gorm:"foreignKey:AppointmentID;constraint:OnDelete:CASCADE"`
+	Service     Service     `gorm:"foreignKey:ServiceID"`
 }
 
 // --- INTERFACE CHO APPOINTMENT STORE ---
 type AppointmentStore interface {
 	// Lịch hẹn
-	CreateAppointment(ctx context.Context, customerID int32, customerAddress string, scheduledTime time.Time, services []AppointmentDetail, total float32, note string, branchID int32) (int32, error)
+	// LockBranchBookings giữ khoá đặt lịch của chi nhánh tới khi gọi hàm trả về
+	LockBranchBookings(ctx context.Context, branchID int32) (func(), error)
+	CreateAppointment(ctx context.Context, appointment *Appointment, services []AppointmentDetail, quote *PriceQuote) (int32, error)
+	GetAppointmentByID(ctx context.Context, appointmentID int32) (*Appointment, error)
+	// Lịch chưa huỷ/không vắng mặt bắt đầu trong [from, to); branchID/employeeIDs rỗng = không lọc
+	ListBranchAppointmentsBetween(ctx context.Context, branchID int32, from, to time.Time) ([]Appointment, error)
+	ListEmployeeAppointmentsBetween(ctx context.Context, employeeIDs []int32, from, to time.Time) ([]Appointment, error)
+	// Như ListEmployeeAppointmentsBetween cho một nhân viên, kèm dịch vụ và thú cưng
+	ListEmployeeAgenda(ctx context.Context, employeeID int32, from, to time.Time) ([]Appointment, error)
 	GetAppointmentsByCustomer(ctx context.Context, customerID int32) ([]Appointment, error)
 	GetAppointmentsByEmployee(ctx context.Context, employeeID int32) ([]Appointment, error)
-	UpdateAppointmentStatus(ctx context.Context, appointmentID int32, status AppointmentStatus) error
+	// Đổi trạng thái nếu lịch vẫn đang ở from (không thì ErrInvalidState) và ghi lịch sử trong cùng transaction
+	ChangeAppointmentStatus(ctx context.Context, from AppointmentStatus, change *AppointmentStatusHistory) error
+	ListAppointmentStatusHistory(ctx context.Context, appointmentID int32) ([]AppointmentStatusHistory, error)
+	// Chỉ dời được nếu lịch vẫn chưa bắt đầu làm và chưa bị đổi kể từ lúc đọc previous (không thì ErrInvalidState)
+	RescheduleAppointment(ctx context.Context, previous, updated *Appointment) error
+	// Nhắc lịch: lịch chưa bắt đầu làm có giờ hẹn trong (from, to]
+	ListUpcomingAppointments(ctx context.Context, from, to time.Time) ([]Appointment, error)
+	ClaimReminder(ctx context.Context, reminder *AppointmentReminder) (bool, error) // false nếu mốc nhắc đã được gửi
+	ReleaseReminder(ctx context.Context, reminder *AppointmentReminder) error
 	GetAppointmentDetails(ctx context.Context, appointmentID int32) (*Appointment, []AppointmentDetail, error)
 	GetAppointmentsByBranch(ctx context.Context, branchID int32) ([]Appointment, error)
+	// Lịch hẹn định kỳ
+	CreateAppointmentSeries(ctx context.Context, series *AppointmentSeries) error
+	GetAppointmentSeriesByID(ctx context.Context, id int32) (*AppointmentSeries, error)
+	ListActiveAppointmentSeries(ctx context.Context) ([]AppointmentSeries, error)
+	ListSeriesAppointments(ctx context.Context, seriesID int32) ([]Appointment, error) // theo giờ hẹn tăng dần
+	// Lưu tiến độ sinh lịch nếu chưa ai sinh tiếp từ fromIndex (không thì ErrInvalidState)
+	SaveSeriesProgress(ctx context.Context, series *AppointmentSeries, fromIndex int32) error
+	// Sửa giờ bắt đầu, nhân viên ưu tiên / huỷ chuỗi chưa bị huỷ (không thì ErrInvalidState)
+	UpdateAppointmentSeries(ctx context.Context, series *AppointmentSeries) error
+	CancelAppointmentSeries(ctx context.Context, id int32) error
 	// Dịch vụ
 	CreateService(ctx context.Context, service *Service) error
 	GetServices(ctx context.Context) ([]Service, error)
@@ -72,27 +444,107 @@ type AppointmentStore interface {
 	DeleteService(ctx context.Context, serviceID int32) error
 	GetServicesByIDs(ctx context.Context, serviceIDs []int32) ([]Service, error)
 	GetServiceByID(ctx context.Context, serviceID int32) (Service, error)
+	RestoreService(ctx context.Context, serviceID int32) error
+	ListArchivedServices(ctx context.Context) ([]Service, error)
 	UpdateAppointmentEmployee(ctx context.Context, appointmentID, employeeID int32) error
+	SaveAppointmentLocation(ctx context.Context, appointmentID int32, point GeoPoint) error
 	GetAllAppointments(ctx context.Context) ([]Appointment, error)
+	// Ảnh dịch vụ
+	AddServiceImages(ctx context.Context, images []ServiceImage) error
+	ListServiceImages(ctx context.Context, serviceID int32) ([]ServiceImage, error)
+	DeleteServiceImage(ctx context.Context, imageID int32) (*ServiceImage, error)
+	// Lịch sử giá dịch vụ
+	CreateServicePriceChange(ctx context.Context, change *ServicePriceChange) error
+	GetServicePriceChangeByID(ctx context.Context, id int32) (*ServicePriceChange, error)
+	ListServicePriceChanges(ctx context.Context, serviceID int32) ([]ServicePriceChange, error) // theo thời điểm hiệu lực tăng dần
+	DeleteServicePriceChange(ctx context.Context, id int32) error                               // chỉ xoá giá hẹn trước chưa áp dụng
+	ApplyDueServicePriceChanges(ctx context.Context, now time.Time, limit int) ([]ServicePriceChange, error)
+	// Ca làm việc
+	CreateEmployeeShift(ctx context.Context, shift *EmployeeShift) error
+	ListEmployeeShifts(ctx context.Context, branchID, employeeID int32, from, to time.Time) ([]EmployeeShift, error) // các ca giao với [from, to)
+	GetEmployeeShiftByID(ctx context.Context, id int32) (*EmployeeShift, error)
+	DeleteEmployeeShift(ctx context.Context, id int32) error
+	// Lịch làm việc cố định hằng tuần
+	CreateShiftTemplate(ctx context.Context, template *ShiftTemplate) error
+	GetShiftTemplateByID(ctx context.Context, id int32) (*ShiftTemplate, error)
+	ListShiftTemplates(ctx context.Context, branchID, employeeID int32) ([]ShiftTemplate, error)
+	DeleteShiftTemplate(ctx context.Context, id int32) error
+	// Nghỉ phép
+	CreateLeave(ctx context.Context, leave *EmployeeLeave) error
+	GetLeaveByID(ctx context.Context, id int32) (*EmployeeLeave, error)
+	// employeeIDs rỗng = mọi nhân viên, statuses rỗng = mọi trạng thái; các đơn giao với [from, to)
+	ListLeaves(ctx context.Context, employeeIDs []int32, statuses []LeaveStatus, from, to time.Time) ([]EmployeeLeave, error)
+	ReviewLeave(ctx context.Context, id int32, status LeaveStatus, reviewerID int32) (*EmployeeLeave, error) // chỉ duyệt đơn đang chờ
+	// Kỹ năng nhân viên
+	SetEmployeeSkills(ctx context.Context, employeeID int32, serviceIDs []int32) error
+	// employeeIDs/serviceIDs rỗng = không lọc
+	ListEmployeeSkills(ctx context.Context, employeeIDs, serviceIDs []int32) ([]EmployeeSkill, error)
 }
 
 // --- INTERFACE CHO APPOINTMENT SERVICE (SỬ DỤNG DỮ LIỆU NỘI BỘ) ---
 type AppointmentService interface {
 	// Lịch hẹn
-	CreateAppointment(ctx context.Context, customerID int32, customerAddress string, scheduledTime time.Time, details []AppointmentDetail, note string, branchID int32) (int32, string, error)
+	CreateAppointment(ctx context.Context, appointment *Appointment, details []AppointmentDetail, couponCodes []string) (int32, *PriceQuote, string, error)
 	GetAppointmentsByCustomer(ctx context.Context, customerID int32) ([]Appointment, error)
 	GetAppointmentsByEmployee(ctx context.Context, employeeID int32) ([]Appointment, error)
-	UpdateAppointmentStatus(ctx context.Context, appointmentID int32, status AppointmentStatus) (string, error)
+	// Lịch hẹn của nhân viên từ fromDate tới toDate theo múi giờ chi nhánh của nhân viên (trả về kèm múi giờ)
+	GetEmployeeAgenda(ctx context.Context, employeeID int32, fromDate, toDate string) ([]AgendaEntry, *time.Location, error)
+	GetEmployeeRoute(ctx context.Context, employeeID int32, date string) (*EmployeeRoute, error)
+	// Trả về phí huỷ muộn (nếu có) khi huỷ lịch
+	UpdateAppointmentStatus(ctx context.Context, appointmentID int32, status AppointmentStatus, reason string, changedBy, customerID int32) (float32, string, error)
+	GetAppointmentStatusHistory(ctx context.Context, appointmentID, customerID int32) ([]AppointmentStatusHistory, error)
+	// Tạo phiếu khám bên Records service cho từng thú cưng của lịch hẹn đã hoàn thành, trả về ID phiếu khám
+	CreateAppointmentExaminations(ctx context.Context, appointmentID int32) ([]string, error)
+	RescheduleAppointment(ctx context.Context, appointmentID, customerID int32, scheduledTime time.Time, branchID int32) (*Appointment, string, error)
+	SendDueReminders(ctx context.Context, now time.Time) (int, error)
+	// Lịch hẹn định kỳ; customerID > 0 chỉ thao tác được trên chuỗi lịch của khách đó
+	CreateAppointmentSeries(ctx context.Context, series *AppointmentSeries) ([]Appointment, []SkippedOccurrence, error)
+	GetAppointmentSeries(ctx context.Context, seriesID, customerID int32) (*AppointmentSeries, []Appointment, error)
+	UpdateAppointmentSeries(ctx context.Context, seriesID, customerID int32, scheduledTime time.Time, employeeID int32) (*AppointmentSeries, []Appointment, []SkippedOccurrence, error)
+	// Trả về các lần hẹn đã huỷ và tổng phí huỷ muộn
+	CancelAppointmentSeries(ctx context.Context, seriesID, customerID int32, reason string, changedBy int32) ([]int32, float32, string, error)
+	GenerateSeriesAppointments(ctx context.Context) (int, error)
 	GetAppointmentsByBranch(ctx context.Context, branchID int32) ([]Appointment, error)
 	// Updated to include service information
 	GetAppointmentDetails(ctx context.Context, appointmentID int32) (*Appointment, []AppointmentDetailWithService, error)
 	UpdateEmployeeForAppointment(ctx context.Context, appointmentID, employeeID int32) (string, error)
 	// Service-related methods
-	CreateService(ctx context.Context, name, description string, price float32) (int32, string, error)
+	CreateService(ctx context.Context, name, description string, price float32, imgURL string, durationMinutes int32) (int32, string, error)
 	GetServices(ctx context.Context) ([]Service, error)
-	UpdateService(ctx context.Context, serviceID int32, name, description string, price float32) (string, error)
+	UpdateService(ctx context.Context, serviceID int32, name, description string, price float32, imgURL string, durationMinutes int32) (string, error)
 	DeleteService(ctx context.Context, serviceID int32) (string, error) // Trả về status
+	RestoreService(ctx context.Context, serviceID int32) (string, error)
+	ListArchivedServices(ctx context.Context) ([]Service, error)
 	GetAllAppointments(ctx context.Context) ([]Appointment, error)
+	// Ảnh dịch vụ
+	AddServiceImages(ctx context.Context, images []ServiceImage) ([]ServiceImage, error)
+	ListServiceImages(ctx context.Context, serviceID int32) ([]ServiceImage, error)
+	DeleteServiceImage(ctx context.Context, imageID int32) (*ServiceImage, error)
+	// Lịch sử giá dịch vụ
+	ScheduleServicePriceChange(ctx context.Context, change *ServicePriceChange) (*ServicePriceChange, error)
+	CancelServicePriceChange(ctx context.Context, id int32) (string, error)
+	ListServicePriceHistory(ctx context.Context, serviceID int32) ([]ServicePriceChange, float32, error)
+	GetServicePriceAt(ctx context.Context, serviceID int32, at time.Time) (float32, error)
+	ApplyScheduledServicePrices(ctx context.Context) (int, error)
+	// Khung giờ trống & ca làm việc
+	// customerAddress (tuỳ chọn) - địa chỉ hẹn, để chừa thời gian di chuyển của nhân viên giữa các lịch tại nhà
+	GetAvailableSlots(ctx context.Context, branchID int32, serviceIDs []int32, date, customerAddress string) (*SlotAvailability, error)
+	CreateEmployeeShift(ctx context.Context, shift *EmployeeShift) (*EmployeeShift, error)
+	ListEmployeeShifts(ctx context.Context, branchID, employeeID int32, from, to time.Time) ([]EmployeeShift, error)
+	// Xoá ca/lịch cố định rồi phân công lại các lịch hẹn nhân viên không còn nhận được
+	DeleteEmployeeShift(ctx context.Context, id int32) (string, []Reassignment, error)
+	CreateShiftTemplate(ctx context.Context, template *ShiftTemplate) (*ShiftTemplate, error)
+	ListShiftTemplates(ctx context.Context, branchID, employeeID int32) ([]ShiftTemplate, error)
+	DeleteShiftTemplate(ctx context.Context, id int32) (string, []Reassignment, error)
+	GenerateShiftsFromTemplates(ctx context.Context, branchID int32, fromDate, toDate string) (int, error)
+	RequestLeave(ctx context.Context, leave *EmployeeLeave) (*EmployeeLeave, error)
+	ListLeaves(ctx context.Context, employeeID int32, status LeaveStatus, from, to time.Time) ([]EmployeeLeave, error)
+	// ReviewLeave trả về thêm các lịch hẹn đã phân công cho nhân viên rơi vào khoảng nghỉ được duyệt
+	ReviewLeave(ctx context.Context, id int32, approve bool, reviewerID int32) (*EmployeeLeave, []int32, error)
+	// Tự phân công
+	AutoAssignAppointment(ctx context.Context, appointmentID int32) (int32, string, error)
+	SetEmployeeSkills(ctx context.Context, employeeID int32, serviceIDs []int32) (string, error)
+	ListEmployeeSkills(ctx context.Context, employeeID int32) ([]int32, error)
 }
 
 // --- CHUYỂN ĐỔI ENUM PROTO <-> GO ---
@@ -102,12 +554,16 @@ func toPbAppointmentStatus(status AppointmentStatus) pb.AppointmentStatus {
 	switch status {
 	case StatusPending:
 		return pb.AppointmentStatus_PENDING
+	case StatusConfirmed:
+		return pb.AppointmentStatus_CONFIRMED
 	case StatusInProgress:
 		return pb.AppointmentStatus_IN_PROGRESS
 	case StatusCompleted:
 		return pb.AppointmentStatus_COMPLETED
 	case StatusCancelled:
 		return pb.AppointmentStatus_CANCELLED
+	case StatusNoShow:
+		return pb.AppointmentStatus_NO_SHOW
 	default:
 		return pb.AppointmentStatus_UNSPECIFIED
 	}
@@ -116,14 +572,18 @@ func fromPbAppointmentStatus(pbStatus pb.AppointmentStatus) AppointmentStatus {
 	switch pbStatus {
 	case pb.AppointmentStatus_PENDING:
 		return StatusPending
+	case pb.AppointmentStatus_CONFIRMED:
+		return StatusConfirmed
 	case pb.AppointmentStatus_IN_PROGRESS:
 		return StatusInProgress
 	case pb.AppointmentStatus_COMPLETED:
 		return StatusCompleted
 	case pb.AppointmentStatus_CANCELLED:
 		return StatusCancelled
+	case pb.AppointmentStatus_NO_SHOW:
+		return StatusNoShow
 	default:
-		return StatusPending // Mặc định PENDING nếu không xác định
+		return "" // không xác định, UpdateAppointmentStatus sẽ từ chối
 	}
 }
 
@@ -134,15 +594,27 @@ func toProtoAppointment(a *Appointment) *pb.Appointment {
 
 	// Thực hiện chuyển đổi sang pb.Appointment
 	pbApp := &pb.Appointment{
-		Id:              a.ID,
-		CustomerId:      a.CustomerID,
-		EmployeeId:      a.EmployeeID,
-		CustomerAddress: a.CustomerAddress,
-		ScheduledTime:   timestamppb.New(a.ScheduledTime),
-		Status:          toPbAppointmentStatus(a.Status),
-		BranchId:        a.BranchID,
-		Note:            a.Note,
-		Total:           a.Total,
+		Id:                a.ID,
+		CustomerId:        a.CustomerID,
+		EmployeeId:        a.EmployeeID,
+		CustomerAddress:   a.CustomerAddress,
+		ScheduledTime:     timestamppb.New(a.ScheduledTime),
+		Status:            toPbAppointmentStatus(a.Status),
+		BranchId:          a.BranchID,
+		Note:              a.Note,
+		Total:             a.Total,
+		Subtotal:          a.Subtotal,
+		Discount:          a.Discount,
+		AppliedPromotions: toProtoAppliedPromotions(a.Promotions),
+		DurationMinutes:   int32(a.EndTime().Sub(a.ScheduledTime) / time.Minute),
+		CancellationFee:   a.CancellationFee,
+		RescheduleCount:   a.RescheduleCount,
+		SeriesId:          int32(a.SeriesID),
+		PetIds:            a.PetIDs(),
+	}
+	if pbApp.Subtotal == 0 && pbApp.Discount == 0 {
+		// Lịch hẹn tạo trước khi có khuyến mãi chưa lưu Subtotal
+		pbApp.Subtotal = a.Total
 	}
 	log.Printf("Converted pb.Appointment: id=%d, status=%v", pbApp.Id, pbApp.Status)
 	// Log ra giá trị sau khi chuyển đổi
@@ -151,13 +623,180 @@ func toProtoAppointment(a *Appointment) *pb.Appointment {
 	return pbApp
 }
 
+func toProtoAppliedPromotions(promotions []AppointmentPromotion) []*pb.AppliedPromotion {
+	pbPromotions := make([]*pb.AppliedPromotion, len(promotions))
+	for i, p := range promotions {
+		pbPromotions[i] = &pb.AppliedPromotion{
+			PromotionId: p.PromotionID,
+			Code:        p.Code,
+			Name:        p.Name,
+			Discount:    p.Discount,
+		}
+	}
+	return pbPromotions
+}
+
 func toProtoService(s *Service) *pb.Service {
 	return &pb.Service{
-		Id:          s.ID,
-		Name:        s.Name,
-		Description: s.Description,
-		Price:       s.Price,
-		Imgurl:      s.ImgUrl,
+		Id:              s.ID,
+		Name:            s.Name,
+		Description:     s.Description,
+		Price:           s.Price,
+		Imgurl:          s.ImgUrl,
+		IsArchived:      s.IsArchived,
+		DurationMinutes: s.DurationMinutes,
+	}
+}
+
+func toProtoEmployeeShift(s *EmployeeShift) *pb.EmployeeShift {
+	return &pb.EmployeeShift{
+		Id:         s.ID,
+		EmployeeId: s.EmployeeID,
+		BranchId:   s.BranchID,
+		StartTime:  s.StartTime.Format(time.RFC3339),
+		EndTime:    s.EndTime.Format(time.RFC3339),
+		TemplateId: s.TemplateID,
+	}
+}
+
+func toProtoShiftTemplate(t *ShiftTemplate) *pb.ShiftTemplate {
+	return &pb.ShiftTemplate{
+		Id:         t.ID,
+		EmployeeId: t.EmployeeID,
+		BranchId:   t.BranchID,
+		Weekday:    t.Weekday,
+		StartTime:  t.StartClock,
+		EndTime:    t.EndClock,
+		ValidFrom:  t.ValidFrom,
+		ValidUntil: t.ValidUntil,
+	}
+}
+
+func toProtoEmployeeLeave(l *EmployeeLeave) *pb.EmployeeLeave {
+	leave := &pb.EmployeeLeave{
+		Id:         l.ID,
+		EmployeeId: l.EmployeeID,
+		StartTime:  l.StartTime.Format(time.RFC3339),
+		EndTime:    l.EndTime.Format(time.RFC3339),
+		Reason:     l.Reason,
+		Status:     string(l.Status),
+		ReviewedBy: l.ReviewedBy,
+	}
+	if l.ReviewedAt != nil {
+		leave.ReviewedAt = l.ReviewedAt.Format(time.RFC3339)
+	}
+	return leave
+}
+
+func toProtoStatusChange(h *AppointmentStatusHistory) *pb.AppointmentStatusChange {
+	return &pb.AppointmentStatusChange{
+		Id:              h.ID,
+		AppointmentId:   h.AppointmentID,
+		FromStatus:      toPbAppointmentStatus(h.FromStatus),
+		ToStatus:        toPbAppointmentStatus(h.ToStatus),
+		Reason:          h.Reason,
+		ChangedBy:       h.ChangedBy,
+		CancellationFee: h.CancellationFee,
+		ChangedAt:       timestamppb.New(h.CreatedAt),
+	}
+}
+
+func toPbFrequency(f RecurrenceFrequency) pb.RecurrenceFrequency {
+	switch f {
+	case FrequencyWeekly:
+		return pb.RecurrenceFrequency_WEEKLY
+	case FrequencyMonthly:
+		return pb.RecurrenceFrequency_MONTHLY
+	default:
+		return pb.RecurrenceFrequency_RECURRENCE_UNSPECIFIED
+	}
+}
+
+func fromPbFrequency(f pb.RecurrenceFrequency) RecurrenceFrequency {
+	switch f {
+	case pb.RecurrenceFrequency_WEEKLY:
+		return FrequencyWeekly
+	case pb.RecurrenceFrequency_MONTHLY:
+		return FrequencyMonthly
+	default:
+		return "" // CreateAppointmentSeries sẽ từ chối
+	}
+}
+
+func toProtoAppointmentSeries(series *AppointmentSeries) *pb.AppointmentSeries {
+	services := make([]*pb.AppointmentDetail, len(series.Services))
+	for i, svc := range series.Services {
+		services[i] = &pb.AppointmentDetail{ServiceId: svc.ServiceID, Quantity: svc.Quantity, PetId: svc.PetID}
+	}
+	return &pb.AppointmentSeries{
+		Id:              series.ID,
+		CustomerId:      series.CustomerID,
+		EmployeeId:      series.EmployeeID,
+		BranchId:        series.BranchID,
+		CustomerAddress: series.CustomerAddress,
+		Note:            series.Note,
+		Frequency:       toPbFrequency(series.Frequency),
+		Interval:        series.Interval,
+		StartTime:       timestamppb.New(series.StartTime),
+		UntilDate:       series.UntilDate,
+		Count:           series.Count,
+		Status:          string(series.Status),
+		Services:        services,
+		PetIds:          series.PetIDs(),
+	}
+}
+
+func toProtoAppointments(appointments []Appointment) []*pb.Appointment {
+	result := make([]*pb.Appointment, len(appointments))
+	for i := range appointments {
+		result[i] = toProtoAppointment(&appointments[i])
+	}
+	return result
+}
+
+func toProtoSkippedOccurrences(skipped []SkippedOccurrence) []*pb.SkippedOccurrence {
+	result := make([]*pb.SkippedOccurrence, len(skipped))
+	for i, o := range skipped {
+		result[i] = &pb.SkippedOccurrence{ScheduledTime: timestamppb.New(o.ScheduledTime), Reason: o.Reason}
+	}
+	return result
+}
+
+func toProtoReassignments(reassignments []Reassignment) []*pb.Reassignment {
+	result := make([]*pb.Reassignment, len(reassignments))
+	for i, r := range reassignments {
+		result[i] = &pb.Reassignment{AppointmentId: r.AppointmentID, EmployeeId: r.EmployeeID}
+	}
+	return result
+}
+
+func toProtoServicePriceChange(c *ServicePriceChange) *pb.ServicePriceChange {
+	change := &pb.ServicePriceChange{
+		Id:            c.ID,
+		ServiceId:     c.ServiceID,
+		Price:         c.Price,
+		PreviousPrice: c.PreviousPrice,
+		EffectiveAt:   c.EffectiveAt.Format(time.RFC3339),
+		CreatedBy:     c.CreatedBy,
+		Note:          c.Note,
+		Status:        c.Status(),
+	}
+	if c.AppliedAt != nil {
+		change.AppliedAt = c.AppliedAt.Format(time.RFC3339)
+	}
+	return change
+}
+
+func toProtoServiceImage(img *ServiceImage) *pb.ServiceImage {
+	return &pb.ServiceImage{
+		Id:           img.ID,
+		ServiceId:    img.ServiceID,
+		Url:          img.URL,
+		ThumbnailUrl: img.ThumbnailURL,
+		StorageKey:   img.StorageKey,
+		ThumbnailKey: img.ThumbnailKey,
+		Position:     img.Position,
+		IsPrimary:    img.IsPrimary,
 	}
 }
 
@@ -166,6 +805,7 @@ func toProtoAppointmentDetail(ad *AppointmentDetail) *pb.AppointmentDetail {
 		AppointmentId: ad.AppointmentID,
 		ServiceId:     ad.ServiceID,
 		ServicePrice:  ad.ServicePrice,
+		PetId:         ad.PetID,
 	}
 }
 
@@ -177,6 +817,7 @@ func toProtoAppointmentDetailWithService(detail *AppointmentDetail, serviceName
 		ServiceName:   serviceName,
 		ServicePrice:  detail.ServicePrice,
 		Quantity:      detail.Quantity,
+		PetId:         detail.PetID,
 	}
 }
 
@@ -184,3 +825,44 @@ type AppointmentDetailWithService struct {
 	AppointmentDetail
 	ServiceName string
 }
+
+// AgendaEntry - lịch hẹn trong lịch làm việc của nhân viên, Appointment có sẵn Details.Service và Pets.
+// Customer/Pets lấy từ Users/Records service, nil/thiếu khi không tra được.
+type AgendaEntry struct {
+	Appointment Appointment
+	Customer    *pbUser.User
+	Pets        []*pbRecord.Pet
+}
+
+func toProtoAgendaAppointment(e *AgendaEntry) *pb.AgendaAppointment {
+	details := make([]*pb.AppointmentDetailWithService, len(e.Appointment.Details))
+	for i, d := range e.Appointment.Details {
+		details[i] = toProtoAppointmentDetailWithService(&d, d.Service.Name)
+	}
+	pets := make([]*pb.AgendaPet, len(e.Pets))
+	for i, p := range e.Pets {
+		pets[i] = &pb.AgendaPet{
+			Id:           p.Id,
+			Name:         p.Name,
+			Species:      p.Species,
+			Dob:          p.Dob,
+			Color:        p.Color,
+			Weight:       p.Weight,
+			IdentityMark: p.IdentityMark,
+		}
+	}
+	agenda := &pb.AgendaAppointment{
+		Appointment:     toProtoAppointment(&e.Appointment),
+		CustomerAddress: e.Appointment.CustomerAddress,
+		Pets:            pets,
+		Details:         details,
+	}
+	if e.Customer != nil {
+		agenda.CustomerName = e.Customer.Name
+		agenda.CustomerPhone = e.Customer.PhoneNumber
+		if agenda.CustomerAddress == "" {
+			agenda.CustomerAddress = e.Customer.Address
+		}
+	}
+	return agenda
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Cache - Cache đọc qua (read-through) trên Redis cho các danh sách đọc nhiều, ghi ít.
+// Redis lỗi thì không làm hỏng request: đọc lỗi coi như miss và lấy từ database, ghi lỗi thì bỏ qua.
+// Cache nil cũng dùng được, mọi lần đọc đều đi thẳng xuống database.
+type Cache struct {
+	client *redis.Client
+	prefix string
+
+	hits   atomic.Int64
+	misses atomic.Int64
+	errors atomic.Int64
+}
+
+// Stats - Số lần trúng / trượt cache và lỗi Redis kể từ khi service khởi động
+type Stats struct {
+	Hits     int64
+	Misses   int64
+	Errors   int64
+	HitRatio float64 // Hits / (Hits + Misses), 0 nếu chưa có lần đọc nào
+}
+
+// New tạo cache với các key có tiền tố prefix (ví dụ "products:") để các service dùng chung Redis không đè key của nhau
+func New(client *redis.Client, prefix string) *Cache {
+	return &Cache{client: client, prefix: prefix}
+}
+
+// Load trả về giá trị của key trong cache, nếu chưa có thì gọi load rồi lưu kết quả với thời hạn ttl
+func Load[T any](ctx context.Context, c *Cache, key string, ttl time.Duration, load func() (T, error)) (T, error) {
+	if c == nil {
+		return load()
+	}
+	data, err := c.client.Get(ctx, c.prefix+key).Bytes()
+	if err == nil {
+		var value T
+		if err := json.Unmarshal(data, &value); err == nil {
+			c.hits.Add(1)
+			return value, nil
+		}
+		c.errors.Add(1)
+	} else if !errors.Is(err, redis.Nil) {
+		c.errors.Add(1)
+		log.Printf("cache: get %s%s: %v", c.prefix, key, err)
+	}
+
+	c.misses.Add(1)
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	if data, err := json.Marshal(value); err == nil {
+		if err := c.client.Set(ctx, c.prefix+key, data, ttl).Err(); err != nil {
+			c.errors.Add(1)
+			log.Printf("cache: set %s%s: %v", c.prefix, key, err)
+		}
+	}
+	return value, nil
+}
+
+// Invalidate xoá các key sau khi dữ liệu nguồn thay đổi, lần đọc kế tiếp sẽ lấy lại từ database
+func (c *Cache) Invalidate(ctx context.Context, keys ...string) {
+	if c == nil || len(keys) == 0 {
+		return
+	}
+	prefixed := make([]string, len(keys))
+	for i, key := range keys {
+		prefixed[i] = c.prefix + key
+	}
+	// Không dùng ctx của request: request bị huỷ giữa chừng cũng không được để lại dữ liệu cũ trong cache
+	if err := c.client.Del(context.WithoutCancel(ctx), prefixed...).Err(); err != nil {
+		c.errors.Add(1)
+		log.Printf("cache: invalidate %v: %v", prefixed, err)
+	}
+}
+
+func (c *Cache) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+	stats := Stats{Hits: c.hits.Load(), Misses: c.misses.Load(), Errors: c.errors.Load()}
+	if total := stats.Hits + stats.Misses; total > 0 {
+		stats.HitRatio = float64(stats.Hits) / float64(total)
+	}
+	return stats
+}
@@ -33,6 +33,24 @@ type Config struct {
 	ClientID               string
 	ApiKey                 string
 	CheckSumKey            string
+	MediaDir               string
+	MediaBaseURL           string
+	// AssignmentStrategy - cách Appointments tự phân công nhân viên: skill_match, least_loaded hoặc round_robin
+	AssignmentStrategy string
+	// Huỷ lịch hẹn trong vòng AppointmentCancelDeadlineHours giờ trước giờ hẹn bị tính phí
+	// AppointmentLateCancelFeePercent % tổng tiền (0 = không tính phí)
+	AppointmentCancelDeadlineHours  int64
+	AppointmentLateCancelFeePercent int64
+	// AppointmentMaxReschedules - số lần tối đa một lịch hẹn được dời
+	AppointmentMaxReschedules int64
+	// AppointmentReminderOffsets - các mốc nhắc lịch trước giờ hẹn, cách nhau bởi dấu phẩy (vd "24h,2h")
+	AppointmentReminderOffsets string
+	// Geocoder - cách Appointments tra toạ độ địa chỉ khách: stub (offline, toạ độ giả lập) hoặc nominatim (GeocoderURL)
+	Geocoder    string
+	GeocoderURL string
+	// HomeVisitSpeedKmh - tốc độ di chuyển trung bình giữa các lịch tại nhà, 0 = không chừa thời gian di chuyển.
+	// Chỉ có tác dụng với geocoder thật (nominatim), stub chỉ cho toạ độ giả lập
+	HomeVisitSpeedKmh int64
 }
 
 var Envs = initConfig()
@@ -69,6 +87,17 @@ func initConfig() Config {
 		ClientID:               getEnv("CLIENT_ID", "your-client-id"),
 		ApiKey:                 getEnv("API_KEY", "your-api-key"),
 		CheckSumKey:            getEnv("CHECKSUM_KEY", "your-checksum-key"),
+		MediaDir:               getEnv("MEDIA_DIR", "./media"),
+		MediaBaseURL:           getEnv("MEDIA_BASE_URL", "http://localhost:8080/media"),
+		AssignmentStrategy:     getEnv("APPOINTMENT_ASSIGNMENT_STRATEGY", "skill_match"),
+
+		AppointmentCancelDeadlineHours:  getEnvAsInt("APPOINTMENT_CANCEL_DEADLINE_HOURS", 24),
+		AppointmentLateCancelFeePercent: getEnvAsInt("APPOINTMENT_LATE_CANCEL_FEE_PERCENT", 0),
+		AppointmentMaxReschedules:       getEnvAsInt("APPOINTMENT_MAX_RESCHEDULES", 2),
+		AppointmentReminderOffsets:      getEnv("APPOINTMENT_REMINDER_OFFSETS", "24h,2h"),
+		Geocoder:                        getEnv("APPOINTMENT_GEOCODER", "stub"),
+		GeocoderURL:                     getEnv("GEOCODER_URL", "https://nominatim.openstreetmap.org"),
+		HomeVisitSpeedKmh:               getEnvAsInt("HOME_VISIT_SPEED_KMH", 0),
 	}
 }
 func getEnv(key, fallback string) string {
@@ -24,13 +24,46 @@ const (
 	AppointmentService_GetAppointmentsByEmployee_FullMethodName    = "/appointments.AppointmentService/GetAppointmentsByEmployee"
 	AppointmentService_GetAppointmentsByBranch_FullMethodName      = "/appointments.AppointmentService/GetAppointmentsByBranch"
 	AppointmentService_GetAllAppointments_FullMethodName           = "/appointments.AppointmentService/GetAllAppointments"
+	AppointmentService_GetEmployeeAgenda_FullMethodName            = "/appointments.AppointmentService/GetEmployeeAgenda"
+	AppointmentService_GetEmployeeRoute_FullMethodName             = "/appointments.AppointmentService/GetEmployeeRoute"
 	AppointmentService_UpdateAppointmentStatus_FullMethodName      = "/appointments.AppointmentService/UpdateAppointmentStatus"
+	AppointmentService_GetAppointmentStatusHistory_FullMethodName  = "/appointments.AppointmentService/GetAppointmentStatusHistory"
+	AppointmentService_RescheduleAppointment_FullMethodName        = "/appointments.AppointmentService/RescheduleAppointment"
 	AppointmentService_UpdateEmployeeForAppointment_FullMethodName = "/appointments.AppointmentService/UpdateEmployeeForAppointment"
+	AppointmentService_CreateAppointmentSeries_FullMethodName      = "/appointments.AppointmentService/CreateAppointmentSeries"
+	AppointmentService_GetAppointmentSeries_FullMethodName         = "/appointments.AppointmentService/GetAppointmentSeries"
+	AppointmentService_UpdateAppointmentSeries_FullMethodName      = "/appointments.AppointmentService/UpdateAppointmentSeries"
+	AppointmentService_CancelAppointmentSeries_FullMethodName      = "/appointments.AppointmentService/CancelAppointmentSeries"
 	AppointmentService_GetAppointmentDetails_FullMethodName        = "/appointments.AppointmentService/GetAppointmentDetails"
 	AppointmentService_CreateService_FullMethodName                = "/appointments.AppointmentService/CreateService"
 	AppointmentService_GetServices_FullMethodName                  = "/appointments.AppointmentService/GetServices"
 	AppointmentService_UpdateService_FullMethodName                = "/appointments.AppointmentService/UpdateService"
 	AppointmentService_DeleteService_FullMethodName                = "/appointments.AppointmentService/DeleteService"
+	AppointmentService_RestoreService_FullMethodName               = "/appointments.AppointmentService/RestoreService"
+	AppointmentService_ListArchivedServices_FullMethodName         = "/appointments.AppointmentService/ListArchivedServices"
+	AppointmentService_AddServiceImage_FullMethodName              = "/appointments.AppointmentService/AddServiceImage"
+	AppointmentService_AddServiceImages_FullMethodName             = "/appointments.AppointmentService/AddServiceImages"
+	AppointmentService_ListServiceImages_FullMethodName            = "/appointments.AppointmentService/ListServiceImages"
+	AppointmentService_DeleteServiceImage_FullMethodName           = "/appointments.AppointmentService/DeleteServiceImage"
+	AppointmentService_GetCacheStats_FullMethodName                = "/appointments.AppointmentService/GetCacheStats"
+	AppointmentService_ScheduleServicePriceChange_FullMethodName   = "/appointments.AppointmentService/ScheduleServicePriceChange"
+	AppointmentService_CancelServicePriceChange_FullMethodName     = "/appointments.AppointmentService/CancelServicePriceChange"
+	AppointmentService_ListServicePriceHistory_FullMethodName      = "/appointments.AppointmentService/ListServicePriceHistory"
+	AppointmentService_GetServicePriceAt_FullMethodName            = "/appointments.AppointmentService/GetServicePriceAt"
+	AppointmentService_GetAvailableSlots_FullMethodName            = "/appointments.AppointmentService/GetAvailableSlots"
+	AppointmentService_CreateEmployeeShift_FullMethodName          = "/appointments.AppointmentService/CreateEmployeeShift"
+	AppointmentService_ListEmployeeShifts_FullMethodName           = "/appointments.AppointmentService/ListEmployeeShifts"
+	AppointmentService_DeleteEmployeeShift_FullMethodName          = "/appointments.AppointmentService/DeleteEmployeeShift"
+	AppointmentService_CreateShiftTemplate_FullMethodName          = "/appointments.AppointmentService/CreateShiftTemplate"
+	AppointmentService_ListShiftTemplates_FullMethodName           = "/appointments.AppointmentService/ListShiftTemplates"
+	AppointmentService_DeleteShiftTemplate_FullMethodName          = "/appointments.AppointmentService/DeleteShiftTemplate"
+	AppointmentService_GenerateShifts_FullMethodName               = "/appointments.AppointmentService/GenerateShifts"
+	AppointmentService_RequestLeave_FullMethodName                 = "/appointments.AppointmentService/RequestLeave"
+	AppointmentService_ListLeaves_FullMethodName                   = "/appointments.AppointmentService/ListLeaves"
+	AppointmentService_ReviewLeave_FullMethodName                  = "/appointments.AppointmentService/ReviewLeave"
+	AppointmentService_AutoAssignAppointment_FullMethodName        = "/appointments.AppointmentService/AutoAssignAppointment"
+	AppointmentService_SetEmployeeSkills_FullMethodName            = "/appointments.AppointmentService/SetEmployeeSkills"
+	AppointmentService_ListEmployeeSkills_FullMethodName           = "/appointments.AppointmentService/ListEmployeeSkills"
 )
 
 // AppointmentServiceClient is the client API for AppointmentService service.
@@ -44,9 +77,22 @@ type AppointmentServiceClient interface {
 	GetAppointmentsByEmployee(ctx context.Context, in *GetAppointmentsByEmployeeRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error)
 	GetAppointmentsByBranch(ctx context.Context, in *GetAppointmentsByBranchRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error)
 	GetAllAppointments(ctx context.Context, in *GetAllAppointmentsRequest, opts ...grpc.CallOption) (*GetAllAppointmentsResponse, error)
+	// Lịch làm việc của nhân viên theo ngày, kèm thông tin khách, thú cưng và tên dịch vụ
+	GetEmployeeAgenda(ctx context.Context, in *GetEmployeeAgendaRequest, opts ...grpc.CallOption) (*GetEmployeeAgendaResponse, error)
+	// Lộ trình đề xuất đi qua các lịch tại nhà của nhân viên trong ngày
+	GetEmployeeRoute(ctx context.Context, in *GetEmployeeRouteRequest, opts ...grpc.CallOption) (*GetEmployeeRouteResponse, error)
 	// Cập nhật trạng thái lịch hẹn
 	UpdateAppointmentStatus(ctx context.Context, in *UpdateAppointmentStatusRequest, opts ...grpc.CallOption) (*UpdateAppointmentStatusResponse, error)
+	GetAppointmentStatusHistory(ctx context.Context, in *GetAppointmentStatusHistoryRequest, opts ...grpc.CallOption) (*GetAppointmentStatusHistoryResponse, error)
+	// Dời lịch hẹn sang giờ (và chi nhánh) khác, giữ nguyên giá đã đặt
+	RescheduleAppointment(ctx context.Context, in *RescheduleAppointmentRequest, opts ...grpc.CallOption) (*RescheduleAppointmentResponse, error)
 	UpdateEmployeeForAppointment(ctx context.Context, in *UpdateEmployeeForAppointmentRequest, opts ...grpc.CallOption) (*UpdateEmployeeForAppointmentResponse, error)
+	// Lịch hẹn định kỳ (vd. tỉa lông mỗi 4 tuần): các lần hẹn được sinh trước thành lịch hẹn thường.
+	// Sửa/huỷ một lần hẹn dùng RescheduleAppointment/UpdateAppointmentStatus, các RPC dưới đây áp dụng cho cả chuỗi.
+	CreateAppointmentSeries(ctx context.Context, in *CreateAppointmentSeriesRequest, opts ...grpc.CallOption) (*CreateAppointmentSeriesResponse, error)
+	GetAppointmentSeries(ctx context.Context, in *GetAppointmentSeriesRequest, opts ...grpc.CallOption) (*GetAppointmentSeriesResponse, error)
+	UpdateAppointmentSeries(ctx context.Context, in *UpdateAppointmentSeriesRequest, opts ...grpc.CallOption) (*UpdateAppointmentSeriesResponse, error)
+	CancelAppointmentSeries(ctx context.Context, in *CancelAppointmentSeriesRequest, opts ...grpc.CallOption) (*CancelAppointmentSeriesResponse, error)
 	// Lấy chi tiết lịch hẹn
 	GetAppointmentDetails(ctx context.Context, in *GetAppointmentDetailsRequest, opts ...grpc.CallOption) (*GetAppointmentDetailsResponse, error)
 	// Quản lý danh sách dịch vụ
@@ -54,6 +100,41 @@ type AppointmentServiceClient interface {
 	GetServices(ctx context.Context, in *GetServicesRequest, opts ...grpc.CallOption) (*GetServicesResponse, error)
 	UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*UpdateServiceResponse, error)
 	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error)
+	// Dịch vụ bị xoá chỉ được lưu trữ: ẩn khỏi GetServices, vẫn hiển thị trong chi tiết lịch hẹn cũ
+	RestoreService(ctx context.Context, in *RestoreServiceRequest, opts ...grpc.CallOption) (*RestoreServiceResponse, error)
+	ListArchivedServices(ctx context.Context, in *GetServicesRequest, opts ...grpc.CallOption) (*GetServicesResponse, error)
+	// Ảnh dịch vụ
+	AddServiceImage(ctx context.Context, in *AddServiceImageRequest, opts ...grpc.CallOption) (*ServiceImage, error)
+	AddServiceImages(ctx context.Context, in *AddServiceImagesRequest, opts ...grpc.CallOption) (*ListServiceImagesResponse, error)
+	ListServiceImages(ctx context.Context, in *ListServiceImagesRequest, opts ...grpc.CallOption) (*ListServiceImagesResponse, error)
+	DeleteServiceImage(ctx context.Context, in *DeleteServiceImageRequest, opts ...grpc.CallOption) (*ServiceImage, error)
+	// Tỉ lệ trúng cache của danh sách dịch vụ
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*CacheStats, error)
+	// Lịch sử giá và giá hẹn trước của dịch vụ
+	ScheduleServicePriceChange(ctx context.Context, in *ScheduleServicePriceChangeRequest, opts ...grpc.CallOption) (*ServicePriceChange, error)
+	CancelServicePriceChange(ctx context.Context, in *CancelServicePriceChangeRequest, opts ...grpc.CallOption) (*CancelServicePriceChangeResponse, error)
+	ListServicePriceHistory(ctx context.Context, in *ListServicePriceHistoryRequest, opts ...grpc.CallOption) (*ListServicePriceHistoryResponse, error)
+	GetServicePriceAt(ctx context.Context, in *GetServicePriceAtRequest, opts ...grpc.CallOption) (*GetServicePriceAtResponse, error)
+	// Khung giờ còn trống của chi nhánh trong một ngày cho danh sách dịch vụ
+	GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error)
+	// Ca làm việc của nhân viên tại chi nhánh
+	CreateEmployeeShift(ctx context.Context, in *CreateEmployeeShiftRequest, opts ...grpc.CallOption) (*EmployeeShift, error)
+	ListEmployeeShifts(ctx context.Context, in *ListEmployeeShiftsRequest, opts ...grpc.CallOption) (*ListEmployeeShiftsResponse, error)
+	DeleteEmployeeShift(ctx context.Context, in *DeleteEmployeeShiftRequest, opts ...grpc.CallOption) (*DeleteEmployeeShiftResponse, error)
+	// Lịch làm việc cố định hằng tuần, sinh trước thành ca cụ thể
+	CreateShiftTemplate(ctx context.Context, in *CreateShiftTemplateRequest, opts ...grpc.CallOption) (*ShiftTemplate, error)
+	ListShiftTemplates(ctx context.Context, in *ListShiftTemplatesRequest, opts ...grpc.CallOption) (*ListShiftTemplatesResponse, error)
+	DeleteShiftTemplate(ctx context.Context, in *DeleteShiftTemplateRequest, opts ...grpc.CallOption) (*DeleteShiftTemplateResponse, error)
+	GenerateShifts(ctx context.Context, in *GenerateShiftsRequest, opts ...grpc.CallOption) (*GenerateShiftsResponse, error)
+	// Nghỉ phép
+	RequestLeave(ctx context.Context, in *RequestLeaveRequest, opts ...grpc.CallOption) (*EmployeeLeave, error)
+	ListLeaves(ctx context.Context, in *ListLeavesRequest, opts ...grpc.CallOption) (*ListLeavesResponse, error)
+	ReviewLeave(ctx context.Context, in *ReviewLeaveRequest, opts ...grpc.CallOption) (*ReviewLeaveResponse, error)
+	// Tự phân công nhân viên rảnh tại chi nhánh theo chiến lược cấu hình
+	AutoAssignAppointment(ctx context.Context, in *AutoAssignAppointmentRequest, opts ...grpc.CallOption) (*AutoAssignAppointmentResponse, error)
+	// Kỹ năng của nhân viên: dịch vụ nhân viên thực hiện được, dùng khi phân công theo kỹ năng
+	SetEmployeeSkills(ctx context.Context, in *SetEmployeeSkillsRequest, opts ...grpc.CallOption) (*SetEmployeeSkillsResponse, error)
+	ListEmployeeSkills(ctx context.Context, in *ListEmployeeSkillsRequest, opts ...grpc.CallOption) (*ListEmployeeSkillsResponse, error)
 }
 
 type appointmentServiceClient struct {
@@ -114,6 +195,26 @@ func (c *appointmentServiceClient) GetAllAppointments(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *appointmentServiceClient) GetEmployeeAgenda(ctx context.Context, in *GetEmployeeAgendaRequest, opts ...grpc.CallOption) (*GetEmployeeAgendaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeAgendaResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetEmployeeAgenda_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetEmployeeRoute(ctx context.Context, in *GetEmployeeRouteRequest, opts ...grpc.CallOption) (*GetEmployeeRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEmployeeRouteResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetEmployeeRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appointmentServiceClient) UpdateAppointmentStatus(ctx context.Context, in *UpdateAppointmentStatusRequest, opts ...grpc.CallOption) (*UpdateAppointmentStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateAppointmentStatusResponse)
@@ -124,6 +225,26 @@ func (c *appointmentServiceClient) UpdateAppointmentStatus(ctx context.Context,
 	return out, nil
 }
 
+func (c *appointmentServiceClient) GetAppointmentStatusHistory(ctx context.Context, in *GetAppointmentStatusHistoryRequest, opts ...grpc.CallOption) (*GetAppointmentStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppointmentStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetAppointmentStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) RescheduleAppointment(ctx context.Context, in *RescheduleAppointmentRequest, opts ...grpc.CallOption) (*RescheduleAppointmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleAppointmentResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_RescheduleAppointment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appointmentServiceClient) UpdateEmployeeForAppointment(ctx context.Context, in *UpdateEmployeeForAppointmentRequest, opts ...grpc.CallOption) (*UpdateEmployeeForAppointmentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateEmployeeForAppointmentResponse)
@@ -134,6 +255,46 @@ func (c *appointmentServiceClient) UpdateEmployeeForAppointment(ctx context.Cont
 	return out, nil
 }
 
+func (c *appointmentServiceClient) CreateAppointmentSeries(ctx context.Context, in *CreateAppointmentSeriesRequest, opts ...grpc.CallOption) (*CreateAppointmentSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAppointmentSeriesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_CreateAppointmentSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetAppointmentSeries(ctx context.Context, in *GetAppointmentSeriesRequest, opts ...grpc.CallOption) (*GetAppointmentSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppointmentSeriesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetAppointmentSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) UpdateAppointmentSeries(ctx context.Context, in *UpdateAppointmentSeriesRequest, opts ...grpc.CallOption) (*UpdateAppointmentSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAppointmentSeriesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_UpdateAppointmentSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) CancelAppointmentSeries(ctx context.Context, in *CancelAppointmentSeriesRequest, opts ...grpc.CallOption) (*CancelAppointmentSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAppointmentSeriesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_CancelAppointmentSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appointmentServiceClient) GetAppointmentDetails(ctx context.Context, in *GetAppointmentDetailsRequest, opts ...grpc.CallOption) (*GetAppointmentDetailsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAppointmentDetailsResponse)
@@ -171,319 +332,1310 @@ func (c *appointmentServiceClient) UpdateService(ctx context.Context, in *Update
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *appointmentServiceClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteServiceResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_DeleteService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) RestoreService(ctx context.Context, in *RestoreServiceRequest, opts ...grpc.CallOption) (*RestoreServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreServiceResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_RestoreService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListArchivedServices(ctx context.Context, in *GetServicesRequest, opts ...grpc.CallOption) (*GetServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServicesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListArchivedServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) AddServiceImage(ctx context.Context, in *AddServiceImageRequest, opts ...grpc.CallOption) (*ServiceImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceImage)
+	err := c.cc.Invoke(ctx, AppointmentService_AddServiceImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) AddServiceImages(ctx context.Context, in *AddServiceImagesRequest, opts ...grpc.CallOption) (*ListServiceImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceImagesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_AddServiceImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListServiceImages(ctx context.Context, in *ListServiceImagesRequest, opts ...grpc.CallOption) (*ListServiceImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceImagesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListServiceImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) DeleteServiceImage(ctx context.Context, in *DeleteServiceImageRequest, opts ...grpc.CallOption) (*ServiceImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceImage)
+	err := c.cc.Invoke(ctx, AppointmentService_DeleteServiceImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*CacheStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, AppointmentService_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ScheduleServicePriceChange(ctx context.Context, in *ScheduleServicePriceChangeRequest, opts ...grpc.CallOption) (*ServicePriceChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServicePriceChange)
+	err := c.cc.Invoke(ctx, AppointmentService_ScheduleServicePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) CancelServicePriceChange(ctx context.Context, in *CancelServicePriceChangeRequest, opts ...grpc.CallOption) (*CancelServicePriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelServicePriceChangeResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_CancelServicePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListServicePriceHistory(ctx context.Context, in *ListServicePriceHistoryRequest, opts ...grpc.CallOption) (*ListServicePriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServicePriceHistoryResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListServicePriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetServicePriceAt(ctx context.Context, in *GetServicePriceAtRequest, opts ...grpc.CallOption) (*GetServicePriceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServicePriceAtResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetServicePriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GetAvailableSlots(ctx context.Context, in *GetAvailableSlotsRequest, opts ...grpc.CallOption) (*GetAvailableSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAvailableSlotsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GetAvailableSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) CreateEmployeeShift(ctx context.Context, in *CreateEmployeeShiftRequest, opts ...grpc.CallOption) (*EmployeeShift, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeShift)
+	err := c.cc.Invoke(ctx, AppointmentService_CreateEmployeeShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListEmployeeShifts(ctx context.Context, in *ListEmployeeShiftsRequest, opts ...grpc.CallOption) (*ListEmployeeShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeeShiftsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListEmployeeShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) DeleteEmployeeShift(ctx context.Context, in *DeleteEmployeeShiftRequest, opts ...grpc.CallOption) (*DeleteEmployeeShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEmployeeShiftResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_DeleteEmployeeShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) CreateShiftTemplate(ctx context.Context, in *CreateShiftTemplateRequest, opts ...grpc.CallOption) (*ShiftTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftTemplate)
+	err := c.cc.Invoke(ctx, AppointmentService_CreateShiftTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListShiftTemplates(ctx context.Context, in *ListShiftTemplatesRequest, opts ...grpc.CallOption) (*ListShiftTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftTemplatesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListShiftTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) DeleteShiftTemplate(ctx context.Context, in *DeleteShiftTemplateRequest, opts ...grpc.CallOption) (*DeleteShiftTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteShiftTemplateResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_DeleteShiftTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) GenerateShifts(ctx context.Context, in *GenerateShiftsRequest, opts ...grpc.CallOption) (*GenerateShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateShiftsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_GenerateShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) RequestLeave(ctx context.Context, in *RequestLeaveRequest, opts ...grpc.CallOption) (*EmployeeLeave, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmployeeLeave)
+	err := c.cc.Invoke(ctx, AppointmentService_RequestLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListLeaves(ctx context.Context, in *ListLeavesRequest, opts ...grpc.CallOption) (*ListLeavesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeavesResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListLeaves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ReviewLeave(ctx context.Context, in *ReviewLeaveRequest, opts ...grpc.CallOption) (*ReviewLeaveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewLeaveResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ReviewLeave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) AutoAssignAppointment(ctx context.Context, in *AutoAssignAppointmentRequest, opts ...grpc.CallOption) (*AutoAssignAppointmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AutoAssignAppointmentResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_AutoAssignAppointment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) SetEmployeeSkills(ctx context.Context, in *SetEmployeeSkillsRequest, opts ...grpc.CallOption) (*SetEmployeeSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEmployeeSkillsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_SetEmployeeSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appointmentServiceClient) ListEmployeeSkills(ctx context.Context, in *ListEmployeeSkillsRequest, opts ...grpc.CallOption) (*ListEmployeeSkillsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeeSkillsResponse)
+	err := c.cc.Invoke(ctx, AppointmentService_ListEmployeeSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AppointmentServiceServer is the server API for AppointmentService service.
+// All implementations must embed UnimplementedAppointmentServiceServer
+// for forward compatibility.
+type AppointmentServiceServer interface {
+	// Đặt lịch hẹn tại nhà khách hàng
+	CreateAppointment(context.Context, *CreateAppointmentRequest) (*CreateAppointmentResponse, error)
+	// Lấy danh sách lịch hẹn theo khách hàng hoặc nhân viên
+	GetAppointmentsByCustomer(context.Context, *GetAppointmentsByCustomerRequest) (*GetAppointmentsResponse, error)
+	GetAppointmentsByEmployee(context.Context, *GetAppointmentsByEmployeeRequest) (*GetAppointmentsResponse, error)
+	GetAppointmentsByBranch(context.Context, *GetAppointmentsByBranchRequest) (*GetAppointmentsResponse, error)
+	GetAllAppointments(context.Context, *GetAllAppointmentsRequest) (*GetAllAppointmentsResponse, error)
+	// Lịch làm việc của nhân viên theo ngày, kèm thông tin khách, thú cưng và tên dịch vụ
+	GetEmployeeAgenda(context.Context, *GetEmployeeAgendaRequest) (*GetEmployeeAgendaResponse, error)
+	// Lộ trình đề xuất đi qua các lịch tại nhà của nhân viên trong ngày
+	GetEmployeeRoute(context.Context, *GetEmployeeRouteRequest) (*GetEmployeeRouteResponse, error)
+	// Cập nhật trạng thái lịch hẹn
+	UpdateAppointmentStatus(context.Context, *UpdateAppointmentStatusRequest) (*UpdateAppointmentStatusResponse, error)
+	GetAppointmentStatusHistory(context.Context, *GetAppointmentStatusHistoryRequest) (*GetAppointmentStatusHistoryResponse, error)
+	// Dời lịch hẹn sang giờ (và chi nhánh) khác, giữ nguyên giá đã đặt
+	RescheduleAppointment(context.Context, *RescheduleAppointmentRequest) (*RescheduleAppointmentResponse, error)
+	UpdateEmployeeForAppointment(context.Context, *UpdateEmployeeForAppointmentRequest) (*UpdateEmployeeForAppointmentResponse, error)
+	// Lịch hẹn định kỳ (vd. tỉa lông mỗi 4 tuần): các lần hẹn được sinh trước thành lịch hẹn thường.
+	// Sửa/huỷ một lần hẹn dùng RescheduleAppointment/UpdateAppointmentStatus, các RPC dưới đây áp dụng cho cả chuỗi.
+	CreateAppointmentSeries(context.Context, *CreateAppointmentSeriesRequest) (*CreateAppointmentSeriesResponse, error)
+	GetAppointmentSeries(context.Context, *GetAppointmentSeriesRequest) (*GetAppointmentSeriesResponse, error)
+	UpdateAppointmentSeries(context.Context, *UpdateAppointmentSeriesRequest) (*UpdateAppointmentSeriesResponse, error)
+	CancelAppointmentSeries(context.Context, *CancelAppointmentSeriesRequest) (*CancelAppointmentSeriesResponse, error)
+	// Lấy chi tiết lịch hẹn
+	GetAppointmentDetails(context.Context, *GetAppointmentDetailsRequest) (*GetAppointmentDetailsResponse, error)
+	// Quản lý danh sách dịch vụ
+	CreateService(context.Context, *CreateServiceRequest) (*CreateServiceResponse, error)
+	GetServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error)
+	UpdateService(context.Context, *UpdateServiceRequest) (*UpdateServiceResponse, error)
+	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error)
+	// Dịch vụ bị xoá chỉ được lưu trữ: ẩn khỏi GetServices, vẫn hiển thị trong chi tiết lịch hẹn cũ
+	RestoreService(context.Context, *RestoreServiceRequest) (*RestoreServiceResponse, error)
+	ListArchivedServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error)
+	// Ảnh dịch vụ
+	AddServiceImage(context.Context, *AddServiceImageRequest) (*ServiceImage, error)
+	AddServiceImages(context.Context, *AddServiceImagesRequest) (*ListServiceImagesResponse, error)
+	ListServiceImages(context.Context, *ListServiceImagesRequest) (*ListServiceImagesResponse, error)
+	DeleteServiceImage(context.Context, *DeleteServiceImageRequest) (*ServiceImage, error)
+	// Tỉ lệ trúng cache của danh sách dịch vụ
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*CacheStats, error)
+	// Lịch sử giá và giá hẹn trước của dịch vụ
+	ScheduleServicePriceChange(context.Context, *ScheduleServicePriceChangeRequest) (*ServicePriceChange, error)
+	CancelServicePriceChange(context.Context, *CancelServicePriceChangeRequest) (*CancelServicePriceChangeResponse, error)
+	ListServicePriceHistory(context.Context, *ListServicePriceHistoryRequest) (*ListServicePriceHistoryResponse, error)
+	GetServicePriceAt(context.Context, *GetServicePriceAtRequest) (*GetServicePriceAtResponse, error)
+	// Khung giờ còn trống của chi nhánh trong một ngày cho danh sách dịch vụ
+	GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error)
+	// Ca làm việc của nhân viên tại chi nhánh
+	CreateEmployeeShift(context.Context, *CreateEmployeeShiftRequest) (*EmployeeShift, error)
+	ListEmployeeShifts(context.Context, *ListEmployeeShiftsRequest) (*ListEmployeeShiftsResponse, error)
+	DeleteEmployeeShift(context.Context, *DeleteEmployeeShiftRequest) (*DeleteEmployeeShiftResponse, error)
+	// Lịch làm việc cố định hằng tuần, sinh trước thành ca cụ thể
+	CreateShiftTemplate(context.Context, *CreateShiftTemplateRequest) (*ShiftTemplate, error)
+	ListShiftTemplates(context.Context, *ListShiftTemplatesRequest) (*ListShiftTemplatesResponse, error)
+	DeleteShiftTemplate(context.Context, *DeleteShiftTemplateRequest) (*DeleteShiftTemplateResponse, error)
+	GenerateShifts(context.Context, *GenerateShiftsRequest) (*GenerateShiftsResponse, error)
+	// Nghỉ phép
+	RequestLeave(context.Context, *RequestLeaveRequest) (*EmployeeLeave, error)
+	ListLeaves(context.Context, *ListLeavesRequest) (*ListLeavesResponse, error)
+	ReviewLeave(context.Context, *ReviewLeaveRequest) (*ReviewLeaveResponse, error)
+	// Tự phân công nhân viên rảnh tại chi nhánh theo chiến lược cấu hình
+	AutoAssignAppointment(context.Context, *AutoAssignAppointmentRequest) (*AutoAssignAppointmentResponse, error)
+	// Kỹ năng của nhân viên: dịch vụ nhân viên thực hiện được, dùng khi phân công theo kỹ năng
+	SetEmployeeSkills(context.Context, *SetEmployeeSkillsRequest) (*SetEmployeeSkillsResponse, error)
+	ListEmployeeSkills(context.Context, *ListEmployeeSkillsRequest) (*ListEmployeeSkillsResponse, error)
+	mustEmbedUnimplementedAppointmentServiceServer()
+}
+
+// UnimplementedAppointmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAppointmentServiceServer struct{}
+
+func (UnimplementedAppointmentServiceServer) CreateAppointment(context.Context, *CreateAppointmentRequest) (*CreateAppointmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppointment not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAppointmentsByCustomer(context.Context, *GetAppointmentsByCustomerRequest) (*GetAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByCustomer not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAppointmentsByEmployee(context.Context, *GetAppointmentsByEmployeeRequest) (*GetAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByEmployee not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAppointmentsByBranch(context.Context, *GetAppointmentsByBranchRequest) (*GetAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByBranch not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAllAppointments(context.Context, *GetAllAppointmentsRequest) (*GetAllAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllAppointments not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetEmployeeAgenda(context.Context, *GetEmployeeAgendaRequest) (*GetEmployeeAgendaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployeeAgenda not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetEmployeeRoute(context.Context, *GetEmployeeRouteRequest) (*GetEmployeeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmployeeRoute not implemented")
+}
+func (UnimplementedAppointmentServiceServer) UpdateAppointmentStatus(context.Context, *UpdateAppointmentStatusRequest) (*UpdateAppointmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointmentStatus not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAppointmentStatusHistory(context.Context, *GetAppointmentStatusHistoryRequest) (*GetAppointmentStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentStatusHistory not implemented")
+}
+func (UnimplementedAppointmentServiceServer) RescheduleAppointment(context.Context, *RescheduleAppointmentRequest) (*RescheduleAppointmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleAppointment not implemented")
+}
+func (UnimplementedAppointmentServiceServer) UpdateEmployeeForAppointment(context.Context, *UpdateEmployeeForAppointmentRequest) (*UpdateEmployeeForAppointmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmployeeForAppointment not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CreateAppointmentSeries(context.Context, *CreateAppointmentSeriesRequest) (*CreateAppointmentSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppointmentSeries not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAppointmentSeries(context.Context, *GetAppointmentSeriesRequest) (*GetAppointmentSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentSeries not implemented")
+}
+func (UnimplementedAppointmentServiceServer) UpdateAppointmentSeries(context.Context, *UpdateAppointmentSeriesRequest) (*UpdateAppointmentSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointmentSeries not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CancelAppointmentSeries(context.Context, *CancelAppointmentSeriesRequest) (*CancelAppointmentSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAppointmentSeries not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAppointmentDetails(context.Context, *GetAppointmentDetailsRequest) (*GetAppointmentDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentDetails not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CreateService(context.Context, *CreateServiceRequest) (*CreateServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateService not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
+}
+func (UnimplementedAppointmentServiceServer) UpdateService(context.Context, *UpdateServiceRequest) (*UpdateServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
+}
+func (UnimplementedAppointmentServiceServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
+}
+func (UnimplementedAppointmentServiceServer) RestoreService(context.Context, *RestoreServiceRequest) (*RestoreServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreService not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListArchivedServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedServices not implemented")
+}
+func (UnimplementedAppointmentServiceServer) AddServiceImage(context.Context, *AddServiceImageRequest) (*ServiceImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddServiceImage not implemented")
+}
+func (UnimplementedAppointmentServiceServer) AddServiceImages(context.Context, *AddServiceImagesRequest) (*ListServiceImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddServiceImages not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListServiceImages(context.Context, *ListServiceImagesRequest) (*ListServiceImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceImages not implemented")
+}
+func (UnimplementedAppointmentServiceServer) DeleteServiceImage(context.Context, *DeleteServiceImageRequest) (*ServiceImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceImage not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*CacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ScheduleServicePriceChange(context.Context, *ScheduleServicePriceChangeRequest) (*ServicePriceChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleServicePriceChange not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CancelServicePriceChange(context.Context, *CancelServicePriceChangeRequest) (*CancelServicePriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelServicePriceChange not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListServicePriceHistory(context.Context, *ListServicePriceHistoryRequest) (*ListServicePriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServicePriceHistory not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetServicePriceAt(context.Context, *GetServicePriceAtRequest) (*GetServicePriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServicePriceAt not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GetAvailableSlots(context.Context, *GetAvailableSlotsRequest) (*GetAvailableSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableSlots not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CreateEmployeeShift(context.Context, *CreateEmployeeShiftRequest) (*EmployeeShift, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployeeShift not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListEmployeeShifts(context.Context, *ListEmployeeShiftsRequest) (*ListEmployeeShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployeeShifts not implemented")
+}
+func (UnimplementedAppointmentServiceServer) DeleteEmployeeShift(context.Context, *DeleteEmployeeShiftRequest) (*DeleteEmployeeShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployeeShift not implemented")
+}
+func (UnimplementedAppointmentServiceServer) CreateShiftTemplate(context.Context, *CreateShiftTemplateRequest) (*ShiftTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShiftTemplate not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListShiftTemplates(context.Context, *ListShiftTemplatesRequest) (*ListShiftTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShiftTemplates not implemented")
+}
+func (UnimplementedAppointmentServiceServer) DeleteShiftTemplate(context.Context, *DeleteShiftTemplateRequest) (*DeleteShiftTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShiftTemplate not implemented")
+}
+func (UnimplementedAppointmentServiceServer) GenerateShifts(context.Context, *GenerateShiftsRequest) (*GenerateShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateShifts not implemented")
+}
+func (UnimplementedAppointmentServiceServer) RequestLeave(context.Context, *RequestLeaveRequest) (*EmployeeLeave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestLeave not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListLeaves(context.Context, *ListLeavesRequest) (*ListLeavesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaves not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ReviewLeave(context.Context, *ReviewLeaveRequest) (*ReviewLeaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewLeave not implemented")
+}
+func (UnimplementedAppointmentServiceServer) AutoAssignAppointment(context.Context, *AutoAssignAppointmentRequest) (*AutoAssignAppointmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoAssignAppointment not implemented")
+}
+func (UnimplementedAppointmentServiceServer) SetEmployeeSkills(context.Context, *SetEmployeeSkillsRequest) (*SetEmployeeSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmployeeSkills not implemented")
+}
+func (UnimplementedAppointmentServiceServer) ListEmployeeSkills(context.Context, *ListEmployeeSkillsRequest) (*ListEmployeeSkillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployeeSkills not implemented")
+}
+func (UnimplementedAppointmentServiceServer) mustEmbedUnimplementedAppointmentServiceServer() {}
+func (UnimplementedAppointmentServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeAppointmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AppointmentServiceServer will
+// result in compilation errors.
+type UnsafeAppointmentServiceServer interface {
+	mustEmbedUnimplementedAppointmentServiceServer()
+}
+
+func RegisterAppointmentServiceServer(s grpc.ServiceRegistrar, srv AppointmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAppointmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AppointmentService_ServiceDesc, srv)
+}
+
+func _AppointmentService_CreateAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CreateAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CreateAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CreateAppointment(ctx, req.(*CreateAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAppointmentsByCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentsByCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAppointmentsByCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAppointmentsByCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAppointmentsByCustomer(ctx, req.(*GetAppointmentsByCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAppointmentsByEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentsByEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAppointmentsByEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAppointmentsByEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAppointmentsByEmployee(ctx, req.(*GetAppointmentsByEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAppointmentsByBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentsByBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAppointmentsByBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAppointmentsByBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAppointmentsByBranch(ctx, req.(*GetAppointmentsByBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAllAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllAppointmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAllAppointments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAllAppointments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAllAppointments(ctx, req.(*GetAllAppointmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetEmployeeAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetEmployeeAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetEmployeeAgenda_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetEmployeeAgenda(ctx, req.(*GetEmployeeAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetEmployeeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmployeeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetEmployeeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetEmployeeRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetEmployeeRoute(ctx, req.(*GetEmployeeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_UpdateAppointmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppointmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).UpdateAppointmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_UpdateAppointmentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).UpdateAppointmentStatus(ctx, req.(*UpdateAppointmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAppointmentStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAppointmentStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAppointmentStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAppointmentStatusHistory(ctx, req.(*GetAppointmentStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_RescheduleAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).RescheduleAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_RescheduleAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).RescheduleAppointment(ctx, req.(*RescheduleAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_UpdateEmployeeForAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeForAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).UpdateEmployeeForAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_UpdateEmployeeForAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).UpdateEmployeeForAppointment(ctx, req.(*UpdateEmployeeForAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_CreateAppointmentSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppointmentSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CreateAppointmentSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CreateAppointmentSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CreateAppointmentSeries(ctx, req.(*CreateAppointmentSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAppointmentSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAppointmentSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAppointmentSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAppointmentSeries(ctx, req.(*GetAppointmentSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_UpdateAppointmentSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppointmentSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).UpdateAppointmentSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_UpdateAppointmentSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).UpdateAppointmentSeries(ctx, req.(*UpdateAppointmentSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_CancelAppointmentSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAppointmentSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CancelAppointmentSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CancelAppointmentSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CancelAppointmentSeries(ctx, req.(*CancelAppointmentSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetAppointmentDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAppointmentDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAppointmentDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAppointmentDetails(ctx, req.(*GetAppointmentDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_CreateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CreateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CreateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CreateService(ctx, req.(*CreateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetServices(ctx, req.(*GetServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).UpdateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_UpdateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_DeleteService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_RestoreService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).RestoreService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_RestoreService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).RestoreService(ctx, req.(*RestoreServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ListArchivedServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListArchivedServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListArchivedServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListArchivedServices(ctx, req.(*GetServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_AddServiceImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServiceImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).AddServiceImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_AddServiceImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).AddServiceImage(ctx, req.(*AddServiceImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_AddServiceImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServiceImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).AddServiceImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_AddServiceImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).AddServiceImages(ctx, req.(*AddServiceImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ListServiceImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListServiceImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListServiceImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListServiceImages(ctx, req.(*ListServiceImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_DeleteServiceImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).DeleteServiceImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_DeleteServiceImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).DeleteServiceImage(ctx, req.(*DeleteServiceImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_ScheduleServicePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleServicePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ScheduleServicePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ScheduleServicePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ScheduleServicePriceChange(ctx, req.(*ScheduleServicePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppointmentService_CancelServicePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelServicePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CancelServicePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CancelServicePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CancelServicePriceChange(ctx, req.(*CancelServicePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *appointmentServiceClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteServiceResponse)
-	err := c.cc.Invoke(ctx, AppointmentService_DeleteService_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _AppointmentService_ListServicePriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicePriceHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// AppointmentServiceServer is the server API for AppointmentService service.
-// All implementations must embed UnimplementedAppointmentServiceServer
-// for forward compatibility.
-type AppointmentServiceServer interface {
-	// Đặt lịch hẹn tại nhà khách hàng
-	CreateAppointment(context.Context, *CreateAppointmentRequest) (*CreateAppointmentResponse, error)
-	// Lấy danh sách lịch hẹn theo khách hàng hoặc nhân viên
-	GetAppointmentsByCustomer(context.Context, *GetAppointmentsByCustomerRequest) (*GetAppointmentsResponse, error)
-	GetAppointmentsByEmployee(context.Context, *GetAppointmentsByEmployeeRequest) (*GetAppointmentsResponse, error)
-	GetAppointmentsByBranch(context.Context, *GetAppointmentsByBranchRequest) (*GetAppointmentsResponse, error)
-	GetAllAppointments(context.Context, *GetAllAppointmentsRequest) (*GetAllAppointmentsResponse, error)
-	// Cập nhật trạng thái lịch hẹn
-	UpdateAppointmentStatus(context.Context, *UpdateAppointmentStatusRequest) (*UpdateAppointmentStatusResponse, error)
-	UpdateEmployeeForAppointment(context.Context, *UpdateEmployeeForAppointmentRequest) (*UpdateEmployeeForAppointmentResponse, error)
-	// Lấy chi tiết lịch hẹn
-	GetAppointmentDetails(context.Context, *GetAppointmentDetailsRequest) (*GetAppointmentDetailsResponse, error)
-	// Quản lý danh sách dịch vụ
-	CreateService(context.Context, *CreateServiceRequest) (*CreateServiceResponse, error)
-	GetServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error)
-	UpdateService(context.Context, *UpdateServiceRequest) (*UpdateServiceResponse, error)
-	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error)
-	mustEmbedUnimplementedAppointmentServiceServer()
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).ListServicePriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_ListServicePriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).ListServicePriceHistory(ctx, req.(*ListServicePriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAppointmentServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAppointmentServiceServer struct{}
-
-func (UnimplementedAppointmentServiceServer) CreateAppointment(context.Context, *CreateAppointmentRequest) (*CreateAppointmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAppointment not implemented")
-}
-func (UnimplementedAppointmentServiceServer) GetAppointmentsByCustomer(context.Context, *GetAppointmentsByCustomerRequest) (*GetAppointmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByCustomer not implemented")
-}
-func (UnimplementedAppointmentServiceServer) GetAppointmentsByEmployee(context.Context, *GetAppointmentsByEmployeeRequest) (*GetAppointmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByEmployee not implemented")
-}
-func (UnimplementedAppointmentServiceServer) GetAppointmentsByBranch(context.Context, *GetAppointmentsByBranchRequest) (*GetAppointmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByBranch not implemented")
-}
-func (UnimplementedAppointmentServiceServer) GetAllAppointments(context.Context, *GetAllAppointmentsRequest) (*GetAllAppointmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllAppointments not implemented")
-}
-func (UnimplementedAppointmentServiceServer) UpdateAppointmentStatus(context.Context, *UpdateAppointmentStatusRequest) (*UpdateAppointmentStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointmentStatus not implemented")
-}
-func (UnimplementedAppointmentServiceServer) UpdateEmployeeForAppointment(context.Context, *UpdateEmployeeForAppointmentRequest) (*UpdateEmployeeForAppointmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmployeeForAppointment not implemented")
-}
-func (UnimplementedAppointmentServiceServer) GetAppointmentDetails(context.Context, *GetAppointmentDetailsRequest) (*GetAppointmentDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentDetails not implemented")
-}
-func (UnimplementedAppointmentServiceServer) CreateService(context.Context, *CreateServiceRequest) (*CreateServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateService not implemented")
-}
-func (UnimplementedAppointmentServiceServer) GetServices(context.Context, *GetServicesRequest) (*GetServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
-}
-func (UnimplementedAppointmentServiceServer) UpdateService(context.Context, *UpdateServiceRequest) (*UpdateServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
-}
-func (UnimplementedAppointmentServiceServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
+func _AppointmentService_GetServicePriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServicePriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetServicePriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetServicePriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetServicePriceAt(ctx, req.(*GetServicePriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAppointmentServiceServer) mustEmbedUnimplementedAppointmentServiceServer() {}
-func (UnimplementedAppointmentServiceServer) testEmbeddedByValue()                            {}
 
-// UnsafeAppointmentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AppointmentServiceServer will
-// result in compilation errors.
-type UnsafeAppointmentServiceServer interface {
-	mustEmbedUnimplementedAppointmentServiceServer()
+func _AppointmentService_GetAvailableSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).GetAvailableSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_GetAvailableSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).GetAvailableSlots(ctx, req.(*GetAvailableSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAppointmentServiceServer(s grpc.ServiceRegistrar, srv AppointmentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedAppointmentServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AppointmentService_CreateEmployeeShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployeeShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&AppointmentService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AppointmentServiceServer).CreateEmployeeShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AppointmentService_CreateEmployeeShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppointmentServiceServer).CreateEmployeeShift(ctx, req.(*CreateEmployeeShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_CreateAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppointmentRequest)
+func _AppointmentService_ListEmployeeShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeeShiftsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).CreateAppointment(ctx, in)
+		return srv.(AppointmentServiceServer).ListEmployeeShifts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_CreateAppointment_FullMethodName,
+		FullMethod: AppointmentService_ListEmployeeShifts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).CreateAppointment(ctx, req.(*CreateAppointmentRequest))
+		return srv.(AppointmentServiceServer).ListEmployeeShifts(ctx, req.(*ListEmployeeShiftsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_GetAppointmentsByCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppointmentsByCustomerRequest)
+func _AppointmentService_DeleteEmployeeShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeShiftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).GetAppointmentsByCustomer(ctx, in)
+		return srv.(AppointmentServiceServer).DeleteEmployeeShift(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_GetAppointmentsByCustomer_FullMethodName,
+		FullMethod: AppointmentService_DeleteEmployeeShift_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).GetAppointmentsByCustomer(ctx, req.(*GetAppointmentsByCustomerRequest))
+		return srv.(AppointmentServiceServer).DeleteEmployeeShift(ctx, req.(*DeleteEmployeeShiftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_GetAppointmentsByEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppointmentsByEmployeeRequest)
+func _AppointmentService_CreateShiftTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShiftTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).GetAppointmentsByEmployee(ctx, in)
+		return srv.(AppointmentServiceServer).CreateShiftTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_GetAppointmentsByEmployee_FullMethodName,
+		FullMethod: AppointmentService_CreateShiftTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).GetAppointmentsByEmployee(ctx, req.(*GetAppointmentsByEmployeeRequest))
+		return srv.(AppointmentServiceServer).CreateShiftTemplate(ctx, req.(*CreateShiftTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_GetAppointmentsByBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppointmentsByBranchRequest)
+func _AppointmentService_ListShiftTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).GetAppointmentsByBranch(ctx, in)
+		return srv.(AppointmentServiceServer).ListShiftTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_GetAppointmentsByBranch_FullMethodName,
+		FullMethod: AppointmentService_ListShiftTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).GetAppointmentsByBranch(ctx, req.(*GetAppointmentsByBranchRequest))
+		return srv.(AppointmentServiceServer).ListShiftTemplates(ctx, req.(*ListShiftTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_GetAllAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllAppointmentsRequest)
+func _AppointmentService_DeleteShiftTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShiftTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).GetAllAppointments(ctx, in)
+		return srv.(AppointmentServiceServer).DeleteShiftTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_GetAllAppointments_FullMethodName,
+		FullMethod: AppointmentService_DeleteShiftTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).GetAllAppointments(ctx, req.(*GetAllAppointmentsRequest))
+		return srv.(AppointmentServiceServer).DeleteShiftTemplate(ctx, req.(*DeleteShiftTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_UpdateAppointmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppointmentStatusRequest)
+func _AppointmentService_GenerateShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateShiftsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).UpdateAppointmentStatus(ctx, in)
+		return srv.(AppointmentServiceServer).GenerateShifts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_UpdateAppointmentStatus_FullMethodName,
+		FullMethod: AppointmentService_GenerateShifts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).UpdateAppointmentStatus(ctx, req.(*UpdateAppointmentStatusRequest))
+		return srv.(AppointmentServiceServer).GenerateShifts(ctx, req.(*GenerateShiftsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_UpdateEmployeeForAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateEmployeeForAppointmentRequest)
+func _AppointmentService_RequestLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLeaveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).UpdateEmployeeForAppointment(ctx, in)
+		return srv.(AppointmentServiceServer).RequestLeave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_UpdateEmployeeForAppointment_FullMethodName,
+		FullMethod: AppointmentService_RequestLeave_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).UpdateEmployeeForAppointment(ctx, req.(*UpdateEmployeeForAppointmentRequest))
+		return srv.(AppointmentServiceServer).RequestLeave(ctx, req.(*RequestLeaveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_GetAppointmentDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppointmentDetailsRequest)
+func _AppointmentService_ListLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeavesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).GetAppointmentDetails(ctx, in)
+		return srv.(AppointmentServiceServer).ListLeaves(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_GetAppointmentDetails_FullMethodName,
+		FullMethod: AppointmentService_ListLeaves_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).GetAppointmentDetails(ctx, req.(*GetAppointmentDetailsRequest))
+		return srv.(AppointmentServiceServer).ListLeaves(ctx, req.(*ListLeavesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_CreateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateServiceRequest)
+func _AppointmentService_ReviewLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewLeaveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).CreateService(ctx, in)
+		return srv.(AppointmentServiceServer).ReviewLeave(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_CreateService_FullMethodName,
+		FullMethod: AppointmentService_ReviewLeave_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).CreateService(ctx, req.(*CreateServiceRequest))
+		return srv.(AppointmentServiceServer).ReviewLeave(ctx, req.(*ReviewLeaveRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetServicesRequest)
+func _AppointmentService_AutoAssignAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoAssignAppointmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).GetServices(ctx, in)
+		return srv.(AppointmentServiceServer).AutoAssignAppointment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_GetServices_FullMethodName,
+		FullMethod: AppointmentService_AutoAssignAppointment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).GetServices(ctx, req.(*GetServicesRequest))
+		return srv.(AppointmentServiceServer).AutoAssignAppointment(ctx, req.(*AutoAssignAppointmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateServiceRequest)
+func _AppointmentService_SetEmployeeSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEmployeeSkillsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).UpdateService(ctx, in)
+		return srv.(AppointmentServiceServer).SetEmployeeSkills(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_UpdateService_FullMethodName,
+		FullMethod: AppointmentService_SetEmployeeSkills_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+		return srv.(AppointmentServiceServer).SetEmployeeSkills(ctx, req.(*SetEmployeeSkillsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AppointmentService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteServiceRequest)
+func _AppointmentService_ListEmployeeSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeeSkillsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AppointmentServiceServer).DeleteService(ctx, in)
+		return srv.(AppointmentServiceServer).ListEmployeeSkills(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AppointmentService_DeleteService_FullMethodName,
+		FullMethod: AppointmentService_ListEmployeeSkills_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppointmentServiceServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+		return srv.(AppointmentServiceServer).ListEmployeeSkills(ctx, req.(*ListEmployeeSkillsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -515,14 +1667,46 @@ var AppointmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllAppointments",
 			Handler:    _AppointmentService_GetAllAppointments_Handler,
 		},
+		{
+			MethodName: "GetEmployeeAgenda",
+			Handler:    _AppointmentService_GetEmployeeAgenda_Handler,
+		},
+		{
+			MethodName: "GetEmployeeRoute",
+			Handler:    _AppointmentService_GetEmployeeRoute_Handler,
+		},
 		{
 			MethodName: "UpdateAppointmentStatus",
 			Handler:    _AppointmentService_UpdateAppointmentStatus_Handler,
 		},
+		{
+			MethodName: "GetAppointmentStatusHistory",
+			Handler:    _AppointmentService_GetAppointmentStatusHistory_Handler,
+		},
+		{
+			MethodName: "RescheduleAppointment",
+			Handler:    _AppointmentService_RescheduleAppointment_Handler,
+		},
 		{
 			MethodName: "UpdateEmployeeForAppointment",
 			Handler:    _AppointmentService_UpdateEmployeeForAppointment_Handler,
 		},
+		{
+			MethodName: "CreateAppointmentSeries",
+			Handler:    _AppointmentService_CreateAppointmentSeries_Handler,
+		},
+		{
+			MethodName: "GetAppointmentSeries",
+			Handler:    _AppointmentService_GetAppointmentSeries_Handler,
+		},
+		{
+			MethodName: "UpdateAppointmentSeries",
+			Handler:    _AppointmentService_UpdateAppointmentSeries_Handler,
+		},
+		{
+			MethodName: "CancelAppointmentSeries",
+			Handler:    _AppointmentService_CancelAppointmentSeries_Handler,
+		},
 		{
 			MethodName: "GetAppointmentDetails",
 			Handler:    _AppointmentService_GetAppointmentDetails_Handler,
@@ -543,6 +1727,106 @@ var AppointmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteService",
 			Handler:    _AppointmentService_DeleteService_Handler,
 		},
+		{
+			MethodName: "RestoreService",
+			Handler:    _AppointmentService_RestoreService_Handler,
+		},
+		{
+			MethodName: "ListArchivedServices",
+			Handler:    _AppointmentService_ListArchivedServices_Handler,
+		},
+		{
+			MethodName: "AddServiceImage",
+			Handler:    _AppointmentService_AddServiceImage_Handler,
+		},
+		{
+			MethodName: "AddServiceImages",
+			Handler:    _AppointmentService_AddServiceImages_Handler,
+		},
+		{
+			MethodName: "ListServiceImages",
+			Handler:    _AppointmentService_ListServiceImages_Handler,
+		},
+		{
+			MethodName: "DeleteServiceImage",
+			Handler:    _AppointmentService_DeleteServiceImage_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _AppointmentService_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "ScheduleServicePriceChange",
+			Handler:    _AppointmentService_ScheduleServicePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelServicePriceChange",
+			Handler:    _AppointmentService_CancelServicePriceChange_Handler,
+		},
+		{
+			MethodName: "ListServicePriceHistory",
+			Handler:    _AppointmentService_ListServicePriceHistory_Handler,
+		},
+		{
+			MethodName: "GetServicePriceAt",
+			Handler:    _AppointmentService_GetServicePriceAt_Handler,
+		},
+		{
+			MethodName: "GetAvailableSlots",
+			Handler:    _AppointmentService_GetAvailableSlots_Handler,
+		},
+		{
+			MethodName: "CreateEmployeeShift",
+			Handler:    _AppointmentService_CreateEmployeeShift_Handler,
+		},
+		{
+			MethodName: "ListEmployeeShifts",
+			Handler:    _AppointmentService_ListEmployeeShifts_Handler,
+		},
+		{
+			MethodName: "DeleteEmployeeShift",
+			Handler:    _AppointmentService_DeleteEmployeeShift_Handler,
+		},
+		{
+			MethodName: "CreateShiftTemplate",
+			Handler:    _AppointmentService_CreateShiftTemplate_Handler,
+		},
+		{
+			MethodName: "ListShiftTemplates",
+			Handler:    _AppointmentService_ListShiftTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteShiftTemplate",
+			Handler:    _AppointmentService_DeleteShiftTemplate_Handler,
+		},
+		{
+			MethodName: "GenerateShifts",
+			Handler:    _AppointmentService_GenerateShifts_Handler,
+		},
+		{
+			MethodName: "RequestLeave",
+			Handler:    _AppointmentService_RequestLeave_Handler,
+		},
+		{
+			MethodName: "ListLeaves",
+			Handler:    _AppointmentService_ListLeaves_Handler,
+		},
+		{
+			MethodName: "ReviewLeave",
+			Handler:    _AppointmentService_ReviewLeave_Handler,
+		},
+		{
+			MethodName: "AutoAssignAppointment",
+			Handler:    _AppointmentService_AutoAssignAppointment_Handler,
+		},
+		{
+			MethodName: "SetEmployeeSkills",
+			Handler:    _AppointmentService_SetEmployeeSkills_Handler,
+		},
+		{
+			MethodName: "ListEmployeeSkills",
+			Handler:    _AppointmentService_ListEmployeeSkills_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "appointments.proto",
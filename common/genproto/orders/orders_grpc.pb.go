@@ -26,6 +26,7 @@ const (
 	OrderService_GetOrderByAppointmentID_FullMethodName = "/orders.OrderService/GetOrderByAppointmentID"
 	OrderService_GetOrdersByCustomerID_FullMethodName   = "/orders.OrderService/GetOrdersByCustomerID"
 	OrderService_GetAllOrders_FullMethodName            = "/orders.OrderService/GetAllOrders"
+	OrderService_FindCompletedPurchase_FullMethodName   = "/orders.OrderService/FindCompletedPurchase"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -39,6 +40,8 @@ type OrderServiceClient interface {
 	GetOrderByAppointmentID(ctx context.Context, in *GetOrderByAppointmentIDRequest, opts ...grpc.CallOption) (*GetOrderByAppointmentIDResponse, error)
 	GetOrdersByCustomerID(ctx context.Context, in *GetOrdersByCustomerIDRequest, opts ...grpc.CallOption) (*GetOrdersByCustomerIDResponse, error)
 	GetAllOrders(ctx context.Context, in *GetAllOrdersRequest, opts ...grpc.CallOption) (*GetAllOrdersResponse, error)
+	// FindCompletedPurchase tìm đơn COMPLETED gần nhất của khách có chứa sản phẩm, order_id = 0 nếu chưa mua
+	FindCompletedPurchase(ctx context.Context, in *FindCompletedPurchaseRequest, opts ...grpc.CallOption) (*FindCompletedPurchaseResponse, error)
 }
 
 type orderServiceClient struct {
@@ -119,6 +122,16 @@ func (c *orderServiceClient) GetAllOrders(ctx context.Context, in *GetAllOrdersR
 	return out, nil
 }
 
+func (c *orderServiceClient) FindCompletedPurchase(ctx context.Context, in *FindCompletedPurchaseRequest, opts ...grpc.CallOption) (*FindCompletedPurchaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindCompletedPurchaseResponse)
+	err := c.cc.Invoke(ctx, OrderService_FindCompletedPurchase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -130,6 +143,8 @@ type OrderServiceServer interface {
 	GetOrderByAppointmentID(context.Context, *GetOrderByAppointmentIDRequest) (*GetOrderByAppointmentIDResponse, error)
 	GetOrdersByCustomerID(context.Context, *GetOrdersByCustomerIDRequest) (*GetOrdersByCustomerIDResponse, error)
 	GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error)
+	// FindCompletedPurchase tìm đơn COMPLETED gần nhất của khách có chứa sản phẩm, order_id = 0 nếu chưa mua
+	FindCompletedPurchase(context.Context, *FindCompletedPurchaseRequest) (*FindCompletedPurchaseResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -161,6 +176,9 @@ func (UnimplementedOrderServiceServer) GetOrdersByCustomerID(context.Context, *G
 func (UnimplementedOrderServiceServer) GetAllOrders(context.Context, *GetAllOrdersRequest) (*GetAllOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) FindCompletedPurchase(context.Context, *FindCompletedPurchaseRequest) (*FindCompletedPurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindCompletedPurchase not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -308,6 +326,24 @@ func _OrderService_GetAllOrders_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_FindCompletedPurchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindCompletedPurchaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).FindCompletedPurchase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_FindCompletedPurchase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).FindCompletedPurchase(ctx, req.(*FindCompletedPurchaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +379,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllOrders",
 			Handler:    _OrderService_GetAllOrders_Handler,
 		},
+		{
+			MethodName: "FindCompletedPurchase",
+			Handler:    _OrderService_FindCompletedPurchase_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders.proto",
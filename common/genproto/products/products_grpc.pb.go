@@ -34,18 +34,68 @@ const (
 	ProductService_DeleteFood_FullMethodName                       = "/ProductService/DeleteFood"
 	ProductService_DeleteAccessory_FullMethodName                  = "/ProductService/DeleteAccessory"
 	ProductService_DeleteMedicine_FullMethodName                   = "/ProductService/DeleteMedicine"
+	ProductService_RestoreProduct_FullMethodName                   = "/ProductService/RestoreProduct"
+	ProductService_ListArchivedProducts_FullMethodName             = "/ProductService/ListArchivedProducts"
 	ProductService_GetBranchByID_FullMethodName                    = "/ProductService/GetBranchByID"
 	ProductService_ListBranches_FullMethodName                     = "/ProductService/ListBranches"
+	ProductService_CreateBranch_FullMethodName                     = "/ProductService/CreateBranch"
+	ProductService_UpdateBranch_FullMethodName                     = "/ProductService/UpdateBranch"
+	ProductService_DeactivateBranch_FullMethodName                 = "/ProductService/DeactivateBranch"
+	ProductService_SetBranchOpeningHours_FullMethodName            = "/ProductService/SetBranchOpeningHours"
+	ProductService_GetBranchSchedule_FullMethodName                = "/ProductService/GetBranchSchedule"
+	ProductService_AddBranchClosure_FullMethodName                 = "/ProductService/AddBranchClosure"
+	ProductService_DeleteBranchClosure_FullMethodName              = "/ProductService/DeleteBranchClosure"
+	ProductService_CheckBranchOpen_FullMethodName                  = "/ProductService/CheckBranchOpen"
 	ProductService_GetBranchInventory_FullMethodName               = "/ProductService/GetBranchInventory"
 	ProductService_UpdateBranchInventory_FullMethodName            = "/ProductService/UpdateBranchInventory"
 	ProductService_ListAttachableProducts_FullMethodName           = "/ProductService/ListAttachableProducts"
 	ProductService_ListAllProducts_FullMethodName                  = "/ProductService/ListAllProducts"
 	ProductService_ListAllProductsWithStock_FullMethodName         = "/ProductService/ListAllProductsWithStock"
+	ProductService_SearchProducts_FullMethodName                   = "/ProductService/SearchProducts"
+	ProductService_GetProductByBarcode_FullMethodName              = "/ProductService/GetProductByBarcode"
+	ProductService_ImportProducts_FullMethodName                   = "/ProductService/ImportProducts"
+	ProductService_ImportInventory_FullMethodName                  = "/ProductService/ImportInventory"
+	ProductService_CreateVariant_FullMethodName                    = "/ProductService/CreateVariant"
+	ProductService_UpdateVariant_FullMethodName                    = "/ProductService/UpdateVariant"
+	ProductService_GetVariant_FullMethodName                       = "/ProductService/GetVariant"
+	ProductService_ListVariants_FullMethodName                     = "/ProductService/ListVariants"
+	ProductService_DeactivateVariant_FullMethodName                = "/ProductService/DeactivateVariant"
+	ProductService_AddProductImage_FullMethodName                  = "/ProductService/AddProductImage"
+	ProductService_AddProductImages_FullMethodName                 = "/ProductService/AddProductImages"
+	ProductService_ListProductImages_FullMethodName                = "/ProductService/ListProductImages"
+	ProductService_DeleteProductImage_FullMethodName               = "/ProductService/DeleteProductImage"
 	ProductService_ListAvailableProductsByBranch_FullMethodName    = "/ProductService/ListAvailableProductsByBranch"
 	ProductService_ListAvailableAllProductsByBranch_FullMethodName = "/ProductService/ListAvailableAllProductsByBranch"
 	ProductService_ReserveProduct_FullMethodName                   = "/ProductService/ReserveProduct"
+	ProductService_ReserveProducts_FullMethodName                  = "/ProductService/ReserveProducts"
 	ProductService_ConfirmPickup_FullMethodName                    = "/ProductService/ConfirmPickup"
 	ProductService_ReleaseReservation_FullMethodName               = "/ProductService/ReleaseReservation"
+	ProductService_AssignReservations_FullMethodName               = "/ProductService/AssignReservations"
+	ProductService_GetReservation_FullMethodName                   = "/ProductService/GetReservation"
+	ProductService_CreateSupplier_FullMethodName                   = "/ProductService/CreateSupplier"
+	ProductService_UpdateSupplier_FullMethodName                   = "/ProductService/UpdateSupplier"
+	ProductService_ListSuppliers_FullMethodName                    = "/ProductService/ListSuppliers"
+	ProductService_CreatePurchaseOrder_FullMethodName              = "/ProductService/CreatePurchaseOrder"
+	ProductService_GetPurchaseOrder_FullMethodName                 = "/ProductService/GetPurchaseOrder"
+	ProductService_ListPurchaseOrders_FullMethodName               = "/ProductService/ListPurchaseOrders"
+	ProductService_CancelPurchaseOrder_FullMethodName              = "/ProductService/CancelPurchaseOrder"
+	ProductService_ReceivePurchaseOrder_FullMethodName             = "/ProductService/ReceivePurchaseOrder"
+	ProductService_CreatePromotion_FullMethodName                  = "/ProductService/CreatePromotion"
+	ProductService_UpdatePromotion_FullMethodName                  = "/ProductService/UpdatePromotion"
+	ProductService_DeactivatePromotion_FullMethodName              = "/ProductService/DeactivatePromotion"
+	ProductService_ListPromotions_FullMethodName                   = "/ProductService/ListPromotions"
+	ProductService_PriceCart_FullMethodName                        = "/ProductService/PriceCart"
+	ProductService_RedeemPromotions_FullMethodName                 = "/ProductService/RedeemPromotions"
+	ProductService_ReleasePromotionRedemptions_FullMethodName      = "/ProductService/ReleasePromotionRedemptions"
+	ProductService_CreateReview_FullMethodName                     = "/ProductService/CreateReview"
+	ProductService_ListProductReviews_FullMethodName               = "/ProductService/ListProductReviews"
+	ProductService_ListReviews_FullMethodName                      = "/ProductService/ListReviews"
+	ProductService_ModerateReview_FullMethodName                   = "/ProductService/ModerateReview"
+	ProductService_GetCacheStats_FullMethodName                    = "/ProductService/GetCacheStats"
+	ProductService_SchedulePriceChange_FullMethodName              = "/ProductService/SchedulePriceChange"
+	ProductService_CancelPriceChange_FullMethodName                = "/ProductService/CancelPriceChange"
+	ProductService_ListPriceHistory_FullMethodName                 = "/ProductService/ListPriceHistory"
+	ProductService_GetPriceAt_FullMethodName                       = "/ProductService/GetPriceAt"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -67,18 +117,88 @@ type ProductServiceClient interface {
 	DeleteFood(ctx context.Context, in *DeleteFoodRequest, opts ...grpc.CallOption) (*DeleteFoodResponse, error)
 	DeleteAccessory(ctx context.Context, in *DeleteAccessoryRequest, opts ...grpc.CallOption) (*DeleteAccessoryResponse, error)
 	DeleteMedicine(ctx context.Context, in *DeleteMedicineRequest, opts ...grpc.CallOption) (*DeleteMedicineResponse, error)
+	// Xoá sản phẩm chỉ lưu trữ (ẩn khỏi danh sách bán), RestoreProduct đưa sản phẩm trở lại
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error)
+	ListArchivedProducts(ctx context.Context, in *ListArchivedProductsRequest, opts ...grpc.CallOption) (*ListAllProductsResponse, error)
 	GetBranchByID(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*Branch, error)
 	ListBranches(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*ListBranchResponse, error)
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*CreateBranchResponse, error)
+	UpdateBranch(ctx context.Context, in *UpdateBranchRequest, opts ...grpc.CallOption) (*UpdateBranchResponse, error)
+	DeactivateBranch(ctx context.Context, in *DeactivateBranchRequest, opts ...grpc.CallOption) (*DeactivateBranchResponse, error)
+	// Giờ mở cửa & ngày nghỉ của chi nhánh
+	SetBranchOpeningHours(ctx context.Context, in *SetBranchOpeningHoursRequest, opts ...grpc.CallOption) (*SetBranchOpeningHoursResponse, error)
+	GetBranchSchedule(ctx context.Context, in *GetBranchScheduleRequest, opts ...grpc.CallOption) (*BranchSchedule, error)
+	AddBranchClosure(ctx context.Context, in *AddBranchClosureRequest, opts ...grpc.CallOption) (*BranchClosure, error)
+	DeleteBranchClosure(ctx context.Context, in *DeleteBranchClosureRequest, opts ...grpc.CallOption) (*DeleteBranchClosureResponse, error)
+	CheckBranchOpen(ctx context.Context, in *CheckBranchOpenRequest, opts ...grpc.CallOption) (*CheckBranchOpenResponse, error)
 	GetBranchInventory(ctx context.Context, in *GetBranchInventoryRequest, opts ...grpc.CallOption) (*GetBranchInventoryResponse, error)
 	UpdateBranchInventory(ctx context.Context, in *UpdateBranchInventoryRequest, opts ...grpc.CallOption) (*UpdateBranchInventoryResponse, error)
 	ListAttachableProducts(ctx context.Context, in *ListAttachableProductsRequest, opts ...grpc.CallOption) (*ListAttachableProductsResponse, error)
 	ListAllProducts(ctx context.Context, in *ListAllProductsRequest, opts ...grpc.CallOption) (*ListAllProductsResponse, error)
 	ListAllProductsWithStock(ctx context.Context, in *ListAllProductsRequest, opts ...grpc.CallOption) (*ListAllProductsWithStockResponse, error)
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	// GetProductByBarcode tìm sản phẩm / biến thể theo mã vạch hoặc SKU kèm số lượng có thể bán tại chi nhánh (bán tại quầy)
+	GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*ProductLookup, error)
+	// Nhập hàng loạt sản phẩm / tồn kho (từ file CSV do gateway đọc)
+	ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	ImportInventory(ctx context.Context, in *ImportInventoryRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	// Biến thể sản phẩm (size, trọng lượng, vị) với SKU, giá và tồn kho riêng
+	CreateVariant(ctx context.Context, in *CreateVariantRequest, opts ...grpc.CallOption) (*CreateVariantResponse, error)
+	UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantResponse, error)
+	GetVariant(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*ProductVariant, error)
+	ListVariants(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error)
+	DeactivateVariant(ctx context.Context, in *DeactivateVariantRequest, opts ...grpc.CallOption) (*DeactivateVariantResponse, error)
+	// Ảnh sản phẩm
+	AddProductImage(ctx context.Context, in *AddProductImageRequest, opts ...grpc.CallOption) (*ProductImage, error)
+	AddProductImages(ctx context.Context, in *AddProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error)
+	ListProductImages(ctx context.Context, in *ListProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error)
+	DeleteProductImage(ctx context.Context, in *DeleteProductImageRequest, opts ...grpc.CallOption) (*ProductImage, error)
 	ListAvailableProductsByBranch(ctx context.Context, in *ListAvailableProductsByBranchRequest, opts ...grpc.CallOption) (*ListAvailableProductsByBranchResponse, error)
 	ListAvailableAllProductsByBranch(ctx context.Context, in *ListAvailableAllProductsByBranchRequest, opts ...grpc.CallOption) (*ListAvailableAllProductsByBranchResponse, error)
 	ReserveProduct(ctx context.Context, in *ReserveProductRequest, opts ...grpc.CallOption) (*ReserveProductResponse, error)
+	// ReserveProducts giữ toàn bộ giỏ hàng trong một transaction: hoặc giữ được tất cả, hoặc không giữ gì
+	ReserveProducts(ctx context.Context, in *ReserveProductsRequest, opts ...grpc.CallOption) (*ReserveProductsResponse, error)
 	ConfirmPickup(ctx context.Context, in *ConfirmPickupRequest, opts ...grpc.CallOption) (*ConfirmPickupResponse, error)
 	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error)
+	// AssignReservations gắn các lần giữ hàng vào đơn hàng và đặt lại hạn giữ
+	AssignReservations(ctx context.Context, in *AssignReservationsRequest, opts ...grpc.CallOption) (*AssignReservationsResponse, error)
+	GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*Reservation, error)
+	// Nhà cung cấp & đơn nhập hàng
+	CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*CreateSupplierResponse, error)
+	UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*UpdateSupplierResponse, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
+	CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*CreatePurchaseOrderResponse, error)
+	GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error)
+	CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*CancelPurchaseOrderResponse, error)
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error)
+	// Khuyến mãi & mã giảm giá
+	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error)
+	UpdatePromotion(ctx context.Context, in *UpdatePromotionRequest, opts ...grpc.CallOption) (*UpdatePromotionResponse, error)
+	DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*DeactivatePromotionResponse, error)
+	ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error)
+	// PriceCart tính giá giỏ hàng (sản phẩm và/hoặc dịch vụ) sau khuyến mãi, không ghi nhận lượt dùng
+	PriceCart(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*CartPricing, error)
+	// RedeemPromotions tính giá như PriceCart và ghi nhận lượt dùng các khuyến mãi được áp dụng
+	RedeemPromotions(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*CartPricing, error)
+	// ReleasePromotionRedemptions huỷ các lượt dùng khi đơn hàng / lịch hẹn không tạo được
+	ReleasePromotionRedemptions(ctx context.Context, in *ReleasePromotionRedemptionsRequest, opts ...grpc.CallOption) (*ReleasePromotionRedemptionsResponse, error)
+	// Đánh giá sản phẩm
+	// CreateReview chỉ nhận đánh giá của khách đã có đơn COMPLETED chứa sản phẩm, đánh giá chờ nhân viên duyệt
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// ListProductReviews trả về các đánh giá đã duyệt của một sản phẩm
+	ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// ListReviews dành cho nhân viên kiểm duyệt, lọc theo trạng thái và sản phẩm
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// Tỉ lệ trúng cache của danh sách sản phẩm
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*CacheStats, error)
+	// Lịch sử giá và giá hẹn trước (variant_id = 0 là sản phẩm gốc)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*PriceChange, error)
+	CancelPriceChange(ctx context.Context, in *CancelPriceChangeRequest, opts ...grpc.CallOption) (*CancelPriceChangeResponse, error)
+	ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error)
+	// GetPriceAt trả về giá bán tại một thời điểm, Orders dùng để định giá đơn hàng
+	GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*GetPriceAtResponse, error)
 }
 
 type productServiceClient struct {
@@ -239,6 +359,26 @@ func (c *productServiceClient) DeleteMedicine(ctx context.Context, in *DeleteMed
 	return out, nil
 }
 
+func (c *productServiceClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListArchivedProducts(ctx context.Context, in *ListArchivedProductsRequest, opts ...grpc.CallOption) (*ListAllProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListArchivedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetBranchByID(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*Branch, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Branch)
@@ -259,6 +399,86 @@ func (c *productServiceClient) ListBranches(ctx context.Context, in *ListBranchR
 	return out, nil
 }
 
+func (c *productServiceClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*CreateBranchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBranchResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateBranch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateBranch(ctx context.Context, in *UpdateBranchRequest, opts ...grpc.CallOption) (*UpdateBranchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBranchResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateBranch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeactivateBranch(ctx context.Context, in *DeactivateBranchRequest, opts ...grpc.CallOption) (*DeactivateBranchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateBranchResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeactivateBranch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetBranchOpeningHours(ctx context.Context, in *SetBranchOpeningHoursRequest, opts ...grpc.CallOption) (*SetBranchOpeningHoursResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBranchOpeningHoursResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetBranchOpeningHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetBranchSchedule(ctx context.Context, in *GetBranchScheduleRequest, opts ...grpc.CallOption) (*BranchSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BranchSchedule)
+	err := c.cc.Invoke(ctx, ProductService_GetBranchSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddBranchClosure(ctx context.Context, in *AddBranchClosureRequest, opts ...grpc.CallOption) (*BranchClosure, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BranchClosure)
+	err := c.cc.Invoke(ctx, ProductService_AddBranchClosure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteBranchClosure(ctx context.Context, in *DeleteBranchClosureRequest, opts ...grpc.CallOption) (*DeleteBranchClosureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBranchClosureResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteBranchClosure_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CheckBranchOpen(ctx context.Context, in *CheckBranchOpenRequest, opts ...grpc.CallOption) (*CheckBranchOpenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBranchOpenResponse)
+	err := c.cc.Invoke(ctx, ProductService_CheckBranchOpen_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) GetBranchInventory(ctx context.Context, in *GetBranchInventoryRequest, opts ...grpc.CallOption) (*GetBranchInventoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBranchInventoryResponse)
@@ -309,6 +529,136 @@ func (c *productServiceClient) ListAllProductsWithStock(ctx context.Context, in
 	return out, nil
 }
 
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductByBarcode(ctx context.Context, in *GetProductByBarcodeRequest, opts ...grpc.CallOption) (*ProductLookup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductLookup)
+	err := c.cc.Invoke(ctx, ProductService_GetProductByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, ProductService_ImportProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportInventory(ctx context.Context, in *ImportInventoryRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, ProductService_ImportInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateVariant(ctx context.Context, in *CreateVariantRequest, opts ...grpc.CallOption) (*CreateVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*UpdateVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetVariant(ctx context.Context, in *GetVariantRequest, opts ...grpc.CallOption) (*ProductVariant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductVariant)
+	err := c.cc.Invoke(ctx, ProductService_GetVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListVariants(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVariantsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListVariants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeactivateVariant(ctx context.Context, in *DeactivateVariantRequest, opts ...grpc.CallOption) (*DeactivateVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeactivateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddProductImage(ctx context.Context, in *AddProductImageRequest, opts ...grpc.CallOption) (*ProductImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImage)
+	err := c.cc.Invoke(ctx, ProductService_AddProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddProductImages(ctx context.Context, in *AddProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_AddProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductImages(ctx context.Context, in *ListProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductImage(ctx context.Context, in *DeleteProductImageRequest, opts ...grpc.CallOption) (*ProductImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImage)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ListAvailableProductsByBranch(ctx context.Context, in *ListAvailableProductsByBranchRequest, opts ...grpc.CallOption) (*ListAvailableProductsByBranchResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAvailableProductsByBranchResponse)
@@ -339,6 +689,16 @@ func (c *productServiceClient) ReserveProduct(ctx context.Context, in *ReservePr
 	return out, nil
 }
 
+func (c *productServiceClient) ReserveProducts(ctx context.Context, in *ReserveProductsRequest, opts ...grpc.CallOption) (*ReserveProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReserveProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ConfirmPickup(ctx context.Context, in *ConfirmPickupRequest, opts ...grpc.CallOption) (*ConfirmPickupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ConfirmPickupResponse)
@@ -359,66 +719,396 @@ func (c *productServiceClient) ReleaseReservation(ctx context.Context, in *Relea
 	return out, nil
 }
 
-// ProductServiceServer is the server API for ProductService service.
-// All implementations must embed UnimplementedProductServiceServer
-// for forward compatibility.
-type ProductServiceServer interface {
-	GetFoodByID(context.Context, *GetFoodRequest) (*Food, error)
-	GetAccessoryByID(context.Context, *GetAccessoryRequest) (*Accessory, error)
-	GetMedicineByID(context.Context, *GetMedicineRequest) (*Medicine, error)
-	ListFoods(context.Context, *ListFoodRequest) (*ListFoodResponse, error)
-	ListAccessories(context.Context, *ListAccessoryRequest) (*ListAccessoryResponse, error)
-	ListMedicines(context.Context, *ListMedicineRequest) (*ListMedicineResponse, error)
-	CreateFood(context.Context, *CreateFoodRequest) (*CreateFoodResponse, error)
-	CreateAccessory(context.Context, *CreateAccessoryRequest) (*CreateAccessoryResponse, error)
-	CreateMedicine(context.Context, *CreateMedicineRequest) (*CreateMedicineResponse, error)
-	UpdateFood(context.Context, *UpdateFoodRequest) (*UpdateFoodResponse, error)
-	UpdateAccessory(context.Context, *UpdateAccessoryRequest) (*UpdateAccessoryResponse, error)
-	UpdateMedicine(context.Context, *UpdateMedicineRequest) (*UpdateMedicineResponse, error)
-	DeleteFood(context.Context, *DeleteFoodRequest) (*DeleteFoodResponse, error)
-	DeleteAccessory(context.Context, *DeleteAccessoryRequest) (*DeleteAccessoryResponse, error)
-	DeleteMedicine(context.Context, *DeleteMedicineRequest) (*DeleteMedicineResponse, error)
-	GetBranchByID(context.Context, *GetBranchRequest) (*Branch, error)
-	ListBranches(context.Context, *ListBranchRequest) (*ListBranchResponse, error)
-	GetBranchInventory(context.Context, *GetBranchInventoryRequest) (*GetBranchInventoryResponse, error)
-	UpdateBranchInventory(context.Context, *UpdateBranchInventoryRequest) (*UpdateBranchInventoryResponse, error)
-	ListAttachableProducts(context.Context, *ListAttachableProductsRequest) (*ListAttachableProductsResponse, error)
-	ListAllProducts(context.Context, *ListAllProductsRequest) (*ListAllProductsResponse, error)
-	ListAllProductsWithStock(context.Context, *ListAllProductsRequest) (*ListAllProductsWithStockResponse, error)
-	ListAvailableProductsByBranch(context.Context, *ListAvailableProductsByBranchRequest) (*ListAvailableProductsByBranchResponse, error)
-	ListAvailableAllProductsByBranch(context.Context, *ListAvailableAllProductsByBranchRequest) (*ListAvailableAllProductsByBranchResponse, error)
-	ReserveProduct(context.Context, *ReserveProductRequest) (*ReserveProductResponse, error)
-	ConfirmPickup(context.Context, *ConfirmPickupRequest) (*ConfirmPickupResponse, error)
-	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
-	mustEmbedUnimplementedProductServiceServer()
+func (c *productServiceClient) AssignReservations(ctx context.Context, in *AssignReservationsRequest, opts ...grpc.CallOption) (*AssignReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignReservationsResponse)
+	err := c.cc.Invoke(ctx, ProductService_AssignReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedProductServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProductServiceServer struct{}
-
-func (UnimplementedProductServiceServer) GetFoodByID(context.Context, *GetFoodRequest) (*Food, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFoodByID not implemented")
-}
-func (UnimplementedProductServiceServer) GetAccessoryByID(context.Context, *GetAccessoryRequest) (*Accessory, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccessoryByID not implemented")
+func (c *productServiceClient) GetReservation(ctx context.Context, in *GetReservationRequest, opts ...grpc.CallOption) (*Reservation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reservation)
+	err := c.cc.Invoke(ctx, ProductService_GetReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetMedicineByID(context.Context, *GetMedicineRequest) (*Medicine, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMedicineByID not implemented")
+
+func (c *productServiceClient) CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*CreateSupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSupplierResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) ListFoods(context.Context, *ListFoodRequest) (*ListFoodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListFoods not implemented")
+
+func (c *productServiceClient) UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*UpdateSupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateSupplierResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) ListAccessories(context.Context, *ListAccessoryRequest) (*ListAccessoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAccessories not implemented")
+
+func (c *productServiceClient) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuppliersResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSuppliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) ListMedicines(context.Context, *ListMedicineRequest) (*ListMedicineResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMedicines not implemented")
+
+func (c *productServiceClient) CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*CreatePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) CreateFood(context.Context, *CreateFoodRequest) (*CreateFoodResponse, error) {
+
+func (c *productServiceClient) GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, ProductService_GetPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*CancelPurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, ProductService_CancelPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*ReceivePurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceivePurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*CreatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromotionResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdatePromotion(ctx context.Context, in *UpdatePromotionRequest, opts ...grpc.CallOption) (*UpdatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePromotionResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeactivatePromotion(ctx context.Context, in *DeactivatePromotionRequest, opts ...grpc.CallOption) (*DeactivatePromotionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivatePromotionResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeactivatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListPromotions(ctx context.Context, in *ListPromotionsRequest, opts ...grpc.CallOption) (*ListPromotionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromotionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PriceCart(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*CartPricing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartPricing)
+	err := c.cc.Invoke(ctx, ProductService_PriceCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RedeemPromotions(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*CartPricing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartPricing)
+	err := c.cc.Invoke(ctx, ProductService_RedeemPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReleasePromotionRedemptions(ctx context.Context, in *ReleasePromotionRedemptionsRequest, opts ...grpc.CallOption) (*ReleasePromotionRedemptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleasePromotionRedemptionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReleasePromotionRedemptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ProductService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, ProductService_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*CacheStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheStats)
+	err := c.cc.Invoke(ctx, ProductService_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*PriceChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceChange)
+	err := c.cc.Invoke(ctx, ProductService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelPriceChange(ctx context.Context, in *CancelPriceChangeRequest, opts ...grpc.CallOption) (*CancelPriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPriceChangeResponse)
+	err := c.cc.Invoke(ctx, ProductService_CancelPriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*GetPriceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceAtResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+type ProductServiceServer interface {
+	GetFoodByID(context.Context, *GetFoodRequest) (*Food, error)
+	GetAccessoryByID(context.Context, *GetAccessoryRequest) (*Accessory, error)
+	GetMedicineByID(context.Context, *GetMedicineRequest) (*Medicine, error)
+	ListFoods(context.Context, *ListFoodRequest) (*ListFoodResponse, error)
+	ListAccessories(context.Context, *ListAccessoryRequest) (*ListAccessoryResponse, error)
+	ListMedicines(context.Context, *ListMedicineRequest) (*ListMedicineResponse, error)
+	CreateFood(context.Context, *CreateFoodRequest) (*CreateFoodResponse, error)
+	CreateAccessory(context.Context, *CreateAccessoryRequest) (*CreateAccessoryResponse, error)
+	CreateMedicine(context.Context, *CreateMedicineRequest) (*CreateMedicineResponse, error)
+	UpdateFood(context.Context, *UpdateFoodRequest) (*UpdateFoodResponse, error)
+	UpdateAccessory(context.Context, *UpdateAccessoryRequest) (*UpdateAccessoryResponse, error)
+	UpdateMedicine(context.Context, *UpdateMedicineRequest) (*UpdateMedicineResponse, error)
+	DeleteFood(context.Context, *DeleteFoodRequest) (*DeleteFoodResponse, error)
+	DeleteAccessory(context.Context, *DeleteAccessoryRequest) (*DeleteAccessoryResponse, error)
+	DeleteMedicine(context.Context, *DeleteMedicineRequest) (*DeleteMedicineResponse, error)
+	// Xoá sản phẩm chỉ lưu trữ (ẩn khỏi danh sách bán), RestoreProduct đưa sản phẩm trở lại
+	RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error)
+	ListArchivedProducts(context.Context, *ListArchivedProductsRequest) (*ListAllProductsResponse, error)
+	GetBranchByID(context.Context, *GetBranchRequest) (*Branch, error)
+	ListBranches(context.Context, *ListBranchRequest) (*ListBranchResponse, error)
+	CreateBranch(context.Context, *CreateBranchRequest) (*CreateBranchResponse, error)
+	UpdateBranch(context.Context, *UpdateBranchRequest) (*UpdateBranchResponse, error)
+	DeactivateBranch(context.Context, *DeactivateBranchRequest) (*DeactivateBranchResponse, error)
+	// Giờ mở cửa & ngày nghỉ của chi nhánh
+	SetBranchOpeningHours(context.Context, *SetBranchOpeningHoursRequest) (*SetBranchOpeningHoursResponse, error)
+	GetBranchSchedule(context.Context, *GetBranchScheduleRequest) (*BranchSchedule, error)
+	AddBranchClosure(context.Context, *AddBranchClosureRequest) (*BranchClosure, error)
+	DeleteBranchClosure(context.Context, *DeleteBranchClosureRequest) (*DeleteBranchClosureResponse, error)
+	CheckBranchOpen(context.Context, *CheckBranchOpenRequest) (*CheckBranchOpenResponse, error)
+	GetBranchInventory(context.Context, *GetBranchInventoryRequest) (*GetBranchInventoryResponse, error)
+	UpdateBranchInventory(context.Context, *UpdateBranchInventoryRequest) (*UpdateBranchInventoryResponse, error)
+	ListAttachableProducts(context.Context, *ListAttachableProductsRequest) (*ListAttachableProductsResponse, error)
+	ListAllProducts(context.Context, *ListAllProductsRequest) (*ListAllProductsResponse, error)
+	ListAllProductsWithStock(context.Context, *ListAllProductsRequest) (*ListAllProductsWithStockResponse, error)
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	// GetProductByBarcode tìm sản phẩm / biến thể theo mã vạch hoặc SKU kèm số lượng có thể bán tại chi nhánh (bán tại quầy)
+	GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*ProductLookup, error)
+	// Nhập hàng loạt sản phẩm / tồn kho (từ file CSV do gateway đọc)
+	ImportProducts(context.Context, *ImportProductsRequest) (*ImportResponse, error)
+	ImportInventory(context.Context, *ImportInventoryRequest) (*ImportResponse, error)
+	// Biến thể sản phẩm (size, trọng lượng, vị) với SKU, giá và tồn kho riêng
+	CreateVariant(context.Context, *CreateVariantRequest) (*CreateVariantResponse, error)
+	UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantResponse, error)
+	GetVariant(context.Context, *GetVariantRequest) (*ProductVariant, error)
+	ListVariants(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error)
+	DeactivateVariant(context.Context, *DeactivateVariantRequest) (*DeactivateVariantResponse, error)
+	// Ảnh sản phẩm
+	AddProductImage(context.Context, *AddProductImageRequest) (*ProductImage, error)
+	AddProductImages(context.Context, *AddProductImagesRequest) (*ListProductImagesResponse, error)
+	ListProductImages(context.Context, *ListProductImagesRequest) (*ListProductImagesResponse, error)
+	DeleteProductImage(context.Context, *DeleteProductImageRequest) (*ProductImage, error)
+	ListAvailableProductsByBranch(context.Context, *ListAvailableProductsByBranchRequest) (*ListAvailableProductsByBranchResponse, error)
+	ListAvailableAllProductsByBranch(context.Context, *ListAvailableAllProductsByBranchRequest) (*ListAvailableAllProductsByBranchResponse, error)
+	ReserveProduct(context.Context, *ReserveProductRequest) (*ReserveProductResponse, error)
+	// ReserveProducts giữ toàn bộ giỏ hàng trong một transaction: hoặc giữ được tất cả, hoặc không giữ gì
+	ReserveProducts(context.Context, *ReserveProductsRequest) (*ReserveProductsResponse, error)
+	ConfirmPickup(context.Context, *ConfirmPickupRequest) (*ConfirmPickupResponse, error)
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+	// AssignReservations gắn các lần giữ hàng vào đơn hàng và đặt lại hạn giữ
+	AssignReservations(context.Context, *AssignReservationsRequest) (*AssignReservationsResponse, error)
+	GetReservation(context.Context, *GetReservationRequest) (*Reservation, error)
+	// Nhà cung cấp & đơn nhập hàng
+	CreateSupplier(context.Context, *CreateSupplierRequest) (*CreateSupplierResponse, error)
+	UpdateSupplier(context.Context, *UpdateSupplierRequest) (*UpdateSupplierResponse, error)
+	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
+	CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*CreatePurchaseOrderResponse, error)
+	GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrder, error)
+	ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error)
+	CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*CancelPurchaseOrderResponse, error)
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error)
+	// Khuyến mãi & mã giảm giá
+	CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error)
+	UpdatePromotion(context.Context, *UpdatePromotionRequest) (*UpdatePromotionResponse, error)
+	DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*DeactivatePromotionResponse, error)
+	ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error)
+	// PriceCart tính giá giỏ hàng (sản phẩm và/hoặc dịch vụ) sau khuyến mãi, không ghi nhận lượt dùng
+	PriceCart(context.Context, *PriceCartRequest) (*CartPricing, error)
+	// RedeemPromotions tính giá như PriceCart và ghi nhận lượt dùng các khuyến mãi được áp dụng
+	RedeemPromotions(context.Context, *PriceCartRequest) (*CartPricing, error)
+	// ReleasePromotionRedemptions huỷ các lượt dùng khi đơn hàng / lịch hẹn không tạo được
+	ReleasePromotionRedemptions(context.Context, *ReleasePromotionRedemptionsRequest) (*ReleasePromotionRedemptionsResponse, error)
+	// Đánh giá sản phẩm
+	// CreateReview chỉ nhận đánh giá của khách đã có đơn COMPLETED chứa sản phẩm, đánh giá chờ nhân viên duyệt
+	CreateReview(context.Context, *CreateReviewRequest) (*Review, error)
+	// ListProductReviews trả về các đánh giá đã duyệt của một sản phẩm
+	ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListReviewsResponse, error)
+	// ListReviews dành cho nhân viên kiểm duyệt, lọc theo trạng thái và sản phẩm
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*Review, error)
+	// Tỉ lệ trúng cache của danh sách sản phẩm
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*CacheStats, error)
+	// Lịch sử giá và giá hẹn trước (variant_id = 0 là sản phẩm gốc)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*PriceChange, error)
+	CancelPriceChange(context.Context, *CancelPriceChangeRequest) (*CancelPriceChangeResponse, error)
+	ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error)
+	// GetPriceAt trả về giá bán tại một thời điểm, Orders dùng để định giá đơn hàng
+	GetPriceAt(context.Context, *GetPriceAtRequest) (*GetPriceAtResponse, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) GetFoodByID(context.Context, *GetFoodRequest) (*Food, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFoodByID not implemented")
+}
+func (UnimplementedProductServiceServer) GetAccessoryByID(context.Context, *GetAccessoryRequest) (*Accessory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccessoryByID not implemented")
+}
+func (UnimplementedProductServiceServer) GetMedicineByID(context.Context, *GetMedicineRequest) (*Medicine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedicineByID not implemented")
+}
+func (UnimplementedProductServiceServer) ListFoods(context.Context, *ListFoodRequest) (*ListFoodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFoods not implemented")
+}
+func (UnimplementedProductServiceServer) ListAccessories(context.Context, *ListAccessoryRequest) (*ListAccessoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccessories not implemented")
+}
+func (UnimplementedProductServiceServer) ListMedicines(context.Context, *ListMedicineRequest) (*ListMedicineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMedicines not implemented")
+}
+func (UnimplementedProductServiceServer) CreateFood(context.Context, *CreateFoodRequest) (*CreateFoodResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFood not implemented")
 }
 func (UnimplementedProductServiceServer) CreateAccessory(context.Context, *CreateAccessoryRequest) (*CreateAccessoryResponse, error) {
@@ -445,12 +1135,42 @@ func (UnimplementedProductServiceServer) DeleteAccessory(context.Context, *Delet
 func (UnimplementedProductServiceServer) DeleteMedicine(context.Context, *DeleteMedicineRequest) (*DeleteMedicineResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMedicine not implemented")
 }
+func (UnimplementedProductServiceServer) RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ListArchivedProducts(context.Context, *ListArchivedProductsRequest) (*ListAllProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivedProducts not implemented")
+}
 func (UnimplementedProductServiceServer) GetBranchByID(context.Context, *GetBranchRequest) (*Branch, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBranchByID not implemented")
 }
 func (UnimplementedProductServiceServer) ListBranches(context.Context, *ListBranchRequest) (*ListBranchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBranches not implemented")
 }
+func (UnimplementedProductServiceServer) CreateBranch(context.Context, *CreateBranchRequest) (*CreateBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateBranch(context.Context, *UpdateBranchRequest) (*UpdateBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBranch not implemented")
+}
+func (UnimplementedProductServiceServer) DeactivateBranch(context.Context, *DeactivateBranchRequest) (*DeactivateBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateBranch not implemented")
+}
+func (UnimplementedProductServiceServer) SetBranchOpeningHours(context.Context, *SetBranchOpeningHoursRequest) (*SetBranchOpeningHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBranchOpeningHours not implemented")
+}
+func (UnimplementedProductServiceServer) GetBranchSchedule(context.Context, *GetBranchScheduleRequest) (*BranchSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBranchSchedule not implemented")
+}
+func (UnimplementedProductServiceServer) AddBranchClosure(context.Context, *AddBranchClosureRequest) (*BranchClosure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBranchClosure not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteBranchClosure(context.Context, *DeleteBranchClosureRequest) (*DeleteBranchClosureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBranchClosure not implemented")
+}
+func (UnimplementedProductServiceServer) CheckBranchOpen(context.Context, *CheckBranchOpenRequest) (*CheckBranchOpenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBranchOpen not implemented")
+}
 func (UnimplementedProductServiceServer) GetBranchInventory(context.Context, *GetBranchInventoryRequest) (*GetBranchInventoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBranchInventory not implemented")
 }
@@ -466,6 +1186,45 @@ func (UnimplementedProductServiceServer) ListAllProducts(context.Context, *ListA
 func (UnimplementedProductServiceServer) ListAllProductsWithStock(context.Context, *ListAllProductsRequest) (*ListAllProductsWithStockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAllProductsWithStock not implemented")
 }
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductByBarcode(context.Context, *GetProductByBarcodeRequest) (*ProductLookup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByBarcode not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProducts(context.Context, *ImportProductsRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ImportInventory(context.Context, *ImportInventoryRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportInventory not implemented")
+}
+func (UnimplementedProductServiceServer) CreateVariant(context.Context, *CreateVariantRequest) (*CreateVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateVariant(context.Context, *UpdateVariantRequest) (*UpdateVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) GetVariant(context.Context, *GetVariantRequest) (*ProductVariant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVariant not implemented")
+}
+func (UnimplementedProductServiceServer) ListVariants(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVariants not implemented")
+}
+func (UnimplementedProductServiceServer) DeactivateVariant(context.Context, *DeactivateVariantRequest) (*DeactivateVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) AddProductImage(context.Context, *AddProductImageRequest) (*ProductImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) AddProductImages(context.Context, *AddProductImagesRequest) (*ListProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductImages(context.Context, *ListProductImagesRequest) (*ListProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductImage(context.Context, *DeleteProductImageRequest) (*ProductImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductImage not implemented")
+}
 func (UnimplementedProductServiceServer) ListAvailableProductsByBranch(context.Context, *ListAvailableProductsByBranchRequest) (*ListAvailableProductsByBranchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableProductsByBranch not implemented")
 }
@@ -475,12 +1234,93 @@ func (UnimplementedProductServiceServer) ListAvailableAllProductsByBranch(contex
 func (UnimplementedProductServiceServer) ReserveProduct(context.Context, *ReserveProductRequest) (*ReserveProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReserveProduct not implemented")
 }
+func (UnimplementedProductServiceServer) ReserveProducts(context.Context, *ReserveProductsRequest) (*ReserveProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveProducts not implemented")
+}
 func (UnimplementedProductServiceServer) ConfirmPickup(context.Context, *ConfirmPickupRequest) (*ConfirmPickupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPickup not implemented")
 }
 func (UnimplementedProductServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
 }
+func (UnimplementedProductServiceServer) AssignReservations(context.Context, *AssignReservationsRequest) (*AssignReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignReservations not implemented")
+}
+func (UnimplementedProductServiceServer) GetReservation(context.Context, *GetReservationRequest) (*Reservation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReservation not implemented")
+}
+func (UnimplementedProductServiceServer) CreateSupplier(context.Context, *CreateSupplierRequest) (*CreateSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplier not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateSupplier(context.Context, *UpdateSupplierRequest) (*UpdateSupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSupplier not implemented")
+}
+func (UnimplementedProductServiceServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedProductServiceServer) CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*CreatePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedProductServiceServer) GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedProductServiceServer) ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPurchaseOrders not implemented")
+}
+func (UnimplementedProductServiceServer) CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*CancelPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPurchaseOrder not implemented")
+}
+func (UnimplementedProductServiceServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*ReceivePurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
+func (UnimplementedProductServiceServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*CreatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedProductServiceServer) UpdatePromotion(context.Context, *UpdatePromotionRequest) (*UpdatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromotion not implemented")
+}
+func (UnimplementedProductServiceServer) DeactivatePromotion(context.Context, *DeactivatePromotionRequest) (*DeactivatePromotionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePromotion not implemented")
+}
+func (UnimplementedProductServiceServer) ListPromotions(context.Context, *ListPromotionsRequest) (*ListPromotionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedProductServiceServer) PriceCart(context.Context, *PriceCartRequest) (*CartPricing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceCart not implemented")
+}
+func (UnimplementedProductServiceServer) RedeemPromotions(context.Context, *PriceCartRequest) (*CartPricing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemPromotions not implemented")
+}
+func (UnimplementedProductServiceServer) ReleasePromotionRedemptions(context.Context, *ReleasePromotionRedemptionsRequest) (*ReleasePromotionRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePromotionRedemptions not implemented")
+}
+func (UnimplementedProductServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductReviews not implemented")
+}
+func (UnimplementedProductServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedProductServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*CacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedProductServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*PriceChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) CancelPriceChange(context.Context, *CancelPriceChangeRequest) (*CancelPriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceAt(context.Context, *GetPriceAtRequest) (*GetPriceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceAt not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -499,491 +1339,1391 @@ func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceSer
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&ProductService_ServiceDesc, srv)
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_GetFoodByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFoodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetFoodByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetFoodByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetFoodByID(ctx, req.(*GetFoodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetAccessoryByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccessoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAccessoryByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAccessoryByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAccessoryByID(ctx, req.(*GetAccessoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetMedicineByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedicineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetMedicineByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetMedicineByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetMedicineByID(ctx, req.(*GetMedicineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListFoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFoodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListFoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListFoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListFoods(ctx, req.(*ListFoodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListAccessories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccessoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListAccessories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListAccessories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListAccessories(ctx, req.(*ListAccessoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListMedicines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListMedicines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListMedicines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListMedicines(ctx, req.(*ListMedicineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateFood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFoodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateFood(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateFood_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateFood(ctx, req.(*CreateFoodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateAccessory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateAccessory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateAccessory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateAccessory(ctx, req.(*CreateAccessoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMedicineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateMedicine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateMedicine(ctx, req.(*CreateMedicineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateFood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFoodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateFood(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateFood_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateFood(ctx, req.(*UpdateFoodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateAccessory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccessoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateAccessory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateAccessory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateAccessory(ctx, req.(*UpdateAccessoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMedicineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateMedicine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateMedicine(ctx, req.(*UpdateMedicineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteFood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFoodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteFood(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteFood_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteFood(ctx, req.(*DeleteFoodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteAccessory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccessoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteAccessory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteAccessory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteAccessory(ctx, req.(*DeleteAccessoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMedicineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteMedicine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteMedicine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteMedicine(ctx, req.(*DeleteMedicineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListArchivedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListArchivedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListArchivedProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListArchivedProducts(ctx, req.(*ListArchivedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetBranchByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetBranchByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetBranchByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetBranchByID(ctx, req.(*GetBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListBranches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListBranches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListBranches(ctx, req.(*ListBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateBranch(ctx, req.(*CreateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateBranch(ctx, req.(*UpdateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeactivateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeactivateBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeactivateBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeactivateBranch(ctx, req.(*DeactivateBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetBranchOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBranchOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetBranchOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetBranchOpeningHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetBranchOpeningHours(ctx, req.(*SetBranchOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetBranchSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBranchScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetBranchSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetBranchSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetBranchSchedule(ctx, req.(*GetBranchScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddBranchClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBranchClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddBranchClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddBranchClosure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddBranchClosure(ctx, req.(*AddBranchClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteBranchClosure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBranchClosureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteBranchClosure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteBranchClosure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteBranchClosure(ctx, req.(*DeleteBranchClosureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CheckBranchOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBranchOpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CheckBranchOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CheckBranchOpen_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CheckBranchOpen(ctx, req.(*CheckBranchOpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetBranchInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBranchInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetBranchInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetBranchInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetBranchInventory(ctx, req.(*GetBranchInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateBranchInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBranchInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateBranchInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateBranchInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateBranchInventory(ctx, req.(*UpdateBranchInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListAttachableProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachableProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListAttachableProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListAttachableProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListAttachableProducts(ctx, req.(*ListAttachableProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListAllProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListAllProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListAllProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListAllProducts(ctx, req.(*ListAllProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListAllProductsWithStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListAllProductsWithStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListAllProductsWithStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListAllProductsWithStock(ctx, req.(*ListAllProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductByBarcode(ctx, req.(*GetProductByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ImportProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ImportProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ImportProducts(ctx, req.(*ImportProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ImportInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ImportInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ImportInventory(ctx, req.(*ImportInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateVariant(ctx, req.(*CreateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, req.(*UpdateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetVariant(ctx, req.(*GetVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListVariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListVariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListVariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListVariants(ctx, req.(*ListVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeactivateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeactivateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeactivateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeactivateVariant(ctx, req.(*DeactivateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddProductImage(ctx, req.(*AddProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddProductImages(ctx, req.(*AddProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductImages(ctx, req.(*ListProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductImage(ctx, req.(*DeleteProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListAvailableProductsByBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableProductsByBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListAvailableProductsByBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListAvailableProductsByBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListAvailableProductsByBranch(ctx, req.(*ListAvailableProductsByBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListAvailableAllProductsByBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableAllProductsByBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListAvailableAllProductsByBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListAvailableAllProductsByBranch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListAvailableAllProductsByBranch(ctx, req.(*ListAvailableAllProductsByBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReserveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReserveProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveProduct(ctx, req.(*ReserveProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReserveProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReserveProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReserveProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReserveProducts(ctx, req.(*ReserveProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ConfirmPickup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPickupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ConfirmPickup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ConfirmPickup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ConfirmPickup(ctx, req.(*ConfirmPickupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetFoodByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFoodRequest)
+func _ProductService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetFoodByID(ctx, in)
+		return srv.(ProductServiceServer).ReleaseReservation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetFoodByID_FullMethodName,
+		FullMethod: ProductService_ReleaseReservation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetFoodByID(ctx, req.(*GetFoodRequest))
+		return srv.(ProductServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetAccessoryByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccessoryRequest)
+func _ProductService_AssignReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignReservationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetAccessoryByID(ctx, in)
+		return srv.(ProductServiceServer).AssignReservations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetAccessoryByID_FullMethodName,
+		FullMethod: ProductService_AssignReservations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetAccessoryByID(ctx, req.(*GetAccessoryRequest))
+		return srv.(ProductServiceServer).AssignReservations(ctx, req.(*AssignReservationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetMedicineByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMedicineRequest)
+func _ProductService_GetReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReservationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetMedicineByID(ctx, in)
+		return srv.(ProductServiceServer).GetReservation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetMedicineByID_FullMethodName,
+		FullMethod: ProductService_GetReservation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetMedicineByID(ctx, req.(*GetMedicineRequest))
+		return srv.(ProductServiceServer).GetReservation(ctx, req.(*GetReservationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListFoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFoodRequest)
+func _ProductService_CreateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSupplierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListFoods(ctx, in)
+		return srv.(ProductServiceServer).CreateSupplier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListFoods_FullMethodName,
+		FullMethod: ProductService_CreateSupplier_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListFoods(ctx, req.(*ListFoodRequest))
+		return srv.(ProductServiceServer).CreateSupplier(ctx, req.(*CreateSupplierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListAccessories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAccessoryRequest)
+func _ProductService_UpdateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSupplierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListAccessories(ctx, in)
+		return srv.(ProductServiceServer).UpdateSupplier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListAccessories_FullMethodName,
+		FullMethod: ProductService_UpdateSupplier_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListAccessories(ctx, req.(*ListAccessoryRequest))
+		return srv.(ProductServiceServer).UpdateSupplier(ctx, req.(*UpdateSupplierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListMedicines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMedicineRequest)
+func _ProductService_ListSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppliersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListMedicines(ctx, in)
+		return srv.(ProductServiceServer).ListSuppliers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListMedicines_FullMethodName,
+		FullMethod: ProductService_ListSuppliers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListMedicines(ctx, req.(*ListMedicineRequest))
+		return srv.(ProductServiceServer).ListSuppliers(ctx, req.(*ListSuppliersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateFood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFoodRequest)
+func _ProductService_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateFood(ctx, in)
+		return srv.(ProductServiceServer).CreatePurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateFood_FullMethodName,
+		FullMethod: ProductService_CreatePurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateFood(ctx, req.(*CreateFoodRequest))
+		return srv.(ProductServiceServer).CreatePurchaseOrder(ctx, req.(*CreatePurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateAccessory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccessoryRequest)
+func _ProductService_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateAccessory(ctx, in)
+		return srv.(ProductServiceServer).GetPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateAccessory_FullMethodName,
+		FullMethod: ProductService_GetPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateAccessory(ctx, req.(*CreateAccessoryRequest))
+		return srv.(ProductServiceServer).GetPurchaseOrder(ctx, req.(*GetPurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMedicineRequest)
+func _ProductService_ListPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPurchaseOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateMedicine(ctx, in)
+		return srv.(ProductServiceServer).ListPurchaseOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateMedicine_FullMethodName,
+		FullMethod: ProductService_ListPurchaseOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateMedicine(ctx, req.(*CreateMedicineRequest))
+		return srv.(ProductServiceServer).ListPurchaseOrders(ctx, req.(*ListPurchaseOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateFood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateFoodRequest)
+func _ProductService_CancelPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateFood(ctx, in)
+		return srv.(ProductServiceServer).CancelPurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateFood_FullMethodName,
+		FullMethod: ProductService_CancelPurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateFood(ctx, req.(*UpdateFoodRequest))
+		return srv.(ProductServiceServer).CancelPurchaseOrder(ctx, req.(*CancelPurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateAccessory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAccessoryRequest)
+func _ProductService_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateAccessory(ctx, in)
+		return srv.(ProductServiceServer).ReceivePurchaseOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateAccessory_FullMethodName,
+		FullMethod: ProductService_ReceivePurchaseOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateAccessory(ctx, req.(*UpdateAccessoryRequest))
+		return srv.(ProductServiceServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMedicineRequest)
+func _ProductService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateMedicine(ctx, in)
+		return srv.(ProductServiceServer).CreatePromotion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateMedicine_FullMethodName,
+		FullMethod: ProductService_CreatePromotion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateMedicine(ctx, req.(*UpdateMedicineRequest))
+		return srv.(ProductServiceServer).CreatePromotion(ctx, req.(*CreatePromotionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteFood_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteFoodRequest)
+func _ProductService_UpdatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromotionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteFood(ctx, in)
+		return srv.(ProductServiceServer).UpdatePromotion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteFood_FullMethodName,
+		FullMethod: ProductService_UpdatePromotion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteFood(ctx, req.(*DeleteFoodRequest))
+		return srv.(ProductServiceServer).UpdatePromotion(ctx, req.(*UpdatePromotionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteAccessory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAccessoryRequest)
+func _ProductService_DeactivatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivatePromotionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteAccessory(ctx, in)
+		return srv.(ProductServiceServer).DeactivatePromotion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteAccessory_FullMethodName,
+		FullMethod: ProductService_DeactivatePromotion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteAccessory(ctx, req.(*DeleteAccessoryRequest))
+		return srv.(ProductServiceServer).DeactivatePromotion(ctx, req.(*DeactivatePromotionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteMedicine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMedicineRequest)
+func _ProductService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromotionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteMedicine(ctx, in)
+		return srv.(ProductServiceServer).ListPromotions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteMedicine_FullMethodName,
+		FullMethod: ProductService_ListPromotions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteMedicine(ctx, req.(*DeleteMedicineRequest))
+		return srv.(ProductServiceServer).ListPromotions(ctx, req.(*ListPromotionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetBranchByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBranchRequest)
+func _ProductService_PriceCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceCartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetBranchByID(ctx, in)
+		return srv.(ProductServiceServer).PriceCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetBranchByID_FullMethodName,
+		FullMethod: ProductService_PriceCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetBranchByID(ctx, req.(*GetBranchRequest))
+		return srv.(ProductServiceServer).PriceCart(ctx, req.(*PriceCartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBranchRequest)
+func _ProductService_RedeemPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceCartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListBranches(ctx, in)
+		return srv.(ProductServiceServer).RedeemPromotions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListBranches_FullMethodName,
+		FullMethod: ProductService_RedeemPromotions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListBranches(ctx, req.(*ListBranchRequest))
+		return srv.(ProductServiceServer).RedeemPromotions(ctx, req.(*PriceCartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetBranchInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBranchInventoryRequest)
+func _ProductService_ReleasePromotionRedemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePromotionRedemptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetBranchInventory(ctx, in)
+		return srv.(ProductServiceServer).ReleasePromotionRedemptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetBranchInventory_FullMethodName,
+		FullMethod: ProductService_ReleasePromotionRedemptions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetBranchInventory(ctx, req.(*GetBranchInventoryRequest))
+		return srv.(ProductServiceServer).ReleasePromotionRedemptions(ctx, req.(*ReleasePromotionRedemptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateBranchInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBranchInventoryRequest)
+func _ProductService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateBranchInventory(ctx, in)
+		return srv.(ProductServiceServer).CreateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateBranchInventory_FullMethodName,
+		FullMethod: ProductService_CreateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateBranchInventory(ctx, req.(*UpdateBranchInventoryRequest))
+		return srv.(ProductServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListAttachableProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAttachableProductsRequest)
+func _ProductService_ListProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListAttachableProducts(ctx, in)
+		return srv.(ProductServiceServer).ListProductReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListAttachableProducts_FullMethodName,
+		FullMethod: ProductService_ListProductReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListAttachableProducts(ctx, req.(*ListAttachableProductsRequest))
+		return srv.(ProductServiceServer).ListProductReviews(ctx, req.(*ListProductReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListAllProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllProductsRequest)
+func _ProductService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListAllProducts(ctx, in)
+		return srv.(ProductServiceServer).ListReviews(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListAllProducts_FullMethodName,
+		FullMethod: ProductService_ListReviews_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListAllProducts(ctx, req.(*ListAllProductsRequest))
+		return srv.(ProductServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListAllProductsWithStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllProductsRequest)
+func _ProductService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListAllProductsWithStock(ctx, in)
+		return srv.(ProductServiceServer).ModerateReview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListAllProductsWithStock_FullMethodName,
+		FullMethod: ProductService_ModerateReview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListAllProductsWithStock(ctx, req.(*ListAllProductsRequest))
+		return srv.(ProductServiceServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListAvailableProductsByBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAvailableProductsByBranchRequest)
+func _ProductService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListAvailableProductsByBranch(ctx, in)
+		return srv.(ProductServiceServer).GetCacheStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListAvailableProductsByBranch_FullMethodName,
+		FullMethod: ProductService_GetCacheStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListAvailableProductsByBranch(ctx, req.(*ListAvailableProductsByBranchRequest))
+		return srv.(ProductServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListAvailableAllProductsByBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAvailableAllProductsByBranchRequest)
+func _ProductService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListAvailableAllProductsByBranch(ctx, in)
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListAvailableAllProductsByBranch_FullMethodName,
+		FullMethod: ProductService_SchedulePriceChange_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListAvailableAllProductsByBranch(ctx, req.(*ListAvailableAllProductsByBranchRequest))
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ReserveProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReserveProductRequest)
+func _ProductService_CancelPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPriceChangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ReserveProduct(ctx, in)
+		return srv.(ProductServiceServer).CancelPriceChange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ReserveProduct_FullMethodName,
+		FullMethod: ProductService_CancelPriceChange_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ReserveProduct(ctx, req.(*ReserveProductRequest))
+		return srv.(ProductServiceServer).CancelPriceChange(ctx, req.(*CancelPriceChangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ConfirmPickup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfirmPickupRequest)
+func _ProductService_ListPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ConfirmPickup(ctx, in)
+		return srv.(ProductServiceServer).ListPriceHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ConfirmPickup_FullMethodName,
+		FullMethod: ProductService_ListPriceHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ConfirmPickup(ctx, req.(*ConfirmPickupRequest))
+		return srv.(ProductServiceServer).ListPriceHistory(ctx, req.(*ListPriceHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleaseReservationRequest)
+func _ProductService_GetPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceAtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ReleaseReservation(ctx, in)
+		return srv.(ProductServiceServer).GetPriceAt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ReleaseReservation_FullMethodName,
+		FullMethod: ProductService_GetPriceAt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+		return srv.(ProductServiceServer).GetPriceAt(ctx, req.(*GetPriceAtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1055,6 +2795,14 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMedicine",
 			Handler:    _ProductService_DeleteMedicine_Handler,
 		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _ProductService_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "ListArchivedProducts",
+			Handler:    _ProductService_ListArchivedProducts_Handler,
+		},
 		{
 			MethodName: "GetBranchByID",
 			Handler:    _ProductService_GetBranchByID_Handler,
@@ -1063,6 +2811,38 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBranches",
 			Handler:    _ProductService_ListBranches_Handler,
 		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _ProductService_CreateBranch_Handler,
+		},
+		{
+			MethodName: "UpdateBranch",
+			Handler:    _ProductService_UpdateBranch_Handler,
+		},
+		{
+			MethodName: "DeactivateBranch",
+			Handler:    _ProductService_DeactivateBranch_Handler,
+		},
+		{
+			MethodName: "SetBranchOpeningHours",
+			Handler:    _ProductService_SetBranchOpeningHours_Handler,
+		},
+		{
+			MethodName: "GetBranchSchedule",
+			Handler:    _ProductService_GetBranchSchedule_Handler,
+		},
+		{
+			MethodName: "AddBranchClosure",
+			Handler:    _ProductService_AddBranchClosure_Handler,
+		},
+		{
+			MethodName: "DeleteBranchClosure",
+			Handler:    _ProductService_DeleteBranchClosure_Handler,
+		},
+		{
+			MethodName: "CheckBranchOpen",
+			Handler:    _ProductService_CheckBranchOpen_Handler,
+		},
 		{
 			MethodName: "GetBranchInventory",
 			Handler:    _ProductService_GetBranchInventory_Handler,
@@ -1083,6 +2863,58 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAllProductsWithStock",
 			Handler:    _ProductService_ListAllProductsWithStock_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "GetProductByBarcode",
+			Handler:    _ProductService_GetProductByBarcode_Handler,
+		},
+		{
+			MethodName: "ImportProducts",
+			Handler:    _ProductService_ImportProducts_Handler,
+		},
+		{
+			MethodName: "ImportInventory",
+			Handler:    _ProductService_ImportInventory_Handler,
+		},
+		{
+			MethodName: "CreateVariant",
+			Handler:    _ProductService_CreateVariant_Handler,
+		},
+		{
+			MethodName: "UpdateVariant",
+			Handler:    _ProductService_UpdateVariant_Handler,
+		},
+		{
+			MethodName: "GetVariant",
+			Handler:    _ProductService_GetVariant_Handler,
+		},
+		{
+			MethodName: "ListVariants",
+			Handler:    _ProductService_ListVariants_Handler,
+		},
+		{
+			MethodName: "DeactivateVariant",
+			Handler:    _ProductService_DeactivateVariant_Handler,
+		},
+		{
+			MethodName: "AddProductImage",
+			Handler:    _ProductService_AddProductImage_Handler,
+		},
+		{
+			MethodName: "AddProductImages",
+			Handler:    _ProductService_AddProductImages_Handler,
+		},
+		{
+			MethodName: "ListProductImages",
+			Handler:    _ProductService_ListProductImages_Handler,
+		},
+		{
+			MethodName: "DeleteProductImage",
+			Handler:    _ProductService_DeleteProductImage_Handler,
+		},
 		{
 			MethodName: "ListAvailableProductsByBranch",
 			Handler:    _ProductService_ListAvailableProductsByBranch_Handler,
@@ -1095,6 +2927,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReserveProduct",
 			Handler:    _ProductService_ReserveProduct_Handler,
 		},
+		{
+			MethodName: "ReserveProducts",
+			Handler:    _ProductService_ReserveProducts_Handler,
+		},
 		{
 			MethodName: "ConfirmPickup",
 			Handler:    _ProductService_ConfirmPickup_Handler,
@@ -1103,6 +2939,110 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseReservation",
 			Handler:    _ProductService_ReleaseReservation_Handler,
 		},
+		{
+			MethodName: "AssignReservations",
+			Handler:    _ProductService_AssignReservations_Handler,
+		},
+		{
+			MethodName: "GetReservation",
+			Handler:    _ProductService_GetReservation_Handler,
+		},
+		{
+			MethodName: "CreateSupplier",
+			Handler:    _ProductService_CreateSupplier_Handler,
+		},
+		{
+			MethodName: "UpdateSupplier",
+			Handler:    _ProductService_UpdateSupplier_Handler,
+		},
+		{
+			MethodName: "ListSuppliers",
+			Handler:    _ProductService_ListSuppliers_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _ProductService_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _ProductService_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ListPurchaseOrders",
+			Handler:    _ProductService_ListPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "CancelPurchaseOrder",
+			Handler:    _ProductService_CancelPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _ProductService_ReceivePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _ProductService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "UpdatePromotion",
+			Handler:    _ProductService_UpdatePromotion_Handler,
+		},
+		{
+			MethodName: "DeactivatePromotion",
+			Handler:    _ProductService_DeactivatePromotion_Handler,
+		},
+		{
+			MethodName: "ListPromotions",
+			Handler:    _ProductService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "PriceCart",
+			Handler:    _ProductService_PriceCart_Handler,
+		},
+		{
+			MethodName: "RedeemPromotions",
+			Handler:    _ProductService_RedeemPromotions_Handler,
+		},
+		{
+			MethodName: "ReleasePromotionRedemptions",
+			Handler:    _ProductService_ReleasePromotionRedemptions_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _ProductService_CreateReview_Handler,
+		},
+		{
+			MethodName: "ListProductReviews",
+			Handler:    _ProductService_ListProductReviews_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _ProductService_ListReviews_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _ProductService_ModerateReview_Handler,
+		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _ProductService_GetCacheStats_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _ProductService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelPriceChange",
+			Handler:    _ProductService_CancelPriceChange_Handler,
+		},
+		{
+			MethodName: "ListPriceHistory",
+			Handler:    _ProductService_ListPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetPriceAt",
+			Handler:    _ProductService_GetPriceAt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "products.proto",
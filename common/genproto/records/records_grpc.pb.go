@@ -24,6 +24,7 @@ const (
 	PetRecordService_UpdatePet_FullMethodName                      = "/petrecord.PetRecordService/UpdatePet"
 	PetRecordService_DeletePet_FullMethodName                      = "/petrecord.PetRecordService/DeletePet"
 	PetRecordService_ListPets_FullMethodName                       = "/petrecord.PetRecordService/ListPets"
+	PetRecordService_GetPetsByIDs_FullMethodName                   = "/petrecord.PetRecordService/GetPetsByIDs"
 	PetRecordService_CreateExamination_FullMethodName              = "/petrecord.PetRecordService/CreateExamination"
 	PetRecordService_GetExamination_FullMethodName                 = "/petrecord.PetRecordService/GetExamination"
 	PetRecordService_UpdateExamination_FullMethodName              = "/petrecord.PetRecordService/UpdateExamination"
@@ -53,6 +54,7 @@ type PetRecordServiceClient interface {
 	UpdatePet(ctx context.Context, in *UpdatePetRequest, opts ...grpc.CallOption) (*UpdatePetResponse, error)
 	DeletePet(ctx context.Context, in *DeletePetRequest, opts ...grpc.CallOption) (*DeletePetResponse, error)
 	ListPets(ctx context.Context, in *ListPetsRequest, opts ...grpc.CallOption) (*ListPetsResponse, error)
+	GetPetsByIDs(ctx context.Context, in *GetPetsByIDsRequest, opts ...grpc.CallOption) (*GetPetsByIDsResponse, error)
 	// Examination operations
 	CreateExamination(ctx context.Context, in *CreateExaminationRequest, opts ...grpc.CallOption) (*CreateExaminationResponse, error)
 	GetExamination(ctx context.Context, in *GetExaminationRequest, opts ...grpc.CallOption) (*GetExaminationResponse, error)
@@ -131,6 +133,16 @@ func (c *petRecordServiceClient) ListPets(ctx context.Context, in *ListPetsReque
 	return out, nil
 }
 
+func (c *petRecordServiceClient) GetPetsByIDs(ctx context.Context, in *GetPetsByIDsRequest, opts ...grpc.CallOption) (*GetPetsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPetsByIDsResponse)
+	err := c.cc.Invoke(ctx, PetRecordService_GetPetsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *petRecordServiceClient) CreateExamination(ctx context.Context, in *CreateExaminationRequest, opts ...grpc.CallOption) (*CreateExaminationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateExaminationResponse)
@@ -293,6 +305,7 @@ type PetRecordServiceServer interface {
 	UpdatePet(context.Context, *UpdatePetRequest) (*UpdatePetResponse, error)
 	DeletePet(context.Context, *DeletePetRequest) (*DeletePetResponse, error)
 	ListPets(context.Context, *ListPetsRequest) (*ListPetsResponse, error)
+	GetPetsByIDs(context.Context, *GetPetsByIDsRequest) (*GetPetsByIDsResponse, error)
 	// Examination operations
 	CreateExamination(context.Context, *CreateExaminationRequest) (*CreateExaminationResponse, error)
 	GetExamination(context.Context, *GetExaminationRequest) (*GetExaminationResponse, error)
@@ -336,6 +349,9 @@ func (UnimplementedPetRecordServiceServer) DeletePet(context.Context, *DeletePet
 func (UnimplementedPetRecordServiceServer) ListPets(context.Context, *ListPetsRequest) (*ListPetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPets not implemented")
 }
+func (UnimplementedPetRecordServiceServer) GetPetsByIDs(context.Context, *GetPetsByIDsRequest) (*GetPetsByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPetsByIDs not implemented")
+}
 func (UnimplementedPetRecordServiceServer) CreateExamination(context.Context, *CreateExaminationRequest) (*CreateExaminationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateExamination not implemented")
 }
@@ -492,6 +508,24 @@ func _PetRecordService_ListPets_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PetRecordService_GetPetsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPetsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PetRecordServiceServer).GetPetsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PetRecordService_GetPetsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PetRecordServiceServer).GetPetsByIDs(ctx, req.(*GetPetsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PetRecordService_CreateExamination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateExaminationRequest)
 	if err := dec(in); err != nil {
@@ -789,6 +823,10 @@ var PetRecordService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPets",
 			Handler:    _PetRecordService_ListPets_Handler,
 		},
+		{
+			MethodName: "GetPetsByIDs",
+			Handler:    _PetRecordService_GetPetsByIDs_Handler,
+		},
 		{
 			MethodName: "CreateExamination",
 			Handler:    _PetRecordService_CreateExamination_Handler,
@@ -1739,6 +1739,96 @@ func (x *GetBranchByEmployeeIDResponse) GetBranchId() int32 {
 	return 0
 }
 
+type GetUsersByIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IDs []int32 `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+}
+
+func (x *GetUsersByIDsRequest) Reset() {
+	*x = GetUsersByIDsRequest{}
+	mi := &file_users_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIDsRequest) ProtoMessage() {}
+
+func (x *GetUsersByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetUsersByIDsRequest) GetIDs() []int32 {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+type GetUsersByIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *GetUsersByIDsResponse) Reset() {
+	*x = GetUsersByIDsResponse{}
+	mi := &file_users_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByIDsResponse) ProtoMessage() {}
+
+func (x *GetUsersByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_users_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUsersByIDsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
 var File_users_proto protoreflect.FileDescriptor
 
 var file_users_proto_rawDesc = []byte{
@@ -1899,7 +1989,13 @@ var file_users_proto_rawDesc = []byte{
 	0x3c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x42, 0x79, 0x45, 0x6d,
 	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x32, 0x88, 0x08,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0x28, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x34, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x32, 0xca, 0x08,
 	0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31, 0x0a,
 	0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x10, 0x2e, 0x52, 0x65, 0x67, 0x69,
 	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x52, 0x65,
@@ -1964,10 +2060,15 @@ var file_users_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65,
 	0x72, 0x12, 0x10, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x62, 0x69, 0x6e, 0x32, 0x37,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x62, 0x69, 0x6e,
+	0x32, 0x37, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1982,7 +2083,7 @@ func file_users_proto_rawDescGZIP() []byte {
 	return file_users_proto_rawDescData
 }
 
-var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_users_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_users_proto_goTypes = []any{
 	(*User)(nil),                          // 0: User
 	(*UserWithRole)(nil),                  // 1: UserWithRole
@@ -2016,53 +2117,58 @@ var file_users_proto_goTypes = []any{
 	(*GetCustomersByNameResponse)(nil),    // 29: GetCustomersByNameResponse
 	(*GetBranchByEmployeeIDRequest)(nil),  // 30: GetBranchByEmployeeIDRequest
 	(*GetBranchByEmployeeIDResponse)(nil), // 31: GetBranchByEmployeeIDResponse
-	(*timestamppb.Timestamp)(nil),         // 32: google.protobuf.Timestamp
+	(*GetUsersByIDsRequest)(nil),          // 32: GetUsersByIDsRequest
+	(*GetUsersByIDsResponse)(nil),         // 33: GetUsersByIDsResponse
+	(*timestamppb.Timestamp)(nil),         // 34: google.protobuf.Timestamp
 }
 var file_users_proto_depIdxs = []int32{
-	32, // 0: User.CreatedAt:type_name -> google.protobuf.Timestamp
+	34, // 0: User.CreatedAt:type_name -> google.protobuf.Timestamp
 	0,  // 1: UserWithRole.user:type_name -> User
 	0,  // 2: GetAllCustomersResponse.users:type_name -> User
 	1,  // 3: GetAllUsersResponse.users:type_name -> UserWithRole
 	1,  // 4: EditUserResponse.user:type_name -> UserWithRole
 	0,  // 5: GetCustomersPaginatedResponse.users:type_name -> User
 	0,  // 6: GetCustomersByNameResponse.users:type_name -> User
-	4,  // 7: UserService.Register:input_type -> RegisterRequest
-	6,  // 8: UserService.Login:input_type -> LoginRequest
-	8,  // 9: UserService.ChangeInfo:input_type -> ChangeInfoRequest
-	10, // 10: UserService.ChangePassword:input_type -> ChangePasswordRequest
-	12, // 11: UserService.GetUserInfo:input_type -> GetUserInfoRequest
-	13, // 12: UserService.GetUserInfoByEmail:input_type -> GetUserInfoByEmailRequest
-	14, // 13: UserService.VerifyEmail:input_type -> VerifyEmailRequest
-	16, // 14: UserService.ForgotPassword:input_type -> ForgotPasswordRequest
-	17, // 15: UserService.ResetPassword:input_type -> ResetPasswordRequest
-	20, // 16: UserService.GetAllCustomers:input_type -> GetAllCustomersRequest
-	26, // 17: UserService.GetCustomersPaginated:input_type -> GetCustomersPaginatedRequest
-	28, // 18: UserService.GetCustomersByName:input_type -> GetCustomersByNameRequest
-	30, // 19: UserService.GetBranchByEmployeeID:input_type -> GetBranchByEmployeeIDRequest
-	2,  // 20: UserService.CreateUser:input_type -> CreateUserRequest
-	21, // 21: UserService.GetAllUsers:input_type -> GetAllUsersRequest
-	24, // 22: UserService.EditUser:input_type -> EditUserRequest
-	5,  // 23: UserService.Register:output_type -> RegisterResponse
-	7,  // 24: UserService.Login:output_type -> LoginResponse
-	9,  // 25: UserService.ChangeInfo:output_type -> ChangeInfoResponse
-	11, // 26: UserService.ChangePassword:output_type -> ChangePasswordResponse
-	0,  // 27: UserService.GetUserInfo:output_type -> User
-	0,  // 28: UserService.GetUserInfoByEmail:output_type -> User
-	15, // 29: UserService.VerifyEmail:output_type -> VerifyEmailResponse
-	19, // 30: UserService.ForgotPassword:output_type -> ForgotPasswordResponse
-	18, // 31: UserService.ResetPassword:output_type -> ResetPasswordResponse
-	22, // 32: UserService.GetAllCustomers:output_type -> GetAllCustomersResponse
-	27, // 33: UserService.GetCustomersPaginated:output_type -> GetCustomersPaginatedResponse
-	29, // 34: UserService.GetCustomersByName:output_type -> GetCustomersByNameResponse
-	31, // 35: UserService.GetBranchByEmployeeID:output_type -> GetBranchByEmployeeIDResponse
-	3,  // 36: UserService.CreateUser:output_type -> CreateUserResponse
-	23, // 37: UserService.GetAllUsers:output_type -> GetAllUsersResponse
-	25, // 38: UserService.EditUser:output_type -> EditUserResponse
-	23, // [23:39] is the sub-list for method output_type
-	7,  // [7:23] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	0,  // 7: GetUsersByIDsResponse.users:type_name -> User
+	4,  // 8: UserService.Register:input_type -> RegisterRequest
+	6,  // 9: UserService.Login:input_type -> LoginRequest
+	8,  // 10: UserService.ChangeInfo:input_type -> ChangeInfoRequest
+	10, // 11: UserService.ChangePassword:input_type -> ChangePasswordRequest
+	12, // 12: UserService.GetUserInfo:input_type -> GetUserInfoRequest
+	13, // 13: UserService.GetUserInfoByEmail:input_type -> GetUserInfoByEmailRequest
+	14, // 14: UserService.VerifyEmail:input_type -> VerifyEmailRequest
+	16, // 15: UserService.ForgotPassword:input_type -> ForgotPasswordRequest
+	17, // 16: UserService.ResetPassword:input_type -> ResetPasswordRequest
+	20, // 17: UserService.GetAllCustomers:input_type -> GetAllCustomersRequest
+	26, // 18: UserService.GetCustomersPaginated:input_type -> GetCustomersPaginatedRequest
+	28, // 19: UserService.GetCustomersByName:input_type -> GetCustomersByNameRequest
+	30, // 20: UserService.GetBranchByEmployeeID:input_type -> GetBranchByEmployeeIDRequest
+	2,  // 21: UserService.CreateUser:input_type -> CreateUserRequest
+	21, // 22: UserService.GetAllUsers:input_type -> GetAllUsersRequest
+	24, // 23: UserService.EditUser:input_type -> EditUserRequest
+	32, // 24: UserService.GetUsersByIDs:input_type -> GetUsersByIDsRequest
+	5,  // 25: UserService.Register:output_type -> RegisterResponse
+	7,  // 26: UserService.Login:output_type -> LoginResponse
+	9,  // 27: UserService.ChangeInfo:output_type -> ChangeInfoResponse
+	11, // 28: UserService.ChangePassword:output_type -> ChangePasswordResponse
+	0,  // 29: UserService.GetUserInfo:output_type -> User
+	0,  // 30: UserService.GetUserInfoByEmail:output_type -> User
+	15, // 31: UserService.VerifyEmail:output_type -> VerifyEmailResponse
+	19, // 32: UserService.ForgotPassword:output_type -> ForgotPasswordResponse
+	18, // 33: UserService.ResetPassword:output_type -> ResetPasswordResponse
+	22, // 34: UserService.GetAllCustomers:output_type -> GetAllCustomersResponse
+	27, // 35: UserService.GetCustomersPaginated:output_type -> GetCustomersPaginatedResponse
+	29, // 36: UserService.GetCustomersByName:output_type -> GetCustomersByNameResponse
+	31, // 37: UserService.GetBranchByEmployeeID:output_type -> GetBranchByEmployeeIDResponse
+	3,  // 38: UserService.CreateUser:output_type -> CreateUserResponse
+	23, // 39: UserService.GetAllUsers:output_type -> GetAllUsersResponse
+	25, // 40: UserService.EditUser:output_type -> EditUserResponse
+	33, // 41: UserService.GetUsersByIDs:output_type -> GetUsersByIDsResponse
+	25, // [25:42] is the sub-list for method output_type
+	8,  // [8:25] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_users_proto_init() }
@@ -2076,7 +2182,7 @@ func file_users_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_users_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,48 @@
+package pricing
+
+import "time"
+
+// Change - Một lần đổi giá trong lịch sử giá của sản phẩm / dịch vụ. Giá hẹn trước có AppliedAt = nil cho tới khi
+// bộ hẹn giờ áp dụng; PreviousPrice là giá ngay trước đó, ghi lúc áp dụng. Skipped là giá hẹn trước không áp
+// dụng (sản phẩm / dịch vụ đã bị xoá, hoặc giá đã được sửa sau thời điểm hiệu lực), không còn ảnh hưởng tới giá.
+type Change struct {
+	Price         float32
+	PreviousPrice float32
+	EffectiveAt   time.Time
+	AppliedAt     *time.Time
+	Skipped       bool
+}
+
+// Record - Bản ghi lịch sử giá của từng service (PriceChange, ServicePriceChange...)
+type Record interface {
+	PriceChange() Change
+}
+
+// PriceAt tính giá tại thời điểm at. changes sắp theo EffectiveAt tăng dần, current là giá đang lưu.
+//   - Giá hẹn trước có hiệu lực muộn nhất <= at thắng, kể cả khi bộ hẹn giờ chưa kịp áp dụng, trừ khi có
+//     thay đổi đã áp dụng nằm giữa thời điểm hiệu lực của nó và at (bộ hẹn giờ sẽ bỏ qua giá hẹn trước đó).
+//   - Ngược lại, thay đổi đã áp dụng đầu tiên sau at cho biết giá trước nó (PreviousPrice), chính là giá tại at.
+//   - Không có thay đổi nào sau at thì giá tại at là giá hiện tại.
+func PriceAt[R Record](current float32, records []R, at time.Time) float32 {
+	var scheduled *Change
+	for _, r := range records {
+		c := r.PriceChange()
+		if c.Skipped || c.EffectiveAt.After(at) {
+			continue
+		}
+		if c.AppliedAt == nil {
+			scheduled = &c
+		} else if scheduled != nil && c.EffectiveAt.After(scheduled.EffectiveAt) {
+			scheduled = nil
+		}
+	}
+	if scheduled != nil {
+		return scheduled.Price
+	}
+	for _, r := range records {
+		if c := r.PriceChange(); c.AppliedAt != nil && c.EffectiveAt.After(at) {
+			return c.PreviousPrice
+		}
+	}
+	return current
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/commons/auth"
+	pb "github.com/quanbin27/commons/genproto/appointments"
+)
+
+// agendaEmployeeID - nhân viên xem lịch của chính mình, admin (role 3) xem được của nhân viên employee_id
+func agendaEmployeeID(c echo.Context) (int32, error) {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	if role, _ := c.Get("role").(int); role == 3 {
+		requested, err := optionalInt32Query(c, "employee_id")
+		if err != nil {
+			return 0, err
+		}
+		if requested > 0 {
+			return requested, nil
+		}
+	}
+	return userID, nil
+}
+
+// GetEmployeeAgenda returns an employee's appointments for a date range
+// @Summary Get an employee's agenda
+// @Description Returns the employee's active appointments (not cancelled or no-show) from from_date to to_date inclusive, ordered by time, with the customer's name, phone and address, the pets brought and the service names. Dates are in the time zone of the employee's branch (returned as timezone). Employees always get their own agenda; admins can pass employee_id. At most 31 days at once
+// @Tags Appointments
+// @Produce json
+// @Security BearerAuth
+// @Param from_date query string true "First day, YYYY-MM-DD"
+// @Param to_date query string false "Last day, YYYY-MM-DD (default from_date)"
+// @Param employee_id query int false "Employee ID, admins only (default yourself)"
+// @Success 200 {object} object{timezone=string,appointments=array{AgendaAppointmentResponse}} "Agenda"
+// @Failure 400 {object} object{error=string} "Invalid or too long date range"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/agenda [get]
+func (h *AppointmentHandler) GetEmployeeAgenda(c echo.Context) error {
+	employeeID, err := agendaEmployeeID(c)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.GetEmployeeAgenda(c.Request().Context(), &pb.GetEmployeeAgendaRequest{
+		EmployeeId: employeeID,
+		FromDate:   c.QueryParam("from_date"),
+		ToDate:     c.QueryParam("to_date"),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	appointments := make([]AgendaAppointmentResponse, len(resp.Appointments))
+	for i, a := range resp.Appointments {
+		appointments[i] = toAgendaAppointmentResponse(a)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"timezone":     resp.Timezone,
+		"appointments": appointments,
+	})
+}
+
+// GetEmployeeRoute suggests the order to visit an employee's home appointments on a day
+// @Summary Get an employee's home-visit route
+// @Description Suggests the order to visit the employee's active appointments on date, starting from the employee's branch, to keep the travel distance short (nearest neighbour improved with 2-opt). Distances are estimated from the customers' geocoded addresses and do not change the scheduled times; total_distance_km can be compared with scheduled_distance_km, the distance when visiting in scheduled order. Appointments whose address cannot be located are listed in unlocated. Employees always get their own route; admins can pass employee_id
+// @Tags Appointments
+// @Produce json
+// @Security BearerAuth
+// @Param date query string true "Day, YYYY-MM-DD in the branch's local time"
+// @Param employee_id query int false "Employee ID, admins only (default yourself)"
+// @Success 200 {object} object{timezone=string,starts_at_branch=boolean,total_distance_km=number,total_travel_minutes=integer,scheduled_distance_km=number,stops=array{RouteStopResponse},unlocated=array{AppointmentResponse}} "Route"
+// @Failure 400 {object} object{error=string} "Invalid date"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/route [get]
+func (h *AppointmentHandler) GetEmployeeRoute(c echo.Context) error {
+	employeeID, err := agendaEmployeeID(c)
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.GetEmployeeRoute(c.Request().Context(), &pb.GetEmployeeRouteRequest{
+		EmployeeId: employeeID,
+		Date:       c.QueryParam("date"),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"timezone":              resp.Timezone,
+		"starts_at_branch":      resp.StartsAtBranch,
+		"total_distance_km":     resp.TotalDistanceKm,
+		"total_travel_minutes":  resp.TotalTravelMinutes,
+		"scheduled_distance_km": resp.ScheduledDistanceKm,
+		"stops":                 toRouteStopResponses(resp.Stops),
+		"unlocated":             toAppointmentResponses(resp.Unlocated),
+	})
+}
@@ -31,15 +31,52 @@ func (h *AppointmentHandler) RegisterRoutes(e *echo.Group) {
 	e.GET("/appointments/customer/:customer_id", h.GetAppointmentsByCustomer)
 	e.GET("/appointments/branch/:branch_id", h.GetAppointmentsByBranch)
 	e.GET("/appointments/employee/:employee_id", h.GetAppointmentsByEmployee)
-	e.PUT("/appointments/update-status", h.UpdateAppointmentStatus)
+	e.PUT("/appointments/update-status", h.UpdateAppointmentStatus, auth.RoleMiddleware(1, 2, 3))
 	e.PUT("/appointments/update-employee", h.UpdateEmployeeForAppointment)
+	e.GET("/appointments/slots", h.GetAvailableSlots)
+	e.GET("/appointments/agenda", h.GetEmployeeAgenda, auth.RoleMiddleware(2, 3))
+	e.GET("/appointments/route", h.GetEmployeeRoute, auth.RoleMiddleware(2, 3))
 	e.GET("/appointments/:appointment_id", h.GetAppointmentDetails)
+	e.GET("/appointments/:appointment_id/history", h.GetAppointmentStatusHistory, auth.RoleMiddleware(1, 2, 3))
+	e.PUT("/appointments/:appointment_id/reschedule", h.RescheduleAppointment, auth.RoleMiddleware(1, 2, 3))
+	e.PUT("/appointments/:appointment_id/auto-assign", h.AutoAssignAppointment, auth.RoleMiddleware(2, 3))
+
+	// Routes cho lịch hẹn định kỳ
+	e.POST("/appointments/series", h.CreateAppointmentSeries, auth.RoleMiddleware(1, 2, 3))
+	e.GET("/appointments/series/:series_id", h.GetAppointmentSeries, auth.RoleMiddleware(1, 2, 3))
+	e.PUT("/appointments/series/:series_id", h.UpdateAppointmentSeries, auth.RoleMiddleware(1, 2, 3))
+	e.PUT("/appointments/series/:series_id/cancel", h.CancelAppointmentSeries, auth.RoleMiddleware(1, 2, 3))
+
+	// Routes cho ca làm việc
+	e.POST("/shifts", h.CreateEmployeeShift, auth.RoleMiddleware(2, 3))
+	e.GET("/shifts", h.ListEmployeeShifts, auth.RoleMiddleware(2, 3))
+	e.DELETE("/shifts/:id", h.DeleteEmployeeShift, auth.RoleMiddleware(2, 3))
+	e.POST("/shifts/templates", h.CreateShiftTemplate, auth.RoleMiddleware(3))
+	e.GET("/shifts/templates", h.ListShiftTemplates, auth.RoleMiddleware(2, 3))
+	e.DELETE("/shifts/templates/:id", h.DeleteShiftTemplate, auth.RoleMiddleware(3))
+	e.POST("/shifts/generate", h.GenerateShifts, auth.RoleMiddleware(3))
+
+	// Routes cho nghỉ phép
+	e.POST("/leaves", h.RequestLeave, auth.RoleMiddleware(2, 3))
+	e.GET("/leaves", h.ListLeaves, auth.RoleMiddleware(2, 3))
+	e.PUT("/leaves/:id/review", h.ReviewLeave, auth.RoleMiddleware(3))
+
+	// Routes cho kỹ năng nhân viên (dùng khi tự phân công)
+	e.PUT("/employees/:employee_id/skills", h.SetEmployeeSkills, auth.RoleMiddleware(3))
+	e.GET("/employees/:employee_id/skills", h.ListEmployeeSkills, auth.RoleMiddleware(2, 3))
 
 	// Routes cho dịch vụ
 	e.POST("/services", h.CreateService)
 	e.GET("/services", h.GetServices)
 	e.PUT("/services", h.UpdateService)
 	e.DELETE("/services/:service_id", h.DeleteService)
+	e.GET("/services/cache-stats", h.GetServiceCacheStats, auth.RoleMiddleware(3))
+	e.GET("/services/archived", h.ListArchivedServices, auth.RoleMiddleware(2, 3))
+	e.PUT("/services/:service_id/restore", h.RestoreService, auth.RoleMiddleware(2, 3))
+	e.GET("/services/:service_id/prices", h.ListServicePriceHistory, auth.RoleMiddleware(2, 3))
+	e.GET("/services/:service_id/prices/at", h.GetServicePriceAt)
+	e.POST("/services/:service_id/prices/scheduled", h.ScheduleServicePriceChange, auth.RoleMiddleware(3))
+	e.DELETE("/services/prices/scheduled/:id", h.CancelServicePriceChange, auth.RoleMiddleware(3))
 }
 
 // --- Lịch hẹn ---
@@ -47,21 +84,24 @@ func (h *AppointmentHandler) RegisterRoutes(e *echo.Group) {
 // CreateAppointment xử lý yêu cầu tạo lịch hẹn
 // CreateAppointment creates a new appointment
 // @Summary Create a new appointment
-// @Description Creates a new appointment with details like customer ID, address, scheduled time, services, note, and branch ID
+// @Description Creates a new appointment with details like customer ID, address, scheduled time, services, note, and branch ID. The scheduled time must be in the future, within branch opening hours for the whole duration of the services, and the branch must have room (see GET /appointments/slots). employee_id optionally books a specific staff member, who must be free at that time; without it a free employee is assigned automatically when possible (employee_id 0 in the response means nobody could be assigned). pet_ids lists the customer's pets (from the records service) brought to the appointment; a service can name the pet it is for with pet_id, which is added to pet_ids automatically. Every pet must belong to the customer. Automatic promotions and the given coupon codes are applied and stored on the appointment
 // @Tags Appointments
 // @Accept json
 // @Produce json
 // @Security BearerAuth
-// @Param request body object{customer_id=integer,customer_address=string,scheduled_time=string,services=array{service_id=integer,quantity=integer},note=string,branch_id=integer} true "Appointment details"
-// @Success 200 {object} object{appointment_id=integer,status=string} "Appointment created successfully"
-// @Failure 400 {object} object{error=string} "Invalid request or invalid scheduled_time format (must be RFC3339)"
+// @Param request body object{customer_id=integer,customer_address=string,scheduled_time=string,services=array{service_id=integer,quantity=integer,pet_id=string},note=string,branch_id=integer,coupon_codes=array{string},employee_id=integer,pet_ids=array{string}} true "Appointment details"
+// @Success 200 {object} object{appointment_id=integer,status=string,employee_id=integer,subtotal=number,discount=number,total=number,applied_promotions=array{promotion_id=integer,code=string,name=string,discount=number}} "Appointment created successfully"
+// @Failure 400 {object} object{error=string} "Invalid request, invalid scheduled_time format (must be RFC3339), scheduled time in the past, unknown coupon code or pet not owned by the customer"
+// @Failure 409 {object} object{error=string} "Scheduled time outside opening hours, branch fully booked, employee busy or coupon cannot be used"
 // @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 503 {object} object{error=string} "Branch is busy with other bookings, retry"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /appointments [post]
 func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 	type ServiceItemReq struct {
-		ServiceId int32 `json:"service_id"`
-		Quantity  int32 `json:"quantity"`
+		ServiceId int32  `json:"service_id"`
+		Quantity  int32  `json:"quantity"`
+		PetID     string `json:"pet_id"`
 	}
 	var req struct {
 		CustomerID      int32            `json:"customer_id"`
@@ -70,6 +110,9 @@ func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 		Detail          []ServiceItemReq `json:"services"`
 		Note            string           `json:"note"`
 		BranchID        int32            `json:"branch_id"`
+		CouponCodes     []string         `json:"coupon_codes"`
+		EmployeeID      int32            `json:"employee_id"`
+		PetIDs          []string         `json:"pet_ids"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -87,6 +130,7 @@ func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 		pbItems[i] = &pb.AppointmentDetail{
 			Quantity:  item.Quantity,
 			ServiceId: item.ServiceId,
+			PetId:     item.PetID,
 		}
 	}
 	resp, err := h.client.CreateAppointment(ctx, &pb.CreateAppointmentRequest{
@@ -96,10 +140,21 @@ func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 		Detail:          pbItems,
 		Note:            req.Note,
 		BranchId:        req.BranchID,
+		CouponCodes:     req.CouponCodes,
+		EmployeeId:      req.EmployeeID,
+		PetIds:          req.PetIDs,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.InvalidArgument:
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": grpcErr.Message()})
+			case codes.NotFound:
+				return c.JSON(http.StatusNotFound, map[string]string{"error": grpcErr.Message()})
+			case codes.FailedPrecondition:
+				return c.JSON(http.StatusConflict, map[string]string{"error": grpcErr.Message()})
+			case codes.Unavailable:
+				return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": grpcErr.Message()})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": grpcErr.Message()})
 			}
@@ -108,8 +163,13 @@ func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"appointment_id": resp.AppointmentId,
-		"status":         resp.Status,
+		"appointment_id":     resp.AppointmentId,
+		"status":             resp.Status,
+		"employee_id":        resp.EmployeeId,
+		"subtotal":           resp.Subtotal,
+		"discount":           resp.Discount,
+		"total":              resp.Total,
+		"applied_promotions": resp.AppliedPromotions,
 	})
 }
 
@@ -274,19 +334,30 @@ func (h *AppointmentHandler) GetAllAppointments(c echo.Context) error {
 
 // UpdateAppointmentStatus updates the status of an appointment
 // @Summary Update appointment status
-// @Description Updates the status of an appointment for a specific appointment ID
+// @Description Moves an appointment along PENDING -> CONFIRMED -> IN_PROGRESS -> COMPLETED. A pending or confirmed appointment can be CANCELLED before its scheduled time (reason required) or marked NO_SHOW after it. Cancelling within the free cancellation deadline charges a late cancellation fee. When completing, create_examination creates an examination record in the records service for each pet of the appointment, pre-filled with the date, the assigned employee and the services; records that cannot be created are skipped. Every change is recorded in the appointment's history. Customers may only cancel their own appointments; cancelling releases the promotions used by the appointment
 // @Tags Appointments
 // @Accept json
 // @Produce json
-// @Param request body object{appointment_id=string,status=string} true "Appointment status update details"
-// @Success 200 {object} object{status=string} "Appointment status updated successfully"
-// @Failure 400 {object} object{error=string} "Invalid request, invalid appointment_id format, or invalid status"
+// @Security BearerAuth
+// @Param request body object{appointment_id=string,status=string,reason=string,create_examination=boolean} true "Appointment status update details"
+// @Success 200 {object} object{status=string,cancellation_fee=number,examination_ids=array{string}} "Appointment status updated successfully"
+// @Failure 400 {object} object{error=string} "Invalid request, invalid appointment_id format, invalid status, missing cancellation reason or create_examination when not completing"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 403 {object} object{error=string} "Customers can only cancel appointments"
+// @Failure 404 {object} object{error=string} "Appointment not found"
+// @Failure 409 {object} object{error=string} "Status change not allowed from the current status"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /appointments/update-status [put]
 func (h *AppointmentHandler) UpdateAppointmentStatus(c echo.Context) error {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
 	var req struct {
-		AppointmentID string `json:"appointment_id"`
-		Status        string `json:"status"`
+		AppointmentID     string `json:"appointment_id"`
+		Status            string `json:"status"`
+		Reason            string `json:"reason"`
+		CreateExamination bool   `json:"create_examination"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -300,41 +371,147 @@ func (h *AppointmentHandler) UpdateAppointmentStatus(c echo.Context) error {
 
 	// Kiểm tra và chuyển đổi status sang pb.AppointmentStatus
 	pbStatus, ok := pb.AppointmentStatus_value[req.Status]
-	if !ok {
+	if !ok || pbStatus == int32(pb.AppointmentStatus_UNSPECIFIED) {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid status value"})
 	}
 
+	// Khách hàng (role 1) chỉ huỷ được lịch của mình
+	var customerID int32
+	if role, _ := c.Get("role").(int); role == 1 {
+		if pb.AppointmentStatus(pbStatus) != pb.AppointmentStatus_CANCELLED {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "Customers can only cancel appointments"})
+		}
+		customerID = userID
+	}
+
 	// Lấy context từ Echo request
 	ctx := c.Request().Context()
 
 	resp, err := h.client.UpdateAppointmentStatus(ctx, &pb.UpdateAppointmentStatusRequest{
-		AppointmentId: int32(appointmentID),
-		Status:        pb.AppointmentStatus(pbStatus),
+		AppointmentId:     int32(appointmentID),
+		Status:            pb.AppointmentStatus(pbStatus),
+		Reason:            req.Reason,
+		ChangedBy:         userID,
+		CreateExamination: req.CreateExamination,
+		CustomerId:        customerID,
 	})
 	if err != nil {
-		if grpcErr, ok := status.FromError(err); ok {
-			switch grpcErr.Code() {
-			case codes.Internal:
-				return c.JSON(http.StatusInternalServerError, map[string]string{"error": grpcErr.Message()})
-			}
-		}
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return grpcErrorToHTTP(c, err)
 	}
 
-	return c.JSON(http.StatusOK, map[string]string{
-		"status": resp.Status,
+	examinationIDs := resp.ExaminationIds
+	if examinationIDs == nil {
+		examinationIDs = []string{}
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status":           resp.Status,
+		"cancellation_fee": resp.CancellationFee,
+		"examination_ids":  examinationIDs,
 	})
 }
 
+// RescheduleAppointment moves an appointment to another time
+// @Summary Reschedule an appointment
+// @Description Moves a pending or confirmed appointment that has not started yet to a new time, and optionally to another branch. The new slot must be bookable (see GET /appointments/slots). Prices booked originally are kept. The assigned employee is kept when still free, otherwise another employee is assigned automatically. An appointment can only be rescheduled a limited number of times. The customer and the employees involved are notified by email. Customers can only reschedule their own appointments
+// @Tags Appointments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param appointment_id path int true "Appointment ID"
+// @Param request body object{scheduled_time=string,branch_id=integer} true "New time (RFC3339) and branch (0 or omitted = keep)"
+// @Success 200 {object} object{status=string,appointment=AppointmentResponse} "Appointment rescheduled"
+// @Failure 400 {object} object{error=string} "Invalid appointment ID or scheduled time"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Appointment not found"
+// @Failure 409 {object} object{error=string} "Slot unavailable, appointment already started or reschedule limit reached"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/{appointment_id}/reschedule [put]
+func (h *AppointmentHandler) RescheduleAppointment(c echo.Context) error {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+	id, err := strconv.ParseInt(c.Param("appointment_id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	var req struct {
+		ScheduledTime string `json:"scheduled_time"`
+		BranchID      int32  `json:"branch_id"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	scheduledTime, err := time.Parse(time.RFC3339, req.ScheduledTime)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid scheduled_time, must be RFC3339"})
+	}
+	// Khách hàng (role 1) chỉ dời được lịch của mình
+	var customerID int32
+	if role, _ := c.Get("role").(int); role == 1 {
+		customerID = userID
+	}
+	resp, err := h.client.RescheduleAppointment(c.Request().Context(), &pb.RescheduleAppointmentRequest{
+		AppointmentId: int32(id),
+		ScheduledTime: timestamppb.New(scheduledTime),
+		BranchId:      req.BranchID,
+		CustomerId:    customerID,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status":      resp.Status,
+		"appointment": toAppointmentResponse(resp.Appointment),
+	})
+}
+
+// GetAppointmentStatusHistory lists the status changes of an appointment
+// @Summary Get appointment status history
+// @Description Returns every status change of the appointment, oldest first, starting with its creation. Customers can only read the history of their own appointments
+// @Tags Appointments
+// @Produce json
+// @Security BearerAuth
+// @Param appointment_id path int true "Appointment ID"
+// @Success 200 {array} AppointmentStatusChangeResponse "Status history"
+// @Failure 400 {object} object{error=string} "Invalid appointment ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Appointment not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/{appointment_id}/history [get]
+func (h *AppointmentHandler) GetAppointmentStatusHistory(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("appointment_id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	// Khách hàng (role 1) chỉ xem được lịch của mình
+	var customerID int32
+	if role, _ := c.Get("role").(int); role == 1 {
+		if customerID, err = auth.GetUserIDFromContext(c); err != nil {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+		}
+	}
+	resp, err := h.client.GetAppointmentStatusHistory(c.Request().Context(), &pb.GetAppointmentStatusHistoryRequest{
+		AppointmentId: int32(id),
+		CustomerId:    customerID,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, toAppointmentStatusChangeResponses(resp.History))
+}
+
 // UpdateEmployeeForAppointment updates the employee assigned to an appointment
 // @Summary Update employee for appointment
-// @Description Updates the employee assigned to a specific appointment ID
+// @Description Updates the employee assigned to a specific appointment ID (0 unassigns). The employee must be on shift at the appointment's branch for its whole duration, not on approved leave, and must not have another appointment overlapping it
 // @Tags Appointments
 // @Accept json
 // @Produce json
 // @Param request body object{appointment_id=string,employee_id=string} true "Appointment employee update details"
 // @Success 200 {object} object{status=string} "Employee updated successfully"
 // @Failure 400 {object} object{error=string} "Invalid request, invalid appointment_id or employee_id format"
+// @Failure 404 {object} object{error=string} "Appointment not found"
+// @Failure 409 {object} object{error=string} "Employee not on shift at the branch, on leave or already has an overlapping appointment"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /appointments/update-employee [put]
 func (h *AppointmentHandler) UpdateEmployeeForAppointment(c echo.Context) error {
@@ -362,13 +539,7 @@ func (h *AppointmentHandler) UpdateEmployeeForAppointment(c echo.Context) error
 		EmployeeId:    int32(employeeID),
 	})
 	if err != nil {
-		if grpcErr, ok := status.FromError(err); ok {
-			switch grpcErr.Code() {
-			case codes.Internal:
-				return c.JSON(http.StatusInternalServerError, map[string]string{"error": grpcErr.Message()})
-			}
-		}
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return grpcErrorToHTTP(c, err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
@@ -448,7 +619,7 @@ func (h *AppointmentHandler) GetAppointmentDetails(c echo.Context) error {
 // @Tags Services
 // @Accept json
 // @Produce json
-// @Param request body object{name=string,description=string,price=number} true "Service details"
+// @Param request body object{name=string,description=string,price=number,imgurl=string,duration_minutes=integer} true "Service details, duration_minutes defaults to 30"
 // @Success 200 {object} object{service_id=integer,status=string} "Service created successfully"
 // @Failure 400 {object} object{error=string} "Invalid request"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -458,6 +629,8 @@ func (h *AppointmentHandler) CreateService(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		Duration    int32   `json:"duration_minutes"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -467,9 +640,11 @@ func (h *AppointmentHandler) CreateService(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	resp, err := h.client.CreateService(ctx, &pb.CreateServiceRequest{
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
+		Name:            req.Name,
+		Description:     req.Description,
+		Price:           req.Price,
+		Imgurl:          req.ImgURL,
+		DurationMinutes: req.Duration,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
@@ -487,12 +662,35 @@ func (h *AppointmentHandler) CreateService(c echo.Context) error {
 	})
 }
 
+// GetServiceCacheStats reports the hit ratio of the service list cache
+// @Summary Service list cache statistics
+// @Description Hits, misses and Redis errors of the service list cache since the Appointments service started
+// @Tags Services
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{hits=integer,misses=integer,errors=integer,hit_ratio=number} "Cache statistics"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /services/cache-stats [get]
+func (h *AppointmentHandler) GetServiceCacheStats(c echo.Context) error {
+	resp, err := h.client.GetCacheStats(c.Request().Context(), &pb.GetCacheStatsRequest{})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"hits":      resp.Hits,
+		"misses":    resp.Misses,
+		"errors":    resp.Errors,
+		"hit_ratio": resp.HitRatio,
+	})
+}
+
 // GetServices retrieves all services
 // @Summary List all services
 // @Description Retrieves a list of all service records
 // @Tags Services
 // @Produce json
-// @Success 200 {array} object{id=integer,name=string,description=string,price=number,imgurl=string} "List of services"
+// @Success 200 {array} ServiceResponse "List of services"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /services [get]
 func (h *AppointmentHandler) GetServices(c echo.Context) error {
@@ -514,11 +712,12 @@ func (h *AppointmentHandler) GetServices(c echo.Context) error {
 	var services []ServiceResponse
 	for _, svc := range resp.Services {
 		services = append(services, ServiceResponse{
-			ServiceID:   svc.Id,
-			Name:        svc.Name,
-			Description: svc.Description,
-			Price:       svc.Price,
-			ImgURL:      svc.Imgurl,
+			ServiceID:       svc.Id,
+			Name:            svc.Name,
+			Description:     svc.Description,
+			Price:           svc.Price,
+			ImgURL:          svc.Imgurl,
+			DurationMinutes: svc.DurationMinutes,
 		})
 	}
 
@@ -531,7 +730,7 @@ func (h *AppointmentHandler) GetServices(c echo.Context) error {
 // @Tags Services
 // @Accept json
 // @Produce json
-// @Param request body object{service_id=string,name=string,description=string,price=number} true "Updated service details"
+// @Param request body object{service_id=string,name=string,description=string,price=number,imgurl=string,duration_minutes=integer} true "Updated service details, duration_minutes = 0 keeps the current duration"
 // @Success 200 {object} object{status=string} "Service updated successfully"
 // @Failure 400 {object} object{error=string} "Invalid request or invalid service_id format"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -542,6 +741,8 @@ func (h *AppointmentHandler) UpdateService(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		Duration    int32   `json:"duration_minutes"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -557,10 +758,12 @@ func (h *AppointmentHandler) UpdateService(c echo.Context) error {
 	ctx := c.Request().Context()
 
 	resp, err := h.client.UpdateService(ctx, &pb.UpdateServiceRequest{
-		ServiceId:   int32(serviceID),
-		Name:        req.Name,
-		Description: req.Description,
-		Price:       req.Price,
+		ServiceId:       int32(serviceID),
+		Name:            req.Name,
+		Description:     req.Description,
+		Price:           req.Price,
+		Imgurl:          req.ImgURL,
+		DurationMinutes: req.Duration,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
@@ -579,12 +782,13 @@ func (h *AppointmentHandler) UpdateService(c echo.Context) error {
 
 // DeleteService deletes a service
 // @Summary Delete a service
-// @Description Deletes a service by its unique ID
+// @Description Archives a service: it is hidden from the service list and cannot be booked, but past appointment details still show it. Use PUT /services/{service_id}/restore to bring it back
 // @Tags Services
 // @Produce json
 // @Param service_id path int true "Service ID"
 // @Success 200 {object} object{status=string} "Service deleted successfully"
 // @Failure 400 {object} object{error=string} "Service ID is required or invalid format"
+// @Failure 404 {object} object{error=string} "Service not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /services/{service_id} [delete]
 func (h *AppointmentHandler) DeleteService(c echo.Context) error {
@@ -606,6 +810,8 @@ func (h *AppointmentHandler) DeleteService(c echo.Context) error {
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.NotFound:
+				return c.JSON(http.StatusNotFound, map[string]string{"error": grpcErr.Message()})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": grpcErr.Message()})
 			}
@@ -617,3 +823,57 @@ func (h *AppointmentHandler) DeleteService(c echo.Context) error {
 		"status": resp.Status,
 	})
 }
+
+// ListArchivedServices lists deleted (archived) services
+// @Summary List archived services
+// @Description Retrieves the services that were deleted. They cannot be booked but still appear in past appointment details
+// @Tags Services
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} ServiceResponse "List of archived services"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /services/archived [get]
+func (h *AppointmentHandler) ListArchivedServices(c echo.Context) error {
+	resp, err := h.client.ListArchivedServices(c.Request().Context(), &pb.GetServicesRequest{})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	services := make([]ServiceResponse, 0, len(resp.Services))
+	for _, svc := range resp.Services {
+		services = append(services, ServiceResponse{
+			ServiceID:       svc.Id,
+			Name:            svc.Name,
+			Description:     svc.Description,
+			Price:           svc.Price,
+			ImgURL:          svc.Imgurl,
+			DurationMinutes: svc.DurationMinutes,
+		})
+	}
+	return c.JSON(http.StatusOK, services)
+}
+
+// RestoreService restores a deleted (archived) service
+// @Summary Restore an archived service
+// @Description Makes a deleted service bookable again
+// @Tags Services
+// @Produce json
+// @Security BearerAuth
+// @Param service_id path int true "Service ID"
+// @Success 200 {object} object{status=string} "Service restored"
+// @Failure 400 {object} object{error=string} "Invalid service ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Service not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /services/{service_id}/restore [put]
+func (h *AppointmentHandler) RestoreService(c echo.Context) error {
+	serviceID, err := strconv.ParseInt(c.Param("service_id"), 10, 32)
+	if err != nil || serviceID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid service_id format, must be a positive integer"})
+	}
+	resp, err := h.client.RestoreService(c.Request().Context(), &pb.RestoreServiceRequest{ServiceId: int32(serviceID)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
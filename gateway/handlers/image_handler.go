@@ -0,0 +1,471 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/BEPetCare-gateway/storage"
+	"github.com/quanbin27/commons/auth"
+	pbAppointments "github.com/quanbin27/commons/genproto/appointments"
+	pbProducts "github.com/quanbin27/commons/genproto/products"
+)
+
+const (
+	maxImageSize       = 5 << 20 // 5MB mỗi ảnh
+	maxImagesPerUpload = 10
+	thumbnailMaxSide   = 320
+	// Số điểm ảnh tối đa, chặn ảnh nén nhỏ nhưng giải nén ra rất lớn (decompression bomb)
+	maxImagePixels = 40_000_000
+)
+
+// Định dạng ảnh được chấp nhận, theo content type phát hiện từ nội dung file (không tin header của client)
+var allowedImageTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type ImageHandler struct {
+	productClient     pbProducts.ProductServiceClient
+	appointmentClient pbAppointments.AppointmentServiceClient
+	blobs             storage.BlobStore
+}
+
+func NewImageHandler(productClient pbProducts.ProductServiceClient, appointmentClient pbAppointments.AppointmentServiceClient, blobs storage.BlobStore) *ImageHandler {
+	return &ImageHandler{productClient: productClient, appointmentClient: appointmentClient, blobs: blobs}
+}
+
+// RegisterRoutes đăng ký các route upload / quản lý ảnh sản phẩm và dịch vụ
+func (h *ImageHandler) RegisterRoutes(e *echo.Group) {
+	e.POST("/images/products/:product_type/:id", h.UploadProductImages, auth.RoleMiddleware(2, 3))
+	e.GET("/images/products/:product_type/:id", h.ListProductImages)
+	e.DELETE("/images/products/:image_id", h.DeleteProductImage, auth.RoleMiddleware(2, 3))
+
+	e.POST("/images/services/:service_id", h.UploadServiceImages, auth.RoleMiddleware(2, 3))
+	e.GET("/images/services/:service_id", h.ListServiceImages)
+	e.DELETE("/images/services/:image_id", h.DeleteServiceImage, auth.RoleMiddleware(2, 3))
+}
+
+// storedImage - Ảnh gốc và thumbnail đã lưu vào blob store
+type storedImage struct {
+	URL          string
+	ThumbnailURL string
+	StorageKey   string
+	ThumbnailKey string
+}
+
+// uploadedImage - Ảnh đã đọc và kiểm tra xong, chưa lưu
+type uploadedImage struct {
+	data        []byte
+	contentType string
+	ext         string
+	img         image.Image
+}
+
+// readImages đọc và kiểm tra các file trong field "images" của multipart form.
+// Lỗi trả về là lỗi của client (400).
+func readImages(c echo.Context) ([]uploadedImage, error) {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, fmt.Errorf("invalid multipart form")
+	}
+	files := form.File["images"]
+	if len(files) == 0 {
+		return nil, fmt.Errorf("at least one file is required in field 'images'")
+	}
+	if len(files) > maxImagesPerUpload {
+		return nil, fmt.Errorf("at most %d images per upload", maxImagesPerUpload)
+	}
+	images := make([]uploadedImage, 0, len(files))
+	for _, fh := range files {
+		img, err := readImage(fh)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", fh.Filename, err)
+		}
+		images = append(images, img)
+	}
+	return images, nil
+}
+
+func readImage(fh *multipart.FileHeader) (uploadedImage, error) {
+	if fh.Size > maxImageSize {
+		return uploadedImage{}, fmt.Errorf("file exceeds %d MB", maxImageSize>>20)
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return uploadedImage{}, fmt.Errorf("cannot read file")
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxImageSize+1))
+	if err != nil {
+		return uploadedImage{}, fmt.Errorf("cannot read file")
+	}
+	if len(data) > maxImageSize {
+		return uploadedImage{}, fmt.Errorf("file exceeds %d MB", maxImageSize>>20)
+	}
+	contentType := http.DetectContentType(data)
+	ext, ok := allowedImageTypes[contentType]
+	if !ok {
+		return uploadedImage{}, fmt.Errorf("unsupported image type %s", contentType)
+	}
+	// Đọc kích thước từ header trước khi giải nén cả ảnh
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return uploadedImage{}, fmt.Errorf("corrupted image")
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > maxImagePixels {
+		return uploadedImage{}, fmt.Errorf("image dimensions %dx%d exceed %d megapixels", cfg.Width, cfg.Height, maxImagePixels/1_000_000)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return uploadedImage{}, fmt.Errorf("corrupted image")
+	}
+	return uploadedImage{data: data, contentType: contentType, ext: ext, img: img}, nil
+}
+
+// thumbnail thu nhỏ ảnh để cạnh dài nhất không quá maxSide, lấy trung bình các điểm ảnh trong mỗi ô
+func thumbnail(src image.Image, maxSide int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSide && h <= maxSide {
+		return src
+	}
+	dw, dh := maxSide, maxSide
+	if w > h {
+		dh = max(1, h*maxSide/w)
+	} else {
+		dw = max(1, w*maxSide/h)
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := b.Min.Y+y*h/dh, b.Min.Y+(y+1)*h/dh
+		for x := 0; x < dw; x++ {
+			x0, x1 := b.Min.X+x*w/dw, b.Min.X+(x+1)*w/dw
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, bl, a = r+uint64(pr), g+uint64(pg), bl+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			off := dst.PixOffset(x, y)
+			dst.Pix[off] = uint8(r / n >> 8)
+			dst.Pix[off+1] = uint8(g / n >> 8)
+			dst.Pix[off+2] = uint8(bl / n >> 8)
+			dst.Pix[off+3] = uint8(a / n >> 8)
+		}
+	}
+	return dst
+}
+
+// encodeThumbnail giữ PNG cho ảnh PNG (có thể trong suốt), các định dạng khác lưu JPEG
+func encodeThumbnail(img image.Image, contentType string) ([]byte, string, string, error) {
+	var buf bytes.Buffer
+	if contentType == "image/png" {
+		if err := png.Encode(&buf, img); err != nil {
+			return nil, "", "", err
+		}
+		return buf.Bytes(), "image/png", ".png", nil
+	}
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, "", "", err
+	}
+	return buf.Bytes(), "image/jpeg", ".jpg", nil
+}
+
+func randomName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// store lưu ảnh gốc và thumbnail dưới prefix, lỗi giữa chừng thì dọn các file đã lưu
+func (h *ImageHandler) store(ctx context.Context, prefix string, u uploadedImage) (*storedImage, error) {
+	name, err := randomName()
+	if err != nil {
+		return nil, err
+	}
+	thumbData, thumbType, thumbExt, err := encodeThumbnail(thumbnail(u.img, thumbnailMaxSide), u.contentType)
+	if err != nil {
+		return nil, err
+	}
+	stored := &storedImage{
+		StorageKey:   prefix + "/" + name + u.ext,
+		ThumbnailKey: prefix + "/" + name + "_thumb" + thumbExt,
+	}
+	if stored.URL, err = h.blobs.Put(ctx, stored.StorageKey, bytes.NewReader(u.data), u.contentType); err != nil {
+		return nil, err
+	}
+	if stored.ThumbnailURL, err = h.blobs.Put(ctx, stored.ThumbnailKey, bytes.NewReader(thumbData), thumbType); err != nil {
+		h.blobs.Delete(ctx, stored.StorageKey)
+		return nil, err
+	}
+	return stored, nil
+}
+
+// storeAll lưu tất cả ảnh, lỗi giữa chừng thì dọn các ảnh đã lưu
+func (h *ImageHandler) storeAll(ctx context.Context, prefix string, images []uploadedImage) ([]*storedImage, error) {
+	stored := make([]*storedImage, 0, len(images))
+	for _, u := range images {
+		s, err := h.store(ctx, prefix, u)
+		if err != nil {
+			h.removeStored(ctx, stored)
+			return nil, err
+		}
+		stored = append(stored, s)
+	}
+	return stored, nil
+}
+
+func (h *ImageHandler) removeStored(ctx context.Context, stored []*storedImage) {
+	for _, s := range stored {
+		h.removeBlobs(ctx, s.StorageKey, s.ThumbnailKey)
+	}
+}
+
+func (h *ImageHandler) removeBlobs(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := h.blobs.Delete(ctx, key); err != nil {
+			log.Printf("failed to delete blob %s: %v", key, err)
+		}
+	}
+}
+
+// --- Ảnh sản phẩm ---
+
+// UploadProductImages uploads images for a product
+// @Summary Upload product images
+// @Description Uploads one or more images (JPEG, PNG or GIF, max 5MB and 40 megapixels each, max 10 per request) for a product. Thumbnails are generated automatically; the first image of a product becomes its primary image (imgurl). Either all images are saved or none
+// @Tags Images
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param product_type path string true "Product type (FOOD, ACCESSORY, MEDICINE)"
+// @Param id path int true "Product ID"
+// @Param images formData file true "Image files"
+// @Param is_primary formData bool false "Make the first uploaded image the primary image"
+// @Success 200 {array} object{id=integer,product_id=integer,product_type=string,url=string,thumbnail_url=string,position=integer,is_primary=boolean} "Uploaded images"
+// @Failure 400 {object} object{error=string} "Invalid product, file type or size"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Product not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /images/products/{product_type}/{id} [post]
+func (h *ImageHandler) UploadProductImages(c echo.Context) error {
+	productType := strings.ToLower(c.Param("product_type"))
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || productID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+	if productType != "food" && productType != "accessory" && productType != "medicine" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product type"})
+	}
+	images, err := readImages(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	isPrimary := c.FormValue("is_primary") == "true"
+
+	ctx := c.Request().Context()
+	prefix := fmt.Sprintf("products/%s/%d", productType, productID)
+	stored, err := h.storeAll(ctx, prefix, images)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to store image"})
+	}
+	// Lưu metadata cả lô trong một transaction: lỗi thì không ảnh nào được thêm và dọn hết file
+	req := &pbProducts.AddProductImagesRequest{}
+	for i, s := range stored {
+		req.Images = append(req.Images, &pbProducts.AddProductImageRequest{
+			ProductId:    int32(productID),
+			ProductType:  productType,
+			Url:          s.URL,
+			ThumbnailUrl: s.ThumbnailURL,
+			StorageKey:   s.StorageKey,
+			ThumbnailKey: s.ThumbnailKey,
+			IsPrimary:    isPrimary && i == 0,
+		})
+	}
+	resp, err := h.productClient.AddProductImages(ctx, req)
+	if err != nil {
+		h.removeStored(ctx, stored)
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Images)
+}
+
+// ListProductImages lists images of a product
+// @Summary List product images
+// @Description Retrieves all images of a product ordered by position
+// @Tags Images
+// @Produce json
+// @Param product_type path string true "Product type (FOOD, ACCESSORY, MEDICINE)"
+// @Param id path int true "Product ID"
+// @Success 200 {array} object{id=integer,product_id=integer,product_type=string,url=string,thumbnail_url=string,position=integer,is_primary=boolean} "List of images"
+// @Failure 400 {object} object{error=string} "Invalid product ID or type"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /images/products/{product_type}/{id} [get]
+func (h *ImageHandler) ListProductImages(c echo.Context) error {
+	productID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product ID"})
+	}
+	resp, err := h.productClient.ListProductImages(c.Request().Context(), &pbProducts.ListProductImagesRequest{
+		ProductId:   int32(productID),
+		ProductType: c.Param("product_type"),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Images)
+}
+
+// DeleteProductImage deletes a product image
+// @Summary Delete a product image
+// @Description Deletes a product image and its stored files. If it was the primary image, the next image becomes primary
+// @Tags Images
+// @Produce json
+// @Security BearerAuth
+// @Param image_id path int true "Image ID"
+// @Success 200 {object} object{status=string} "Image deleted successfully"
+// @Failure 400 {object} object{error=string} "Invalid image ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Image not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /images/products/{image_id} [delete]
+func (h *ImageHandler) DeleteProductImage(c echo.Context) error {
+	imageID, err := strconv.ParseInt(c.Param("image_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid image ID"})
+	}
+	ctx := c.Request().Context()
+	resp, err := h.productClient.DeleteProductImage(ctx, &pbProducts.DeleteProductImageRequest{Id: int32(imageID)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	h.removeBlobs(ctx, resp.StorageKey, resp.ThumbnailKey)
+	return c.JSON(http.StatusOK, map[string]string{"status": "Success"})
+}
+
+// --- Ảnh dịch vụ ---
+
+// UploadServiceImages uploads images for a service
+// @Summary Upload service images
+// @Description Uploads one or more images (JPEG, PNG or GIF, max 5MB and 40 megapixels each, max 10 per request) for a service. Thumbnails are generated automatically; the first image of a service becomes its primary image (imgurl). Either all images are saved or none
+// @Tags Images
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param service_id path int true "Service ID"
+// @Param images formData file true "Image files"
+// @Param is_primary formData bool false "Make the first uploaded image the primary image"
+// @Success 200 {array} object{id=integer,service_id=integer,url=string,thumbnail_url=string,position=integer,is_primary=boolean} "Uploaded images"
+// @Failure 400 {object} object{error=string} "Invalid file type or size"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Service not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /images/services/{service_id} [post]
+func (h *ImageHandler) UploadServiceImages(c echo.Context) error {
+	serviceID, err := strconv.ParseInt(c.Param("service_id"), 10, 32)
+	if err != nil || serviceID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid service ID"})
+	}
+	images, err := readImages(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	isPrimary := c.FormValue("is_primary") == "true"
+
+	ctx := c.Request().Context()
+	prefix := fmt.Sprintf("services/%d", serviceID)
+	stored, err := h.storeAll(ctx, prefix, images)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to store image"})
+	}
+	req := &pbAppointments.AddServiceImagesRequest{}
+	for i, s := range stored {
+		req.Images = append(req.Images, &pbAppointments.AddServiceImageRequest{
+			ServiceId:    int32(serviceID),
+			Url:          s.URL,
+			ThumbnailUrl: s.ThumbnailURL,
+			StorageKey:   s.StorageKey,
+			ThumbnailKey: s.ThumbnailKey,
+			IsPrimary:    isPrimary && i == 0,
+		})
+	}
+	resp, err := h.appointmentClient.AddServiceImages(ctx, req)
+	if err != nil {
+		h.removeStored(ctx, stored)
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Images)
+}
+
+// ListServiceImages lists images of a service
+// @Summary List service images
+// @Description Retrieves all images of a service ordered by position
+// @Tags Images
+// @Produce json
+// @Param service_id path int true "Service ID"
+// @Success 200 {array} object{id=integer,service_id=integer,url=string,thumbnail_url=string,position=integer,is_primary=boolean} "List of images"
+// @Failure 400 {object} object{error=string} "Invalid service ID"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /images/services/{service_id} [get]
+func (h *ImageHandler) ListServiceImages(c echo.Context) error {
+	serviceID, err := strconv.ParseInt(c.Param("service_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid service ID"})
+	}
+	resp, err := h.appointmentClient.ListServiceImages(c.Request().Context(), &pbAppointments.ListServiceImagesRequest{
+		ServiceId: int32(serviceID),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Images)
+}
+
+// DeleteServiceImage deletes a service image
+// @Summary Delete a service image
+// @Description Deletes a service image and its stored files. If it was the primary image, the next image becomes primary
+// @Tags Images
+// @Produce json
+// @Security BearerAuth
+// @Param image_id path int true "Image ID"
+// @Success 200 {object} object{status=string} "Image deleted successfully"
+// @Failure 400 {object} object{error=string} "Invalid image ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Image not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /images/services/{image_id} [delete]
+func (h *ImageHandler) DeleteServiceImage(c echo.Context) error {
+	imageID, err := strconv.ParseInt(c.Param("image_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid image ID"})
+	}
+	ctx := c.Request().Context()
+	resp, err := h.appointmentClient.DeleteServiceImage(ctx, &pbAppointments.DeleteServiceImageRequest{ImageId: int32(imageID)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	h.removeBlobs(ctx, resp.StorageKey, resp.ThumbnailKey)
+	return c.JSON(http.StatusOK, map[string]string{"status": "Success"})
+}
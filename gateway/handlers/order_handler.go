@@ -37,13 +37,14 @@ func (h *OrderHandler) RegisterRoutes(e *echo.Group) {
 // CreateOrder xử lý yêu cầu tạo đơn hàng
 // CreateOrder creates a new order
 // @Summary Create a new order
-// @Description Creates a new order with details like customer ID, branch ID, appointment ID, items, and pickup time
+// @Description Creates a new order with details like customer ID, branch ID, appointment ID, items, and pickup time. Automatic promotions and the given coupon codes are applied and stored on the order
 // @Tags Orders
 // @Accept json
 // @Produce json
-// @Param request body object{customer_id=integer,branch_id=integer,appointment_id=integer,items=array{product_id=integer,product_type=string,quantity=integer,unit_price=number,product_name=string},pickup_time=string} true "Order details"
-// @Success 200 {object} object{order_id=integer,status=string} "Order created successfully"
-// @Failure 400 {object} object{error=string} "Invalid request"
+// @Param request body object{customer_id=integer,branch_id=integer,appointment_id=integer,items=array{product_id=integer,product_type=string,variant_id=integer,quantity=integer,unit_price=number,product_name=string},pickup_time=string,coupon_codes=array{string}} true "Order details"
+// @Success 200 {object} object{order_id=integer,status=string,subtotal=number,discount=number,total_price=number,applied_promotions=array{promotion_id=integer,code=string,name=string,discount=number}} "Order created successfully"
+// @Failure 400 {object} object{error=string} "Invalid request or unknown coupon code"
+// @Failure 409 {object} object{error=string} "Pickup time outside opening hours or coupon cannot be used"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /orders [post]
 func (h *OrderHandler) CreateOrder(c echo.Context) error {
@@ -51,6 +52,7 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 	type OrderItemReq struct {
 		ProductID   int32   `json:"product_id"`
 		ProductType string  `json:"product_type"`
+		VariantID   int32   `json:"variant_id"`
 		Quantity    int32   `json:"quantity"`
 		UnitPrice   float32 `json:"unit_price"`
 		ProductName string  `json:"product_name"`
@@ -61,6 +63,7 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 		AppointmentID int32          `json:"appointment_id"`
 		Items         []OrderItemReq `json:"items"`
 		PickupTime    string         `json:"pickup_time"`
+		CouponCodes   []string       `json:"coupon_codes"`
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -75,6 +78,7 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 			Quantity:    item.Quantity,
 			UnitPrice:   item.UnitPrice,
 			ProductType: item.ProductType,
+			VariantId:   item.VariantID,
 			ProductName: item.ProductName,
 		}
 	}
@@ -94,10 +98,17 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 		AppointmentId: req.AppointmentID,
 		Email:         user.Email,
 		PickupTime:    req.PickupTime,
+		CouponCodes:   req.CouponCodes,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.InvalidArgument:
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": grpcErr.Message()})
+			case codes.NotFound:
+				return c.JSON(http.StatusNotFound, map[string]string{"error": grpcErr.Message()})
+			case codes.FailedPrecondition:
+				return c.JSON(http.StatusConflict, map[string]string{"error": grpcErr.Message()})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": grpcErr.Message()})
 			}
@@ -106,8 +117,12 @@ func (h *OrderHandler) CreateOrder(c echo.Context) error {
 	}
 
 	return c.JSON(http.StatusOK, map[string]interface{}{
-		"order_id": resp.OrderId,
-		"status":   resp.Status,
+		"order_id":           resp.OrderId,
+		"status":             resp.Status,
+		"subtotal":           resp.Subtotal,
+		"discount":           resp.Discount,
+		"total_price":        resp.TotalPrice,
+		"applied_promotions": resp.AppliedPromotions,
 	})
 }
 
@@ -264,6 +279,9 @@ func (h *OrderHandler) GetOrderByAppointmentID(c echo.Context) error {
 // @Param request body object{order_id=string,status=string} true "Order statusSy status update details"
 // @Success 200 {object} object{status=string} "Order status updated successfully"
 // @Failure 400 {object} object{error=string} "Invalid request, invalid order_id format, or invalid status"
+// @Failure 404 {object} object{error=string} "Order not found"
+// @Failure 409 {object} object{error=string} "Order is already completed or cancelled, or its stock is no longer held"
+// @Failure 503 {object} object{error=string} "Status changed but stock settlement failed; it is retried automatically and repeating the request is safe"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /orders/update-status [put]
 func (h *OrderHandler) UpdateOrderStatus(c echo.Context) error {
@@ -295,13 +313,7 @@ func (h *OrderHandler) UpdateOrderStatus(c echo.Context) error {
 		Status:  pb.OrderStatus(pbStatus),
 	})
 	if err != nil {
-		if grpcErr, ok := status.FromError(err); ok {
-			switch grpcErr.Code() {
-			case codes.Internal:
-				return c.JSON(http.StatusInternalServerError, map[string]string{"error": grpcErr.Message()})
-			}
-		}
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return grpcErrorToHTTP(c, err)
 	}
 
 	return c.JSON(http.StatusOK, map[string]string{
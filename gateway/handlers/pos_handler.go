@@ -0,0 +1,263 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/commons/auth"
+	pbOrder "github.com/quanbin27/commons/genproto/orders"
+	pbPayment "github.com/quanbin27/commons/genproto/payments"
+	pbProduct "github.com/quanbin27/commons/genproto/products"
+	pbUser "github.com/quanbin27/commons/genproto/users"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PosHandler - Bán hàng tại quầy: quét mã SKU/mã vạch và lập đơn thanh toán tiền mặt ngay
+type PosHandler struct {
+	productClient pbProduct.ProductServiceClient
+	orderClient   pbOrder.OrderServiceClient
+	userClient    pbUser.UserServiceClient
+	paymentClient pbPayment.PaymentServiceClient
+}
+
+func NewPosHandler(productClient pbProduct.ProductServiceClient, orderClient pbOrder.OrderServiceClient,
+	userClient pbUser.UserServiceClient, paymentClient pbPayment.PaymentServiceClient) *PosHandler {
+	return &PosHandler{productClient: productClient, orderClient: orderClient, userClient: userClient, paymentClient: paymentClient}
+}
+
+// RegisterRoutes đăng ký các route bán hàng tại quầy với tiền tố "/pos", chỉ dành cho nhân viên
+func (h *PosHandler) RegisterRoutes(e *echo.Group) {
+	e.GET("/pos/products/:code", h.LookupProduct, auth.RoleMiddleware(2, 3))
+	e.POST("/pos/orders", h.CreatePosOrder, auth.RoleMiddleware(2, 3))
+}
+
+// staffBranch trả về chi nhánh của nhân viên đang đăng nhập; chỉ admin (role 3) được chọn chi nhánh khác qua branch_id
+func (h *PosHandler) staffBranch(c echo.Context) (int32, error) {
+	if role, _ := c.Get("role").(int); role == 3 {
+		if raw := c.QueryParam("branch_id"); raw != "" {
+			id, err := strconv.ParseInt(raw, 10, 32)
+			if err != nil || id <= 0 {
+				return 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid branch_id")
+			}
+			return int32(id), nil
+		}
+	}
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return 0, echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	resp, err := h.userClient.GetBranchByEmployeeID(c.Request().Context(), &pbUser.GetBranchByEmployeeIDRequest{EmployeeId: userID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, echo.NewHTTPError(http.StatusForbidden, "Staff member is not assigned to a branch")
+		}
+		log.Printf("resolve branch of employee %d: %v", userID, err)
+		return 0, echo.NewHTTPError(http.StatusInternalServerError, "Failed to resolve staff branch")
+	}
+	if resp.BranchId <= 0 {
+		return 0, echo.NewHTTPError(http.StatusForbidden, "Staff member is not assigned to a branch")
+	}
+	return resp.BranchId, nil
+}
+
+// lookupItem trả về biến thể (0 nếu mã thuộc sản phẩm gốc) và tên hiển thị trên hoá đơn
+func lookupItem(resp *pbProduct.ProductLookup) (int32, string) {
+	if resp.Variant == nil {
+		return 0, resp.Product.Name
+	}
+	return resp.Variant.Id, fmt.Sprintf("%s - %s", resp.Product.Name, resp.Variant.Name)
+}
+
+func lookupResponse(resp *pbProduct.ProductLookup) map[string]interface{} {
+	variantID, name := lookupItem(resp)
+	return map[string]interface{}{
+		"product_id":         resp.Product.ProductId,
+		"product_type":       resp.Product.ProductType,
+		"variant_id":         variantID,
+		"name":               name,
+		"sku":                resp.Sku,
+		"barcode":            resp.Barcode,
+		"price":              resp.Price,
+		"available_quantity": resp.Product.AvailableQuantity,
+		"imgurl":             resp.Product.Imgurl,
+	}
+}
+
+// LookupProduct looks up a product by SKU or barcode
+// @Summary Look up product by SKU or barcode
+// @Description Resolves a scanned SKU or barcode to a product (or one of its variants) with its selling price and live availability at the staff member's branch. Admins may pass branch_id to use another branch
+// @Tags POS
+// @Produce json
+// @Security BearerAuth
+// @Param code path string true "SKU or barcode"
+// @Param branch_id query int false "Branch ID, admin only (defaults to the staff member's branch)"
+// @Success 200 {object} object{product_id=int32,product_type=string,variant_id=int32,name=string,sku=string,barcode=string,price=number,available_quantity=int32,imgurl=string} "Product found"
+// @Failure 400 {object} object{error=string} "Invalid branch ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 403 {object} object{error=string} "Staff member is not assigned to a branch"
+// @Failure 404 {object} object{error=string} "No product matches the code"
+// @Failure 409 {object} object{error=string} "Code matches several products"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /pos/products/{code} [get]
+func (h *PosHandler) LookupProduct(c echo.Context) error {
+	branchID, err := h.staffBranch(c)
+	if err != nil {
+		return err
+	}
+	resp, err := h.productClient.GetProductByBarcode(c.Request().Context(), &pbProduct.GetProductByBarcodeRequest{
+		Code:     c.Param("code"),
+		BranchId: branchID,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, lookupResponse(resp))
+}
+
+// CreatePosOrder creates and settles a counter sale
+// @Summary Create a counter (POS) order
+// @Description Resolves each scanned code at the staff member's branch, creates the order (promotions and coupons apply as usual), records a completed cash payment and marks the order COMPLETED so the reserved stock is taken out immediately. If the payment step fails the order is cancelled and the stock released
+// @Tags POS
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param branch_id query int false "Branch ID, admin only (defaults to the staff member's branch)"
+// @Param request body object{customer_id=integer,items=array{code=string,quantity=integer},coupon_codes=array{string}} true "Scanned items"
+// @Success 200 {object} object{order_id=integer,payment_id=integer,status=string,subtotal=number,discount=number,total_price=number,applied_promotions=array{promotion_id=integer,code=string,name=string,discount=number}} "Order completed"
+// @Failure 400 {object} object{error=string} "Invalid request or unknown coupon code"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 403 {object} object{error=string} "Staff member is not assigned to a branch"
+// @Failure 404 {object} object{error=string} "Customer or scanned code not found"
+// @Failure 409 {object} object{error=string} "Not enough stock or coupon cannot be used"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /pos/orders [post]
+func (h *PosHandler) CreatePosOrder(c echo.Context) error {
+	var req struct {
+		CustomerID int32 `json:"customer_id"`
+		Items      []struct {
+			Code     string `json:"code"`
+			Quantity int32  `json:"quantity"`
+		} `json:"items"`
+		CouponCodes []string `json:"coupon_codes"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if req.CustomerID <= 0 || len(req.Items) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "customer_id and at least one item are required"})
+	}
+	branchID, err := h.staffBranch(c)
+	if err != nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	// Gộp các lần quét trùng sản phẩm / biến thể, vì mỗi dòng đơn hàng là duy nhất theo sản phẩm
+	type itemKey struct {
+		productType string
+		productID   int32
+		variantID   int32
+	}
+	var pbItems []*pbOrder.OrderItem
+	index := make(map[itemKey]int)
+	for _, item := range req.Items {
+		if item.Code == "" || item.Quantity <= 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Each item needs a code and a positive quantity"})
+		}
+		lookup, err := h.productClient.GetProductByBarcode(ctx, &pbProduct.GetProductByBarcodeRequest{Code: item.Code, BranchId: branchID})
+		if err != nil {
+			return grpcErrorToHTTP(c, err)
+		}
+		variantID, name := lookupItem(lookup)
+		key := itemKey{lookup.Product.ProductType, lookup.Product.ProductId, variantID}
+		if i, ok := index[key]; ok {
+			pbItems[i].Quantity += item.Quantity
+			continue
+		}
+		index[key] = len(pbItems)
+		pbItems = append(pbItems, &pbOrder.OrderItem{
+			ProductId:   key.productID,
+			ProductType: key.productType,
+			VariantId:   key.variantID,
+			Quantity:    item.Quantity,
+			UnitPrice:   lookup.Price,
+			ProductName: name,
+		})
+	}
+
+	customer, err := h.userClient.GetUserInfo(ctx, &pbUser.GetUserInfoRequest{ID: req.CustomerID})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	order, err := h.orderClient.CreateOrder(ctx, &pbOrder.CreateOrderRequest{
+		CustomerId:  req.CustomerID,
+		BranchId:    branchID,
+		Items:       pbItems,
+		Email:       customer.Email,
+		CouponCodes: req.CouponCodes,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+
+	paymentID, err := h.settleCash(ctx, order.OrderId, order.TotalPrice)
+	if err != nil {
+		// Huỷ đơn để trả lại hàng đang giữ, khách chưa bị thu tiền
+		if _, cancelErr := h.orderClient.UpdateOrderStatus(ctx, &pbOrder.UpdateOrderStatusRequest{
+			OrderId: order.OrderId,
+			Status:  pbOrder.OrderStatus_CANCELLED,
+		}); cancelErr != nil {
+			log.Printf("pos: cancel order %d after failed payment: %v", order.OrderId, cancelErr)
+		}
+		return grpcErrorToHTTP(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"order_id":           order.OrderId,
+		"payment_id":         paymentID,
+		"status":             pbOrder.OrderStatus_COMPLETED.String(),
+		"subtotal":           order.Subtotal,
+		"discount":           order.Discount,
+		"total_price":        order.TotalPrice,
+		"applied_promotions": order.AppliedPromotions,
+	})
+}
+
+// settleCash ghi nhận thanh toán tiền mặt đã hoàn tất rồi chuyển đơn sang COMPLETED (xuất kho phần hàng đang giữ)
+func (h *PosHandler) settleCash(ctx context.Context, orderID int32, amount float32) (int32, error) {
+	payment, err := h.paymentClient.CreatePayment(ctx, &pbPayment.CreatePaymentRequest{
+		OrderId:     orderID,
+		Amount:      amount,
+		Description: fmt.Sprintf("Thanh toán tại quầy đơn hàng %d", orderID),
+		Method:      pbPayment.PaymentMethod_CASH,
+	})
+	if err != nil {
+		return 0, err
+	}
+	// Unavailable: đơn đã COMPLETED, chỉ phần xuất kho chưa xong và được Orders service thử lại
+	if _, err := h.orderClient.UpdateOrderStatus(ctx, &pbOrder.UpdateOrderStatusRequest{
+		OrderId: orderID,
+		Status:  pbOrder.OrderStatus_COMPLETED,
+	}); err != nil && status.Code(err) != codes.Unavailable {
+		if _, cancelErr := h.paymentClient.UpdatePaymentStatus(ctx, &pbPayment.UpdatePaymentStatusRequest{
+			PaymentId: payment.PaymentId,
+			Status:    pbPayment.PaymentStatus_CANCELLED,
+		}); cancelErr != nil {
+			log.Printf("pos: cancel payment %d: %v", payment.PaymentId, cancelErr)
+		}
+		return 0, err
+	}
+	if _, err := h.paymentClient.UpdatePaymentStatus(ctx, &pbPayment.UpdatePaymentStatusRequest{
+		PaymentId: payment.PaymentId,
+		Status:    pbPayment.PaymentStatus_COMPLETED,
+	}); err != nil {
+		// Hàng đã xuất kho nên không huỷ đơn; chỉ ghi log để đối soát thủ công
+		log.Printf("pos: mark payment %d of order %d completed: %v", payment.PaymentId, orderID, err)
+	}
+	return payment.PaymentId, nil
+}
@@ -0,0 +1,296 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/commons/auth"
+	pbAppointment "github.com/quanbin27/commons/genproto/appointments"
+	pbProduct "github.com/quanbin27/commons/genproto/products"
+)
+
+// Lịch sử giá và giá hẹn trước của sản phẩm (ProductHandler) và dịch vụ (AppointmentHandler)
+
+// optionalInt32Query đọc query parameter kiểu int32, rỗng = 0
+func optionalInt32Query(c echo.Context, name string) (int32, error) {
+	raw := c.QueryParam(name)
+	if raw == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || v < 0 {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid "+name)
+	}
+	return int32(v), nil
+}
+
+// SchedulePriceChange schedules a future price for a product or variant
+// @Summary Schedule a product price change
+// @Description Schedules a new price for a product (variant_id = 0) or one of its variants. The price is applied automatically at effective_at; orders placed from then on use it
+// @Tags Prices
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{product_type=string,product_id=int32,variant_id=int32,price=number,effective_at=string,note=string} true "Price change, effective_at in RFC3339"
+// @Success 200 {object} object{id=int32,product_id=int32,product_type=string,variant_id=int32,price=number,effective_at=string,status=string,note=string} "Price change scheduled"
+// @Failure 400 {object} object{error=string} "Invalid price, product type or effective_at not in the future"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Product or variant not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /prices/products/scheduled [post]
+func (h *ProductHandler) SchedulePriceChange(c echo.Context) error {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	var req struct {
+		ProductType string  `json:"product_type"`
+		ProductID   int32   `json:"product_id"`
+		VariantID   int32   `json:"variant_id"`
+		Price       float32 `json:"price"`
+		EffectiveAt string  `json:"effective_at"`
+		Note        string  `json:"note"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.SchedulePriceChange(c.Request().Context(), &pbProduct.SchedulePriceChangeRequest{
+		ProductType: req.ProductType,
+		ProductId:   req.ProductID,
+		VariantId:   req.VariantID,
+		Price:       req.Price,
+		EffectiveAt: req.EffectiveAt,
+		CreatedBy:   userID,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CancelPriceChange cancels a scheduled product price
+// @Summary Cancel a scheduled product price
+// @Description Cancels a price change that has not been applied yet. Applied prices stay in the history
+// @Tags Prices
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Price change ID"
+// @Success 200 {object} object{status=string} "Price change cancelled"
+// @Failure 400 {object} object{error=string} "Invalid ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Price change not found"
+// @Failure 409 {object} object{error=string} "Price change was already applied"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /prices/products/scheduled/{id} [delete]
+func (h *ProductHandler) CancelPriceChange(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	resp, err := h.client.CancelPriceChange(c.Request().Context(), &pbProduct.CancelPriceChangeRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// ListPriceHistory lists the price history of a product or variant
+// @Summary Product price history
+// @Description Retrieves every price change of a product (or of one variant with variant_id), oldest first, including scheduled ones, with the current price
+// @Tags Prices
+// @Produce json
+// @Security BearerAuth
+// @Param product_type path string true "Product type (food, accessory, medicine)"
+// @Param id path int true "Product ID"
+// @Param variant_id query int false "Variant ID (default: the product itself)"
+// @Success 200 {object} object{current_price=number,changes=array{id=int32,price=number,previous_price=number,effective_at=string,applied_at=string,status=string,created_by=int32,note=string}} "Price history"
+// @Failure 400 {object} object{error=string} "Invalid product type or ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Product or variant not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /prices/products/{product_type}/{id} [get]
+func (h *ProductHandler) ListPriceHistory(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	variantID, err := optionalInt32Query(c, "variant_id")
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.ListPriceHistory(c.Request().Context(), &pbProduct.ListPriceHistoryRequest{
+		ProductType: c.Param("product_type"),
+		ProductId:   int32(id),
+		VariantId:   variantID,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"current_price": resp.CurrentPrice,
+		"changes":       resp.Changes,
+	})
+}
+
+// GetPriceAt returns the price of a product at a point in time
+// @Summary Product price at a time
+// @Description Returns what a product (or variant) cost at the given time, past or future (scheduled prices included). Defaults to now
+// @Tags Prices
+// @Produce json
+// @Param product_type path string true "Product type (food, accessory, medicine)"
+// @Param id path int true "Product ID"
+// @Param variant_id query int false "Variant ID (default: the product itself)"
+// @Param at query string false "Time in RFC3339 (default: now)"
+// @Success 200 {object} object{price=number} "Price"
+// @Failure 400 {object} object{error=string} "Invalid product type, ID or time"
+// @Failure 404 {object} object{error=string} "Product or variant not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /prices/products/{product_type}/{id}/at [get]
+func (h *ProductHandler) GetPriceAt(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	variantID, err := optionalInt32Query(c, "variant_id")
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.GetPriceAt(c.Request().Context(), &pbProduct.GetPriceAtRequest{
+		ProductType: c.Param("product_type"),
+		ProductId:   int32(id),
+		VariantId:   variantID,
+		At:          c.QueryParam("at"),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]float32{"price": resp.Price})
+}
+
+// ScheduleServicePriceChange schedules a future price for a service
+// @Summary Schedule a service price change
+// @Description Schedules a new price for a service. The price is applied automatically at effective_at; appointments booked from then on use it
+// @Tags Prices
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param service_id path int true "Service ID"
+// @Param request body object{price=number,effective_at=string,note=string} true "Price change, effective_at in RFC3339"
+// @Success 200 {object} object{id=int32,service_id=int32,price=number,effective_at=string,status=string,note=string} "Price change scheduled"
+// @Failure 400 {object} object{error=string} "Invalid price or effective_at not in the future"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Service not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /services/{service_id}/prices/scheduled [post]
+func (h *AppointmentHandler) ScheduleServicePriceChange(c echo.Context) error {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	serviceID, err := strconv.ParseInt(c.Param("service_id"), 10, 32)
+	if err != nil || serviceID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid service_id format, must be a positive integer"})
+	}
+	var req struct {
+		Price       float32 `json:"price"`
+		EffectiveAt string  `json:"effective_at"`
+		Note        string  `json:"note"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.ScheduleServicePriceChange(c.Request().Context(), &pbAppointment.ScheduleServicePriceChangeRequest{
+		ServiceId:   int32(serviceID),
+		Price:       req.Price,
+		EffectiveAt: req.EffectiveAt,
+		CreatedBy:   userID,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CancelServicePriceChange cancels a scheduled service price
+// @Summary Cancel a scheduled service price
+// @Description Cancels a service price change that has not been applied yet. Applied prices stay in the history
+// @Tags Prices
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Price change ID"
+// @Success 200 {object} object{status=string} "Price change cancelled"
+// @Failure 400 {object} object{error=string} "Invalid ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Price change not found"
+// @Failure 409 {object} object{error=string} "Price change was already applied"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /services/prices/scheduled/{id} [delete]
+func (h *AppointmentHandler) CancelServicePriceChange(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	resp, err := h.client.CancelServicePriceChange(c.Request().Context(), &pbAppointment.CancelServicePriceChangeRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// ListServicePriceHistory lists the price history of a service
+// @Summary Service price history
+// @Description Retrieves every price change of a service, oldest first, including scheduled ones, with the current price
+// @Tags Prices
+// @Produce json
+// @Security BearerAuth
+// @Param service_id path int true "Service ID"
+// @Success 200 {object} object{current_price=number,changes=array{id=int32,price=number,previous_price=number,effective_at=string,applied_at=string,status=string,created_by=int32,note=string}} "Price history"
+// @Failure 400 {object} object{error=string} "Invalid service ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Service not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /services/{service_id}/prices [get]
+func (h *AppointmentHandler) ListServicePriceHistory(c echo.Context) error {
+	serviceID, err := strconv.ParseInt(c.Param("service_id"), 10, 32)
+	if err != nil || serviceID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid service_id format, must be a positive integer"})
+	}
+	resp, err := h.client.ListServicePriceHistory(c.Request().Context(), &pbAppointment.ListServicePriceHistoryRequest{ServiceId: int32(serviceID)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"current_price": resp.CurrentPrice,
+		"changes":       resp.Changes,
+	})
+}
+
+// GetServicePriceAt returns the price of a service at a point in time
+// @Summary Service price at a time
+// @Description Returns what a service cost at the given time, past or future (scheduled prices included). Defaults to now
+// @Tags Prices
+// @Produce json
+// @Param service_id path int true "Service ID"
+// @Param at query string false "Time in RFC3339 (default: now)"
+// @Success 200 {object} object{price=number} "Price"
+// @Failure 400 {object} object{error=string} "Invalid service ID or time"
+// @Failure 404 {object} object{error=string} "Service not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /services/{service_id}/prices/at [get]
+func (h *AppointmentHandler) GetServicePriceAt(c echo.Context) error {
+	serviceID, err := strconv.ParseInt(c.Param("service_id"), 10, 32)
+	if err != nil || serviceID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid service_id format, must be a positive integer"})
+	}
+	resp, err := h.client.GetServicePriceAt(c.Request().Context(), &pbAppointment.GetServicePriceAtRequest{
+		ServiceId: int32(serviceID),
+		At:        c.QueryParam("at"),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]float32{"price": resp.Price})
+}
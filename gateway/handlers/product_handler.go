@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/commons/auth"
 	pb "github.com/quanbin27/commons/genproto/products"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -45,6 +46,16 @@ func (h *ProductHandler) RegisterRoutes(e *echo.Group) {
 	// Chi nhánh
 	e.GET("/branches/:id", h.GetBranchByID)
 	e.GET("/branches", h.ListBranches)
+	e.POST("/branches", h.CreateBranch, auth.RoleMiddleware(3))
+	e.PUT("/branches/:id", h.UpdateBranch, auth.RoleMiddleware(3))
+	e.PUT("/branches/:id/deactivate", h.DeactivateBranch, auth.RoleMiddleware(3))
+
+	// Giờ mở cửa & ngày nghỉ
+	e.GET("/branches/:id/schedule", h.GetBranchSchedule)
+	e.GET("/branches/:id/open", h.CheckBranchOpen)
+	e.PUT("/branches/:id/opening-hours", h.SetBranchOpeningHours, auth.RoleMiddleware(3))
+	e.POST("/branches/:id/closures", h.AddBranchClosure, auth.RoleMiddleware(3))
+	e.DELETE("/branches/closures/:closure_id", h.DeleteBranchClosure, auth.RoleMiddleware(3))
 
 	// Tồn kho
 	e.GET("/branches/:branch_id/inventory", h.GetBranchInventory)
@@ -52,10 +63,52 @@ func (h *ProductHandler) RegisterRoutes(e *echo.Group) {
 
 	e.GET("/products/is_attachable", h.ListAttachableProduct)
 	e.GET("/products", h.ListAllProduct)
+	e.GET("/products/search", h.SearchProducts)
 	e.GET("/products/with_stock", h.ListAllProductsWithStock)
+	e.GET("/products/cache-stats", h.GetProductCacheStats, auth.RoleMiddleware(3))
+	e.GET("/products/archived", h.ListArchivedProducts, auth.RoleMiddleware(2, 3))
+	e.PUT("/products/archived/:product_type/:id/restore", h.RestoreProduct, auth.RoleMiddleware(2, 3))
+	e.POST("/products/import", h.ImportProducts, auth.RoleMiddleware(2, 3))
+	e.GET("/products/export", h.ExportProducts, auth.RoleMiddleware(2, 3))
 
 	e.GET("/branches/:branch_id/products/available/all", h.ListAllAvailableProductsByBranch)
 	e.GET("/branches/:branch_id/products/available", h.ListAvailableProductsByBranch)
+
+	// Nhà cung cấp & đơn nhập hàng (chỉ nhân viên/quản trị)
+	e.GET("/suppliers", h.ListSuppliers, auth.RoleMiddleware(2, 3))
+	e.POST("/suppliers", h.CreateSupplier, auth.RoleMiddleware(2, 3))
+	e.PUT("/suppliers/:id", h.UpdateSupplier, auth.RoleMiddleware(2, 3))
+	e.GET("/purchase-orders", h.ListPurchaseOrders, auth.RoleMiddleware(2, 3))
+	e.POST("/purchase-orders", h.CreatePurchaseOrder, auth.RoleMiddleware(2, 3))
+	e.GET("/purchase-orders/:id", h.GetPurchaseOrder, auth.RoleMiddleware(2, 3))
+	e.PUT("/purchase-orders/:id/cancel", h.CancelPurchaseOrder, auth.RoleMiddleware(2, 3))
+	e.POST("/purchase-orders/:id/receive", h.ReceivePurchaseOrder, auth.RoleMiddleware(2, 3))
+
+	// Khuyến mãi & mã giảm giá
+	e.GET("/promotions", h.ListPromotions, auth.RoleMiddleware(2, 3))
+	e.POST("/promotions", h.CreatePromotion, auth.RoleMiddleware(3))
+	e.PUT("/promotions/:id", h.UpdatePromotion, auth.RoleMiddleware(3))
+	e.PUT("/promotions/:id/deactivate", h.DeactivatePromotion, auth.RoleMiddleware(3))
+	e.POST("/promotions/quote", h.QuoteCart)
+
+	// Biến thể sản phẩm (size, trọng lượng, vị)
+	e.GET("/variants/products/:product_type/:id", h.ListVariants)
+	e.GET("/variants/:id", h.GetVariant)
+	e.POST("/variants", h.CreateVariant, auth.RoleMiddleware(2, 3))
+	e.PUT("/variants/:id", h.UpdateVariant, auth.RoleMiddleware(2, 3))
+	e.PUT("/variants/:id/deactivate", h.DeactivateVariant, auth.RoleMiddleware(2, 3))
+
+	// Đánh giá sản phẩm
+	e.POST("/reviews", h.CreateReview, auth.WithJWTAuth())
+	e.GET("/reviews/products/:product_type/:id", h.ListProductReviews)
+	e.GET("/reviews", h.ListReviews, auth.RoleMiddleware(2, 3))
+	e.PUT("/reviews/:id/moderate", h.ModerateReview, auth.RoleMiddleware(2, 3))
+
+	// Lịch sử giá & giá hẹn trước
+	e.GET("/prices/products/:product_type/:id", h.ListPriceHistory, auth.RoleMiddleware(2, 3))
+	e.GET("/prices/products/:product_type/:id/at", h.GetPriceAt)
+	e.POST("/prices/products/scheduled", h.SchedulePriceChange, auth.RoleMiddleware(3))
+	e.DELETE("/prices/products/scheduled/:id", h.CancelPriceChange, auth.RoleMiddleware(3))
 }
 
 // --- Thực phẩm ---
@@ -65,7 +118,7 @@ func (h *ProductHandler) RegisterRoutes(e *echo.Group) {
 // @Tags Foods
 // @Produce json
 // @Param id path int true "Food ID"
-// @Success 200 {object} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean} "Food details"
+// @Success 200 {object} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean,is_archived=boolean} "Food details"
 // @Failure 400 {object} object{error=string} "ID is required or invalid ID format"
 // @Failure 404 {object} object{error=string} "Food not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -140,7 +193,7 @@ func (h *ProductHandler) ListFoods(c echo.Context) error {
 // @Tags Foods
 // @Accept json
 // @Produce json
-// @Param request body object{name=string,description=string,price=number} true "Food details"
+// @Param request body object{name=string,description=string,price=number,imgurl=string,sku=string,barcode=string} true "Food details"
 // @Success 200 {object} object{status=string} "Food created successfully"
 // @Failure 400 {object} object{error=string} "Invalid request or missing required fields"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -150,6 +203,9 @@ func (h *ProductHandler) CreateFood(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		SKU         string  `json:"sku"`
+		Barcode     string  `json:"barcode"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -165,10 +221,15 @@ func (h *ProductHandler) CreateFood(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		Imgurl:      req.ImgURL,
+		Sku:         req.SKU,
+		Barcode:     req.Barcode,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.InvalidArgument:
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": grpcErr.Message()})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
 			}
@@ -189,7 +250,7 @@ func (h *ProductHandler) CreateFood(c echo.Context) error {
 // @Tags Foods
 // @Accept json
 // @Produce json
-// @Param request body object{id=integer,name=string,description=string,price=number} true "Updated food details"
+// @Param request body object{id=integer,name=string,description=string,price=number,imgurl=string,sku=string,barcode=string} true "Updated food details"
 // @Success 200 {object} object{status=string} "Food updated successfully"
 // @Failure 400 {object} object{error=string} "Invalid request, ID or name missing, or price must be positive"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -200,6 +261,9 @@ func (h *ProductHandler) UpdateFood(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		SKU         string  `json:"sku"`
+		Barcode     string  `json:"barcode"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -215,6 +279,9 @@ func (h *ProductHandler) UpdateFood(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		Imgurl:      req.ImgURL,
+		Sku:         req.SKU,
+		Barcode:     req.Barcode,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
@@ -233,12 +300,13 @@ func (h *ProductHandler) UpdateFood(c echo.Context) error {
 
 // DeleteFood deletes a food product
 // @Summary Delete a food
-// @Description Deletes a food product by its unique ID
+// @Description Archives a food product: it is hidden from listings and can no longer be sold, but stays resolvable by ID for past orders. Use PUT /products/archived/food/{id}/restore to bring it back
 // @Tags Foods
 // @Produce json
 // @Param id path int true "Food ID"
 // @Success 200 {object} object{status=string} "Food deleted successfully"
 // @Failure 400 {object} object{error=string} "ID is required or invalid ID format"
+// @Failure 404 {object} object{error=string} "Food not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /products/foods/{id} [delete]
 func (h *ProductHandler) DeleteFood(c echo.Context) error {
@@ -257,6 +325,8 @@ func (h *ProductHandler) DeleteFood(c echo.Context) error {
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.NotFound:
+				return c.JSON(http.StatusNotFound, map[string]string{"error": "Food not found"})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
 			}
@@ -274,7 +344,7 @@ func (h *ProductHandler) DeleteFood(c echo.Context) error {
 // @Tags Accessories
 // @Produce json
 // @Param id path int true "Accessory ID"
-// @Success 200 {object} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean} "Accessory details"
+// @Success 200 {object} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean,is_archived=boolean} "Accessory details"
 // @Failure 400 {object} object{error=string} "ID is required or invalid ID format"
 // @Failure 404 {object} object{error=string} "Accessory not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -349,7 +419,7 @@ func (h *ProductHandler) ListAccessories(c echo.Context) error {
 // @Tags Accessories
 // @Accept json
 // @Produce json
-// @Param request body object{name=string,description=string,price=number} true "Accessory details"
+// @Param request body object{name=string,description=string,price=number,imgurl=string,sku=string,barcode=string} true "Accessory details"
 // @Success 200 {object} object{status=string} "Accessory created successfully"
 // @Failure 400 {object} object{error=string} "Invalid request or missing required fields"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -359,6 +429,9 @@ func (h *ProductHandler) CreateAccessory(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		SKU         string  `json:"sku"`
+		Barcode     string  `json:"barcode"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -373,10 +446,15 @@ func (h *ProductHandler) CreateAccessory(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		Imgurl:      req.ImgURL,
+		Sku:         req.SKU,
+		Barcode:     req.Barcode,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.InvalidArgument:
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": grpcErr.Message()})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
 			}
@@ -393,7 +471,7 @@ func (h *ProductHandler) CreateAccessory(c echo.Context) error {
 // @Tags Accessories
 // @Accept json
 // @Produce json
-// @Param request body object{id=integer,name=string,description=string,price=number} true "Updated accessory details"
+// @Param request body object{id=integer,name=string,description=string,price=number,imgurl=string,sku=string,barcode=string} true "Updated accessory details"
 // @Success 200 {object} object{status=string} "Accessory updated successfully"
 // @Failure 400 {object} object{error=string} "Invalid request, ID or name missing, or price must be positive"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -404,6 +482,9 @@ func (h *ProductHandler) UpdateAccessory(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		SKU         string  `json:"sku"`
+		Barcode     string  `json:"barcode"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -419,6 +500,9 @@ func (h *ProductHandler) UpdateAccessory(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		Imgurl:      req.ImgURL,
+		Sku:         req.SKU,
+		Barcode:     req.Barcode,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
@@ -437,12 +521,13 @@ func (h *ProductHandler) UpdateAccessory(c echo.Context) error {
 
 // DeleteAccessory deletes an accessory product
 // @Summary Delete an accessory
-// @Description Deletes an accessory product by its unique ID
+// @Description Archives an accessory: it is hidden from listings and can no longer be sold, but stays resolvable by ID for past orders. Use PUT /products/archived/accessory/{id}/restore to bring it back
 // @Tags Accessories
 // @Produce json
 // @Param id path int true "Accessory ID"
 // @Success 200 {object} object{status=string} "Accessory deleted successfully"
 // @Failure 400 {object} object{error=string} "ID is required or invalid ID format"
+// @Failure 404 {object} object{error=string} "Accessory not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /products/accessories/{id} [delete]
 func (h *ProductHandler) DeleteAccessory(c echo.Context) error {
@@ -461,6 +546,8 @@ func (h *ProductHandler) DeleteAccessory(c echo.Context) error {
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.NotFound:
+				return c.JSON(http.StatusNotFound, map[string]string{"error": "Accessory not found"})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
 			}
@@ -478,7 +565,7 @@ func (h *ProductHandler) DeleteAccessory(c echo.Context) error {
 // @Tags Medicines
 // @Produce json
 // @Param id path int true "Medicine ID"
-// @Success 200 {object} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean} "Medicine details"
+// @Success 200 {object} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean,is_archived=boolean} "Medicine details"
 // @Failure 400 {object} object{error=string} "ID is required or invalid ID format"
 // @Failure 404 {object} object{error=string} "Medicine not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -553,7 +640,7 @@ func (h *ProductHandler) ListMedicines(c echo.Context) error {
 // @Tags Medicines
 // @Accept json
 // @Produce json
-// @Param request body object{name=string,description=string,price=number} true "Medicine details"
+// @Param request body object{name=string,description=string,price=number,imgurl=string,sku=string,barcode=string} true "Medicine details"
 // @Success 200 {object} object{status=string} "Medicine created successfully"
 // @Failure 400 {object} object{error=string} "Invalid request or missing required fields"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -563,6 +650,9 @@ func (h *ProductHandler) CreateMedicine(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		SKU         string  `json:"sku"`
+		Barcode     string  `json:"barcode"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -577,10 +667,15 @@ func (h *ProductHandler) CreateMedicine(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		Imgurl:      req.ImgURL,
+		Sku:         req.SKU,
+		Barcode:     req.Barcode,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.InvalidArgument:
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": grpcErr.Message()})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
 			}
@@ -597,7 +692,7 @@ func (h *ProductHandler) CreateMedicine(c echo.Context) error {
 // @Tags Medicines
 // @Accept json
 // @Produce json
-// @Param request body object{id=integer,name=string,description=string,price=number} true "Updated medicine details"
+// @Param request body object{id=integer,name=string,description=string,price=number,imgurl=string,sku=string,barcode=string} true "Updated medicine details"
 // @Success 200 {object} object{status=string} "Medicine updated successfully"
 // @Failure 400 {object} object{error=string} "Invalid request, ID or name missing, or price must be positive"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -608,6 +703,9 @@ func (h *ProductHandler) UpdateMedicine(c echo.Context) error {
 		Name        string  `json:"name"`
 		Description string  `json:"description"`
 		Price       float32 `json:"price"`
+		ImgURL      string  `json:"imgurl"`
+		SKU         string  `json:"sku"`
+		Barcode     string  `json:"barcode"`
 	}
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
@@ -623,6 +721,9 @@ func (h *ProductHandler) UpdateMedicine(c echo.Context) error {
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
+		Imgurl:      req.ImgURL,
+		Sku:         req.SKU,
+		Barcode:     req.Barcode,
 	})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
@@ -641,12 +742,13 @@ func (h *ProductHandler) UpdateMedicine(c echo.Context) error {
 
 // DeleteMedicine deletes a medicine product
 // @Summary Delete a medicine
-// @Description Deletes a medicine product by its unique ID
+// @Description Archives a medicine: it is hidden from listings and can no longer be sold, but stays resolvable by ID for past orders. Use PUT /products/archived/medicine/{id}/restore to bring it back
 // @Tags Medicines
 // @Produce json
 // @Param id path int true "Medicine ID"
 // @Success 200 {object} object{status=string} "Medicine deleted successfully"
 // @Failure 400 {object} object{error=string} "ID is required or invalid ID format"
+// @Failure 404 {object} object{error=string} "Medicine not found"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /products/medicines/{id} [delete]
 func (h *ProductHandler) DeleteMedicine(c echo.Context) error {
@@ -665,6 +767,8 @@ func (h *ProductHandler) DeleteMedicine(c echo.Context) error {
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
+			case codes.NotFound:
+				return c.JSON(http.StatusNotFound, map[string]string{"error": "Medicine not found"})
 			case codes.Internal:
 				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
 			}
@@ -717,15 +821,16 @@ func (h *ProductHandler) GetBranchByID(c echo.Context) error {
 
 // ListBranches lists all branches
 // @Summary List all branches
-// @Description Retrieves a list of all branch records
+// @Description Retrieves a list of active branches, or all branches when include_inactive=true
 // @Tags Branches
 // @Produce json
-// @Success 200 {array} object{id=int32,name=string,description=string,location=string} "List of branches"
+// @Param include_inactive query bool false "Include deactivated branches"
+// @Success 200 {array} object{id=int32,name=string,location=string,phone=string,email=string,latitude=number,longitude=number,timezone=string,is_active=boolean} "List of branches"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /branches [get]
 func (h *ProductHandler) ListBranches(c echo.Context) error {
 	ctx := c.Request().Context()
-	resp, err := h.client.ListBranches(ctx, &pb.ListBranchRequest{})
+	resp, err := h.client.ListBranches(ctx, &pb.ListBranchRequest{IncludeInactive: c.QueryParam("include_inactive") == "true"})
 	if err != nil {
 		if grpcErr, ok := status.FromError(err); ok {
 			switch grpcErr.Code() {
@@ -739,6 +844,288 @@ func (h *ProductHandler) ListBranches(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp.Branches)
 }
 
+// branchRequest - Thông tin chi nhánh dùng chung cho tạo mới và cập nhật
+type branchRequest struct {
+	Name      string  `json:"name"`
+	Location  string  `json:"location"`
+	Phone     string  `json:"phone"`
+	Email     string  `json:"email"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Timezone  string  `json:"timezone"`
+	// AppointmentCapacity - số lịch hẹn tối đa cùng lúc, 0 = không giới hạn
+	AppointmentCapacity int32 `json:"appointment_capacity"`
+}
+
+// CreateBranch creates a new branch
+// @Summary Create a branch
+// @Description Creates a new active branch with contact info and coordinates. Timezone defaults to Asia/Ho_Chi_Minh
+// @Tags Branches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{name=string,location=string,phone=string,email=string,latitude=number,longitude=number,timezone=string,appointment_capacity=int32} true "Branch details"
+// @Success 200 {object} object{branch_id=int32,status=string} "Branch created successfully"
+// @Failure 400 {object} object{error=string} "Invalid request, missing name, invalid coordinates or timezone"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches [post]
+func (h *ProductHandler) CreateBranch(c echo.Context) error {
+	var req branchRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	ctx := c.Request().Context()
+	resp, err := h.client.CreateBranch(ctx, &pb.CreateBranchRequest{
+		Name:                req.Name,
+		Location:            req.Location,
+		Phone:               req.Phone,
+		Email:               req.Email,
+		Latitude:            req.Latitude,
+		Longitude:           req.Longitude,
+		Timezone:            req.Timezone,
+		AppointmentCapacity: req.AppointmentCapacity,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"branch_id": resp.BranchId, "status": resp.Status})
+}
+
+// UpdateBranch updates a branch
+// @Summary Update a branch
+// @Description Updates name, address, contact info, coordinates, timezone and appointment capacity (0 = unlimited) of a branch
+// @Tags Branches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Branch ID"
+// @Param request body object{name=string,location=string,phone=string,email=string,latitude=number,longitude=number,timezone=string,appointment_capacity=int32} true "Updated branch details"
+// @Success 200 {object} object{status=string} "Branch updated successfully"
+// @Failure 400 {object} object{error=string} "Invalid request"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Branch not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches/{id} [put]
+func (h *ProductHandler) UpdateBranch(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	var req branchRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	ctx := c.Request().Context()
+	resp, err := h.client.UpdateBranch(ctx, &pb.UpdateBranchRequest{
+		Id:                  int32(id),
+		Name:                req.Name,
+		Location:            req.Location,
+		Phone:               req.Phone,
+		Email:               req.Email,
+		Latitude:            req.Latitude,
+		Longitude:           req.Longitude,
+		Timezone:            req.Timezone,
+		AppointmentCapacity: req.AppointmentCapacity,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// DeactivateBranch deactivates a branch
+// @Summary Deactivate a branch
+// @Description Marks a branch as inactive. Inactive branches are hidden from the branch list and are always reported as closed
+// @Tags Branches
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Branch ID"
+// @Success 200 {object} object{status=string} "Branch deactivated successfully"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Branch not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches/{id}/deactivate [put]
+func (h *ProductHandler) DeactivateBranch(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	resp, err := h.client.DeactivateBranch(c.Request().Context(), &pb.DeactivateBranchRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// GetBranchSchedule retrieves opening hours and upcoming closures of a branch
+// @Summary Get branch schedule
+// @Description Retrieves the weekly opening hours (weekday 0 = Sunday) and the closures that have not ended yet
+// @Tags Branches
+// @Produce json
+// @Param id path int true "Branch ID"
+// @Success 200 {object} object{branch_id=int32,timezone=string,opening_hours=array{weekday=int32,open_time=string,close_time=string},closures=array{id=int32,branch_id=int32,start_date=string,end_date=string,reason=string}} "Branch schedule"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 404 {object} object{error=string} "Branch not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches/{id}/schedule [get]
+func (h *ProductHandler) GetBranchSchedule(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	resp, err := h.client.GetBranchSchedule(c.Request().Context(), &pb.GetBranchScheduleRequest{BranchId: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetBranchOpeningHours replaces the weekly opening hours of a branch
+// @Summary Set branch opening hours
+// @Description Replaces the weekly opening hours of a branch. Weekday 0 = Sunday ... 6 = Saturday, times are HH:MM in the branch timezone. A day may have several non-overlapping slots; days without slots are closed
+// @Tags Branches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Branch ID"
+// @Param request body object{hours=array{weekday=int32,open_time=string,close_time=string}} true "Opening hours"
+// @Success 200 {object} object{status=string} "Opening hours updated successfully"
+// @Failure 400 {object} object{error=string} "Invalid weekday, time format or overlapping slots"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Branch not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches/{id}/opening-hours [put]
+func (h *ProductHandler) SetBranchOpeningHours(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	var req struct {
+		Hours []struct {
+			Weekday   int32  `json:"weekday"`
+			OpenTime  string `json:"open_time"`
+			CloseTime string `json:"close_time"`
+		} `json:"hours"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	hours := make([]*pb.OpeningHour, len(req.Hours))
+	for i, oh := range req.Hours {
+		hours[i] = &pb.OpeningHour{Weekday: oh.Weekday, OpenTime: oh.OpenTime, CloseTime: oh.CloseTime}
+	}
+	resp, err := h.client.SetBranchOpeningHours(c.Request().Context(), &pb.SetBranchOpeningHoursRequest{
+		BranchId: int32(id),
+		Hours:    hours,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// AddBranchClosure adds a closure (holiday) to a branch
+// @Summary Add branch closure
+// @Description Adds a closure from start_date to end_date inclusive (YYYY-MM-DD). An empty end_date closes only start_date
+// @Tags Branches
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Branch ID"
+// @Param request body object{start_date=string,end_date=string,reason=string} true "Closure details"
+// @Success 200 {object} object{id=int32,branch_id=int32,start_date=string,end_date=string,reason=string} "Closure created"
+// @Failure 400 {object} object{error=string} "Invalid dates"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Branch not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches/{id}/closures [post]
+func (h *ProductHandler) AddBranchClosure(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	var req struct {
+		StartDate string `json:"start_date"`
+		EndDate   string `json:"end_date"`
+		Reason    string `json:"reason"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.AddBranchClosure(c.Request().Context(), &pb.AddBranchClosureRequest{
+		BranchId:  int32(id),
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteBranchClosure deletes a branch closure
+// @Summary Delete branch closure
+// @Description Deletes a branch closure by its ID
+// @Tags Branches
+// @Produce json
+// @Security BearerAuth
+// @Param closure_id path int true "Closure ID"
+// @Success 200 {object} object{status=string} "Closure deleted successfully"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Closure not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches/closures/{closure_id} [delete]
+func (h *ProductHandler) DeleteBranchClosure(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("closure_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	resp, err := h.client.DeleteBranchClosure(c.Request().Context(), &pb.DeleteBranchClosureRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// CheckBranchOpen checks whether a branch is open at a given time
+// @Summary Check if a branch is open
+// @Description Checks whether the branch is open at the given time (RFC3339), optionally for the whole duration in minutes. Branches without configured opening hours are treated as always open
+// @Tags Branches
+// @Produce json
+// @Param id path int true "Branch ID"
+// @Param time query string true "Time to check, RFC3339"
+// @Param duration query int false "Duration in minutes"
+// @Success 200 {object} object{is_open=boolean,reason=string} "Open status"
+// @Failure 400 {object} object{error=string} "Invalid ID, time or duration"
+// @Failure 404 {object} object{error=string} "Branch not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /branches/{id}/open [get]
+func (h *ProductHandler) CheckBranchOpen(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	var duration int64
+	if d := c.QueryParam("duration"); d != "" {
+		if duration, err = strconv.ParseInt(d, 10, 32); err != nil || duration < 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid duration, must be a non-negative integer"})
+		}
+	}
+	resp, err := h.client.CheckBranchOpen(c.Request().Context(), &pb.CheckBranchOpenRequest{
+		BranchId:        int32(id),
+		Time:            c.QueryParam("time"),
+		DurationMinutes: int32(duration),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"is_open": resp.IsOpen, "reason": resp.Reason})
+}
+
 // --- Tồn kho ---
 // GetBranchInventory retrieves the inventory for a specific branch
 // @Summary Get branch inventory
@@ -778,11 +1165,11 @@ func (h *ProductHandler) GetBranchInventory(c echo.Context) error {
 
 // UpdateBranchInventory updates the inventory for a branch
 // @Summary Update branch inventory
-// @Description Updates the inventory for a specific branch with details like branch ID, product ID, product type, and stock quantity
+// @Description Updates the inventory for a specific branch with details like branch ID, product ID, product type, and stock quantity. Products with variants are stocked per variant, so variant_id is required for them
 // @Tags Inventory
 // @Accept json
 // @Produce json
-// @Param request body object{branch_id=integer,product_id=integer,product_type=string,stock_quantity=integer} true "Inventory update details"
+// @Param request body object{branch_id=integer,product_id=integer,product_type=string,variant_id=integer,stock_quantity=integer} true "Inventory update details"
 // @Success 200 {object} object{status=string} "Inventory updated successfully"
 // @Failure 400 {object} object{error=string} "Invalid request, missing required fields, or invalid product type"
 // @Failure 500 {object} object{error=string} "Internal server error"
@@ -792,6 +1179,7 @@ func (h *ProductHandler) UpdateBranchInventory(c echo.Context) error {
 		BranchID      int32  `json:"branch_id"`
 		ProductID     int32  `json:"product_id"`
 		ProductType   string `json:"product_type"` // Giữ nguyên là string
+		VariantID     int32  `json:"variant_id"`
 		StockQuantity int32  `json:"stock_quantity"`
 	}
 	if err := c.Bind(&req); err != nil {
@@ -818,6 +1206,7 @@ func (h *ProductHandler) UpdateBranchInventory(c echo.Context) error {
 		BranchId:      req.BranchID,
 		ProductId:     req.ProductID,
 		ProductType:   req.ProductType, // Truyền trực tiếp string
+		VariantId:     req.VariantID,
 		StockQuantity: req.StockQuantity,
 	})
 	if err != nil {
@@ -838,7 +1227,7 @@ func (h *ProductHandler) UpdateBranchInventory(c echo.Context) error {
 // @Description Retrieves a list of all products marked as attachable
 // @Tags Products
 // @Produce json
-// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean} "List of attachable products"
+// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,is_attachable=boolean,average_rating=number,review_count=int32} "List of attachable products"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /products/is_attachable [get]
 func (h *ProductHandler) ListAttachableProduct(c echo.Context) error {
@@ -862,7 +1251,7 @@ func (h *ProductHandler) ListAttachableProduct(c echo.Context) error {
 // @Description Retrieves a list of all products (foods, accessories, medicines) with their details
 // @Tags Products
 // @Produce json
-// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,product_type=string,is_attachable=boolean} "List of all products"
+// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,product_type=string,is_attachable=boolean,average_rating=number,review_count=int32} "List of all products"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /products [get]
 func (h *ProductHandler) ListAllProduct(c echo.Context) error {
@@ -881,19 +1270,111 @@ func (h *ProductHandler) ListAllProduct(c echo.Context) error {
 	var products []AllProductResponse
 	for _, prod := range resp.Products {
 		products = append(products, AllProductResponse{
-			ID:           prod.ProductId,
-			Name:         prod.Name,
-			Price:        prod.Price,
-			Description:  prod.Description,
-			ImgURL:       prod.Imgurl,
-			ProductType:  prod.ProductType,
-			IsAttachable: prod.IsAttachable, // Đảm bảo trường này được gán
+			ID:            prod.ProductId,
+			Name:          prod.Name,
+			Price:         prod.Price,
+			Description:   prod.Description,
+			ImgURL:        prod.Imgurl,
+			ProductType:   prod.ProductType,
+			IsAttachable:  prod.IsAttachable, // Đảm bảo trường này được gán
+			AverageRating: prod.AverageRating,
+			ReviewCount:   prod.ReviewCount,
 		})
 	}
 
 	return c.JSON(http.StatusOK, products)
 }
 
+// SearchProducts searches products with filters, sorting, pagination and facet counts
+// @Summary Search products
+// @Description Keyword search over product name and description (Vietnamese diacritic-insensitive) with filters, sorting, pagination and facet counts
+// @Tags Products
+// @Produce json
+// @Param q query string false "Keyword"
+// @Param product_type query string false "Comma-separated product types (FOOD, ACCESSORY, MEDICINE)"
+// @Param min_price query number false "Minimum price"
+// @Param max_price query number false "Maximum price (exclusive)"
+// @Param is_attachable query bool false "Attachable flag"
+// @Param branch_id query int false "Only products in stock at this branch"
+// @Param sort query string false "relevance (default), price_asc, price_desc, name_asc, name_desc"
+// @Param page query int false "Page number (1-based, default 1)"
+// @Param page_size query int false "Items per page (default 20, max 100)"
+// @Success 200 {object} object{products=array,total=int64,facets=object{product_types=array,attachable=array,price_ranges=array}} "Search results"
+// @Failure 400 {object} object{error=string} "Invalid query parameters"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /products/search [get]
+func (h *ProductHandler) SearchProducts(c echo.Context) error {
+	req := &pb.SearchProductsRequest{
+		Keyword: c.QueryParam("q"),
+		SortBy:  c.QueryParam("sort"),
+	}
+	if v := c.QueryParam("product_type"); v != "" {
+		for _, t := range strings.Split(v, ",") {
+			req.ProductTypes = append(req.ProductTypes, strings.ToUpper(strings.TrimSpace(t)))
+		}
+	}
+	if v := c.QueryParam("min_price"); v != "" {
+		price, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid min_price, must be a number"})
+		}
+		req.MinPrice = float32(price)
+	}
+	if v := c.QueryParam("max_price"); v != "" {
+		price, err := strconv.ParseFloat(v, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid max_price, must be a number"})
+		}
+		req.MaxPrice = float32(price)
+	}
+	if v := c.QueryParam("is_attachable"); v != "" {
+		attachable, err := strconv.ParseBool(v)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid is_attachable, must be true or false"})
+		}
+		req.IsAttachable = &attachable
+	}
+	intParams := map[string]*int32{"branch_id": &req.InStockBranchId, "page": &req.Page, "page_size": &req.PageSize}
+	for name, dst := range intParams {
+		v := c.QueryParam(name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid " + name + ", must be an integer"})
+		}
+		*dst = int32(n)
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.SearchProducts(ctx, req)
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+
+	products := make([]AvailableProductResponse, 0, len(resp.Products))
+	for _, prod := range resp.Products {
+		products = append(products, AvailableProductResponse{
+			ID:                prod.ProductId,
+			Name:              prod.Name,
+			Price:             prod.Price,
+			Description:       prod.Description,
+			ImgURL:            prod.Imgurl,
+			ProductType:       prod.ProductType,
+			IsAttachable:      prod.IsAttachable,
+			AvailableQuantity: prod.AvailableQuantity,
+			AverageRating:     prod.AverageRating,
+			ReviewCount:       prod.ReviewCount,
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"products": products,
+		"total":    resp.Total,
+		"facets":   resp.Facets,
+	})
+}
+
 // ListAllProductsWithStock lists all products with their stock information
 // @Summary List all products with stock information
 // @Description Retrieves a list of all products (foods, accessories, medicines) along with their stock information
@@ -917,13 +1398,83 @@ func (h *ProductHandler) ListAllProductsWithStock(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp.Products)
 }
 
+// GetProductCacheStats reports the hit ratio of the product listing cache
+// @Summary Product listing cache statistics
+// @Description Hits, misses and Redis errors of the product listing cache since the Products service started
+// @Tags Products
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} object{hits=integer,misses=integer,errors=integer,hit_ratio=number} "Cache statistics"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /products/cache-stats [get]
+func (h *ProductHandler) GetProductCacheStats(c echo.Context) error {
+	resp, err := h.client.GetCacheStats(c.Request().Context(), &pb.GetCacheStatsRequest{})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"hits":      resp.Hits,
+		"misses":    resp.Misses,
+		"errors":    resp.Errors,
+		"hit_ratio": resp.HitRatio,
+	})
+}
+
+// ListArchivedProducts lists deleted (archived) products
+// @Summary List archived products
+// @Description Retrieves the foods, accessories and medicines that were deleted. They are hidden from the storefront but still resolvable by ID
+// @Tags Products
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} object{product_id=int32,name=string,description=string,price=number,imgurl=string,product_type=string,sku=string,barcode=string,is_archived=boolean} "List of archived products"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /products/archived [get]
+func (h *ProductHandler) ListArchivedProducts(c echo.Context) error {
+	resp, err := h.client.ListArchivedProducts(c.Request().Context(), &pb.ListArchivedProductsRequest{})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Products)
+}
+
+// RestoreProduct restores a deleted (archived) product
+// @Summary Restore an archived product
+// @Description Puts a deleted food, accessory or medicine back on sale
+// @Tags Products
+// @Produce json
+// @Security BearerAuth
+// @Param product_type path string true "Product type (food, accessory, medicine)"
+// @Param id path int true "Product ID"
+// @Success 200 {object} object{status=string} "Product restored"
+// @Failure 400 {object} object{error=string} "Invalid product type or ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Product not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /products/archived/{product_type}/{id}/restore [put]
+func (h *ProductHandler) RestoreProduct(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	resp, err := h.client.RestoreProduct(c.Request().Context(), &pb.RestoreProductRequest{
+		ProductType: c.Param("product_type"),
+		Id:          int32(id),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
 // ListAvailableAllProductsByBranch lists all available products by branch
 // @Summary List all available products by branch
 // @Description Retrieves a list of all available products (foods, accessories, medicines) for a specific branch
 // @Tags Products
 // @Produce json
 // @Param branch_id path int true "Branch ID"
-// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,product_type=string,is_attachable=boolean,available_quantity=int32} "List of available products"
+// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,product_type=string,is_attachable=boolean,available_quantity=int32,average_rating=number,review_count=int32} "List of available products"
 // @Failure 400 {object} object{error=string} "Branch ID is required or invalid format"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /branches/{branch_id}/products/available/all [get]
@@ -960,7 +1511,7 @@ func (h *ProductHandler) ListAllAvailableProductsByBranch(c echo.Context) error
 // @Produce json
 // @Param branch_id path int true "Branch ID"
 // @Param product_type query string false "Product type (FOOD, ACCESSORY, MEDICINE)"
-// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,product_type=string,is_attachable=boolean,available_quantity=int32} "List of available products"
+// @Success 200 {array} object{id=int32,name=string,description=string,price=number,imgurl=string,product_type=string,is_attachable=boolean,available_quantity=int32,average_rating=number,review_count=int32} "List of available products"
 // @Failure 400 {object} object{error=string} "Invalid branch ID or product type"
 // @Failure 500 {object} object{error=string} "Internal server error"
 // @Router /branches/{branch_id}/products/available [get]
@@ -990,14 +1541,355 @@ func (h *ProductHandler) ListAvailableProductsByBranch(c echo.Context) error {
 		ProductType: productType,
 	})
 	if err != nil {
-		if grpcErr, ok := status.FromError(err); ok {
-			switch grpcErr.Code() {
-			case codes.Internal:
-				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
-			}
-		}
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+		return grpcErrorToHTTP(c, err)
 	}
 
 	return c.JSON(http.StatusOK, resp.Products)
 }
+
+// grpcErrorToHTTP chuyển lỗi gRPC từ Products service sang HTTP response
+func grpcErrorToHTTP(c echo.Context, err error) error {
+	if grpcErr, ok := status.FromError(err); ok {
+		switch grpcErr.Code() {
+		case codes.InvalidArgument:
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": grpcErr.Message()})
+		case codes.NotFound:
+			return c.JSON(http.StatusNotFound, map[string]string{"error": grpcErr.Message()})
+		case codes.FailedPrecondition:
+			return c.JSON(http.StatusConflict, map[string]string{"error": grpcErr.Message()})
+		case codes.Unavailable:
+			return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": grpcErr.Message()})
+		case codes.Internal:
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+	}
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+}
+
+// --- Nhà cung cấp ---
+// ListSuppliers lists all suppliers
+// @Summary List suppliers
+// @Description Retrieves all suppliers ordered by name
+// @Tags Suppliers
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} object{id=int32,name=string,contact_name=string,phone=string,email=string,address=string} "List of suppliers"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /suppliers [get]
+func (h *ProductHandler) ListSuppliers(c echo.Context) error {
+	ctx := c.Request().Context()
+	resp, err := h.client.ListSuppliers(ctx, &pb.ListSuppliersRequest{})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Suppliers)
+}
+
+// CreateSupplier creates a new supplier
+// @Summary Create a supplier
+// @Description Creates a new supplier with contact details
+// @Tags Suppliers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{name=string,contact_name=string,phone=string,email=string,address=string} true "Supplier details"
+// @Success 200 {object} object{supplier_id=int32,status=string} "Supplier created successfully"
+// @Failure 400 {object} object{error=string} "Invalid request or missing name"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /suppliers [post]
+func (h *ProductHandler) CreateSupplier(c echo.Context) error {
+	var req struct {
+		Name        string `json:"name"`
+		ContactName string `json:"contact_name"`
+		Phone       string `json:"phone"`
+		Email       string `json:"email"`
+		Address     string `json:"address"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if req.Name == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Name is required"})
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.CreateSupplier(ctx, &pb.CreateSupplierRequest{
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Phone:       req.Phone,
+		Email:       req.Email,
+		Address:     req.Address,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"supplier_id": resp.SupplierId,
+		"status":      resp.Status,
+	})
+}
+
+// UpdateSupplier updates an existing supplier
+// @Summary Update a supplier
+// @Description Updates the name and contact details of a supplier
+// @Tags Suppliers
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Supplier ID"
+// @Param request body object{name=string,contact_name=string,phone=string,email=string,address=string} true "Updated supplier details"
+// @Success 200 {object} object{status=string} "Supplier updated successfully"
+// @Failure 400 {object} object{error=string} "Invalid request or missing name"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /suppliers/{id} [put]
+func (h *ProductHandler) UpdateSupplier(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	var req struct {
+		Name        string `json:"name"`
+		ContactName string `json:"contact_name"`
+		Phone       string `json:"phone"`
+		Email       string `json:"email"`
+		Address     string `json:"address"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if req.Name == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Name is required"})
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.UpdateSupplier(ctx, &pb.UpdateSupplierRequest{
+		Id:          int32(id),
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Phone:       req.Phone,
+		Email:       req.Email,
+		Address:     req.Address,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// --- Đơn nhập hàng ---
+// ListPurchaseOrders lists purchase orders
+// @Summary List purchase orders
+// @Description Retrieves purchase orders, newest first, optionally filtered by supplier, branch and status
+// @Tags PurchaseOrders
+// @Produce json
+// @Security BearerAuth
+// @Param supplier_id query int false "Supplier ID"
+// @Param branch_id query int false "Branch ID"
+// @Param status query string false "Status (ordered, partially_received, received, cancelled)"
+// @Success 200 {array} object{id=int32,supplier_id=int32,branch_id=int32,status=string,expected_date=string,note=string,total_cost=number,created_at=string} "List of purchase orders"
+// @Failure 400 {object} object{error=string} "Invalid query parameters"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /purchase-orders [get]
+func (h *ProductHandler) ListPurchaseOrders(c echo.Context) error {
+	req := &pb.ListPurchaseOrdersRequest{Status: c.QueryParam("status")}
+	if v := c.QueryParam("supplier_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid supplier_id format, must be an integer"})
+		}
+		req.SupplierId = int32(id)
+	}
+	if v := c.QueryParam("branch_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid branch_id format, must be an integer"})
+		}
+		req.BranchId = int32(id)
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.ListPurchaseOrders(ctx, req)
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.PurchaseOrders)
+}
+
+// CreatePurchaseOrder creates a purchase order for a branch
+// @Summary Create a purchase order
+// @Description Creates a purchase order from a supplier for a branch, with product lines, quantities and unit costs
+// @Tags PurchaseOrders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{supplier_id=integer,branch_id=integer,expected_date=string,note=string,lines=array{product_id=integer,product_type=string,variant_id=integer,quantity=integer,unit_cost=number}} true "Purchase order details"
+// @Success 200 {object} object{purchase_order_id=int32,status=string} "Purchase order created successfully"
+// @Failure 400 {object} object{error=string} "Invalid request, unknown supplier/branch or invalid lines"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /purchase-orders [post]
+func (h *ProductHandler) CreatePurchaseOrder(c echo.Context) error {
+	type lineReq struct {
+		ProductID   int32   `json:"product_id"`
+		ProductType string  `json:"product_type"`
+		VariantID   int32   `json:"variant_id"`
+		Quantity    int32   `json:"quantity"`
+		UnitCost    float32 `json:"unit_cost"`
+	}
+	var req struct {
+		SupplierID   int32     `json:"supplier_id"`
+		BranchID     int32     `json:"branch_id"`
+		ExpectedDate string    `json:"expected_date"` // RFC3339, tuỳ chọn
+		Note         string    `json:"note"`
+		Lines        []lineReq `json:"lines"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if req.SupplierID <= 0 || req.BranchID <= 0 || len(req.Lines) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "supplier_id, branch_id and at least one line are required"})
+	}
+
+	lines := make([]*pb.PurchaseOrderLineInput, len(req.Lines))
+	for i, l := range req.Lines {
+		lines[i] = &pb.PurchaseOrderLineInput{
+			ProductId:   l.ProductID,
+			ProductType: l.ProductType,
+			VariantId:   l.VariantID,
+			Quantity:    l.Quantity,
+			UnitCost:    l.UnitCost,
+		}
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.CreatePurchaseOrder(ctx, &pb.CreatePurchaseOrderRequest{
+		SupplierId:   req.SupplierID,
+		BranchId:     req.BranchID,
+		ExpectedDate: req.ExpectedDate,
+		Note:         req.Note,
+		Lines:        lines,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"purchase_order_id": resp.PurchaseOrderId,
+		"status":            resp.Status,
+	})
+}
+
+// GetPurchaseOrder retrieves a purchase order with its lines
+// @Summary Get purchase order by ID
+// @Description Retrieves a purchase order with ordered and received quantities per line
+// @Tags PurchaseOrders
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} object{id=int32,supplier_id=int32,branch_id=int32,status=string,expected_date=string,note=string,total_cost=number,lines=array{id=int32,product_id=int32,product_type=string,quantity_ordered=int32,quantity_received=int32,unit_cost=number}} "Purchase order details"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Purchase order not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /purchase-orders/{id} [get]
+func (h *ProductHandler) GetPurchaseOrder(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.GetPurchaseOrder(ctx, &pb.GetPurchaseOrderRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CancelPurchaseOrder cancels a purchase order that has not received any goods yet
+// @Summary Cancel a purchase order
+// @Description Cancels a purchase order. Only orders with no goods received can be cancelled.
+// @Tags PurchaseOrders
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Purchase order ID"
+// @Success 200 {object} object{status=string} "Purchase order cancelled"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Purchase order not found"
+// @Failure 409 {object} object{error=string} "Purchase order can no longer be cancelled"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /purchase-orders/{id}/cancel [put]
+func (h *ProductHandler) CancelPurchaseOrder(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.CancelPurchaseOrder(ctx, &pb.CancelPurchaseOrderRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// ReceivePurchaseOrder records a (partial) goods receipt for a purchase order
+// @Summary Receive goods for a purchase order
+// @Description Records received quantities per purchase order line, increments branch stock and updates the average cost. Supports partial receipts.
+// @Tags PurchaseOrders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Purchase order ID"
+// @Param request body object{note=string,lines=array{line_id=integer,quantity=integer,unit_cost=number}} true "Received lines (unit_cost optional, defaults to the ordered cost)"
+// @Success 200 {object} object{receipt_id=int32,purchase_order_status=string} "Goods received"
+// @Failure 400 {object} object{error=string} "Invalid request or quantity exceeds remaining"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Purchase order not found"
+// @Failure 409 {object} object{error=string} "Purchase order is cancelled or fully received"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /purchase-orders/{id}/receive [post]
+func (h *ProductHandler) ReceivePurchaseOrder(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	type lineReq struct {
+		LineID   int32   `json:"line_id"`
+		Quantity int32   `json:"quantity"`
+		UnitCost float32 `json:"unit_cost"`
+	}
+	var req struct {
+		Note  string    `json:"note"`
+		Lines []lineReq `json:"lines"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	if len(req.Lines) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "At least one line is required"})
+	}
+
+	lines := make([]*pb.ReceiveLineInput, len(req.Lines))
+	for i, l := range req.Lines {
+		lines[i] = &pb.ReceiveLineInput{LineId: l.LineID, Quantity: l.Quantity, UnitCost: l.UnitCost}
+	}
+
+	ctx := c.Request().Context()
+	resp, err := h.client.ReceivePurchaseOrder(ctx, &pb.ReceivePurchaseOrderRequest{
+		PurchaseOrderId: int32(id),
+		Lines:           lines,
+		Note:            req.Note,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"receipt_id":            resp.ReceiptId,
+		"purchase_order_status": resp.PurchaseOrderStatus,
+	})
+}
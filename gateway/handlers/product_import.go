@@ -0,0 +1,335 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	pb "github.com/quanbin27/commons/genproto/products"
+)
+
+// Nhập / xuất sản phẩm và tồn kho dạng CSV.
+// Dòng đầu là header, thứ tự cột tuỳ ý, các cột khác bị bỏ qua; file xuất ra nhập lại được ngay.
+//   kind=products : product_type, product_id (rỗng = tạo mới), name, description, price, is_attachable, imgurl
+//   kind=inventory: branch_id, product_type, product_id, variant_id (rỗng/0 = không có biến thể), stock_quantity
+
+const (
+	importKindProducts  = "products"
+	importKindInventory = "inventory"
+	maxImportFileSize   = 10 << 20 // 10MB
+)
+
+var importColumns = map[string][]string{
+	importKindProducts:  {"product_type", "product_id", "name", "description", "price", "is_attachable", "imgurl"},
+	importKindInventory: {"branch_id", "product_type", "product_id", "variant_id", "stock_quantity"},
+}
+
+var requiredImportColumns = map[string][]string{
+	importKindProducts:  {"product_type", "name", "price"},
+	importKindInventory: {"branch_id", "product_type", "product_id", "variant_id", "stock_quantity"},
+}
+
+type importRowError struct {
+	Row     int32  `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type importReport struct {
+	DryRun    bool             `json:"dry_run"`
+	Applied   bool             `json:"applied"`
+	TotalRows int32            `json:"total_rows"`
+	Created   int32            `json:"created"`
+	Updated   int32            `json:"updated"`
+	Errors    []importRowError `json:"errors"`
+}
+
+// csvRow - Một dòng dữ liệu kèm số dòng trong file (header là dòng 1)
+type csvRow struct {
+	line   int32
+	values map[string]string
+	errors []importRowError
+}
+
+func (r *csvRow) str(col string) string {
+	return strings.TrimSpace(r.values[col])
+}
+
+func (r *csvRow) int32(col string, optional bool) int32 {
+	v := r.str(col)
+	if v == "" && optional {
+		return 0
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		r.errors = append(r.errors, importRowError{Row: r.line, Field: col, Message: fmt.Sprintf("invalid integer %q", v)})
+	}
+	return int32(n)
+}
+
+func (r *csvRow) float32(col string) float32 {
+	v := r.str(col)
+	f, err := strconv.ParseFloat(v, 32)
+	if err != nil {
+		r.errors = append(r.errors, importRowError{Row: r.line, Field: col, Message: fmt.Sprintf("invalid number %q", v)})
+	}
+	return float32(f)
+}
+
+func (r *csvRow) bool(col string) bool {
+	switch v := strings.ToLower(r.str(col)); v {
+	case "", "false", "0", "no":
+		return false
+	case "true", "1", "yes":
+		return true
+	default:
+		r.errors = append(r.errors, importRowError{Row: r.line, Field: col, Message: fmt.Sprintf("invalid boolean %q", v)})
+		return false
+	}
+}
+
+// readCSV đọc file CSV theo header, trả về các dòng dữ liệu (bỏ qua dòng trống)
+func readCSV(r io.Reader, kind string) ([]*csvRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CSV header")
+	}
+	index := make(map[string]int)
+	for i, h := range header {
+		// Excel thêm BOM vào đầu file UTF-8
+		h = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		index[h] = i
+	}
+	for _, col := range requiredImportColumns[kind] {
+		if _, ok := index[col]; !ok {
+			return nil, fmt.Errorf("missing required column %q", col)
+		}
+	}
+
+	var rows []*csvRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %v", err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		row := &csvRow{line: int32(line), values: make(map[string]string)}
+		for _, col := range importColumns[kind] {
+			if i, ok := index[col]; ok && i < len(record) {
+				row.values[col] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("file has no data rows")
+	}
+	return rows, nil
+}
+
+// ImportProducts imports products or branch inventory from a CSV file
+// @Summary Bulk import products or inventory
+// @Description Imports products (kind=products: product_type, product_id, name, description, price, is_attachable, imgurl; empty product_id creates a new product) or branch stock (kind=inventory: branch_id, product_type, product_id, variant_id, stock_quantity; variant_id is empty or 0 for products without variants and required for products with active variants) from a CSV file with a header row. All rows are validated first and applied in a single transaction; if any row has an error nothing is written. Use dry_run=true to only get the validation report
+// @Tags Products
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param kind query string true "products or inventory"
+// @Param dry_run query bool false "Validate only, do not write"
+// @Param file formData file true "CSV file"
+// @Success 200 {object} object{dry_run=boolean,applied=boolean,total_rows=integer,created=integer,updated=integer,errors=array{row=integer,field=string,message=string}} "Import report"
+// @Failure 400 {object} object{error=string} "Invalid kind or unreadable file"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 422 {object} object{dry_run=boolean,applied=boolean,total_rows=integer,created=integer,updated=integer,errors=array{row=integer,field=string,message=string}} "Some rows are invalid, nothing was imported"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /products/import [post]
+func (h *ProductHandler) ImportProducts(c echo.Context) error {
+	kind := c.QueryParam("kind")
+	if _, ok := importColumns[kind]; !ok {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "kind must be products or inventory"})
+	}
+	dryRun := c.QueryParam("dry_run") == "true"
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "CSV file is required in field 'file'"})
+	}
+	if fh.Size > maxImportFileSize {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "File exceeds 10 MB"})
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Cannot read file"})
+	}
+	defer f.Close()
+	rows, err := readCSV(io.LimitReader(f, maxImportFileSize), kind)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	// Dòng sai định dạng không gửi sang Products service; nếu có thì chỉ kiểm tra (dry run) các dòng còn lại
+	var parseErrors []importRowError
+	var productRows []*pb.ProductImportRow
+	var inventoryRows []*pb.InventoryImportRow
+	for _, r := range rows {
+		switch kind {
+		case importKindProducts:
+			row := &pb.ProductImportRow{
+				Row:          r.line,
+				ProductType:  r.str("product_type"),
+				ProductId:    r.int32("product_id", true),
+				Name:         r.str("name"),
+				Description:  r.str("description"),
+				Price:        r.float32("price"),
+				IsAttachable: r.bool("is_attachable"),
+				Imgurl:       r.str("imgurl"),
+			}
+			if len(r.errors) == 0 {
+				productRows = append(productRows, row)
+			}
+		case importKindInventory:
+			row := &pb.InventoryImportRow{
+				Row:           r.line,
+				BranchId:      r.int32("branch_id", false),
+				ProductType:   r.str("product_type"),
+				ProductId:     r.int32("product_id", false),
+				VariantId:     r.int32("variant_id", true),
+				StockQuantity: r.int32("stock_quantity", false),
+			}
+			if len(r.errors) == 0 {
+				inventoryRows = append(inventoryRows, row)
+			}
+		}
+		parseErrors = append(parseErrors, r.errors...)
+	}
+
+	report := importReport{DryRun: dryRun, TotalRows: int32(len(rows)), Errors: []importRowError{}}
+	if len(productRows) > 0 || len(inventoryRows) > 0 {
+		ctx := c.Request().Context()
+		var resp *pb.ImportResponse
+		if kind == importKindProducts {
+			resp, err = h.client.ImportProducts(ctx, &pb.ImportProductsRequest{Rows: productRows, DryRun: dryRun || len(parseErrors) > 0})
+		} else {
+			resp, err = h.client.ImportInventory(ctx, &pb.ImportInventoryRequest{Rows: inventoryRows, DryRun: dryRun || len(parseErrors) > 0})
+		}
+		if err != nil {
+			return grpcErrorToHTTP(c, err)
+		}
+		report.Applied = resp.Applied
+		report.Created = resp.Created
+		report.Updated = resp.Updated
+		for _, e := range resp.Errors {
+			report.Errors = append(report.Errors, importRowError{Row: e.Row, Field: e.Field, Message: e.Message})
+		}
+	}
+	report.Errors = append(report.Errors, parseErrors...)
+	sort.SliceStable(report.Errors, func(i, j int) bool { return report.Errors[i].Row < report.Errors[j].Row })
+
+	if len(report.Errors) > 0 && !dryRun {
+		return c.JSON(http.StatusUnprocessableEntity, report)
+	}
+	return c.JSON(http.StatusOK, report)
+}
+
+// ExportProducts exports products or branch inventory as CSV
+// @Summary Export products or inventory as CSV
+// @Description Exports all products (kind=products, with total stock across branches) or the stock of every product and variant at every active branch (kind=inventory, one row per product variant). The file uses the same columns as the import endpoint and can be re-imported after editing
+// @Tags Products
+// @Produce text/csv
+// @Security BearerAuth
+// @Param kind query string true "products or inventory"
+// @Success 200 {file} file "CSV file"
+// @Failure 400 {object} object{error=string} "Invalid kind"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /products/export [get]
+func (h *ProductHandler) ExportProducts(c echo.Context) error {
+	kind := c.QueryParam("kind")
+	if _, ok := importColumns[kind]; !ok {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "kind must be products or inventory"})
+	}
+	ctx := c.Request().Context()
+	resp, err := h.client.ListAllProductsWithStock(ctx, &pb.ListAllProductsRequest{})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	// Tồn kho theo chi nhánh được lấy hết trước khi ghi header, lỗi vẫn trả về được JSON
+	var branches *pb.ListBranchResponse
+	inventories := make(map[int32][]*pb.BranchProduct)
+	if kind == importKindInventory {
+		if branches, err = h.client.ListBranches(ctx, &pb.ListBranchRequest{}); err != nil {
+			return grpcErrorToHTTP(c, err)
+		}
+		for _, b := range branches.Branches {
+			inv, err := h.client.GetBranchInventory(ctx, &pb.GetBranchInventoryRequest{BranchId: b.Id})
+			if err != nil {
+				return grpcErrorToHTTP(c, err)
+			}
+			list := inv.Inventory
+			sort.Slice(list, func(i, j int) bool {
+				if list[i].ProductType != list[j].ProductType {
+					return list[i].ProductType < list[j].ProductType
+				}
+				if list[i].ProductId != list[j].ProductId {
+					return list[i].ProductId < list[j].ProductId
+				}
+				return list[i].VariantId < list[j].VariantId
+			})
+			inventories[b.Id] = list
+		}
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", kind+".csv"))
+	res.WriteHeader(http.StatusOK)
+	w := csv.NewWriter(res)
+	itoa := func(n int32) string { return strconv.FormatInt(int64(n), 10) }
+
+	if kind == importKindProducts {
+		w.Write(append(importColumns[importKindProducts], "total_stock"))
+		for _, p := range resp.Products {
+			var total int32
+			for _, inv := range p.Inventory {
+				total += inv.Quantity
+			}
+			w.Write([]string{
+				p.ProductType, itoa(p.ProductId), p.Name, p.Description,
+				strconv.FormatFloat(float64(p.Price), 'f', -1, 32),
+				strconv.FormatBool(p.IsAttachable), p.Imgurl, itoa(total),
+			})
+		}
+	} else {
+		// Một dòng cho mỗi dòng tồn kho (chi nhánh, sản phẩm, biến thể) như lúc nhập, không cộng dồn các biến thể
+		names := make(map[string]string, len(resp.Products))
+		for _, p := range resp.Products {
+			names[fmt.Sprintf("%s:%d", p.ProductType, p.ProductId)] = p.Name
+		}
+		w.Write(append(importColumns[importKindInventory], "name"))
+		for _, b := range branches.Branches {
+			for _, bp := range inventories[b.Id] {
+				name, ok := names[fmt.Sprintf("%s:%d", bp.ProductType, bp.ProductId)]
+				if !ok {
+					continue // sản phẩm đã lưu trữ, không nhập lại được
+				}
+				w.Write([]string{itoa(bp.BranchId), bp.ProductType, itoa(bp.ProductId), itoa(bp.VariantId), itoa(bp.StockQuantity), name})
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
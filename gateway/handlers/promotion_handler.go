@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	pb "github.com/quanbin27/commons/genproto/products"
+)
+
+// promotionRequest - Thông tin khuyến mãi dùng chung cho tạo mới và cập nhật
+type promotionRequest struct {
+	Name             string  `json:"name"`
+	Description      string  `json:"description"`
+	Code             string  `json:"code"`
+	Type             string  `json:"type"`
+	Value            float32 `json:"value"`
+	MaxDiscount      float32 `json:"max_discount"`
+	BuyQuantity      int32   `json:"buy_quantity"`
+	GetQuantity      int32   `json:"get_quantity"`
+	Scope            string  `json:"scope"`
+	Category         string  `json:"category"`
+	ItemType         string  `json:"item_type"`
+	ItemID           int32   `json:"item_id"`
+	MinSpend         float32 `json:"min_spend"`
+	StartsAt         string  `json:"starts_at"`
+	EndsAt           string  `json:"ends_at"`
+	UsageLimit       int32   `json:"usage_limit"`
+	PerCustomerLimit int32   `json:"per_customer_limit"`
+}
+
+func (r *promotionRequest) toProto(id int32) *pb.Promotion {
+	return &pb.Promotion{
+		Id:               id,
+		Name:             r.Name,
+		Description:      r.Description,
+		Code:             r.Code,
+		Type:             r.Type,
+		Value:            r.Value,
+		MaxDiscount:      r.MaxDiscount,
+		BuyQuantity:      r.BuyQuantity,
+		GetQuantity:      r.GetQuantity,
+		Scope:            r.Scope,
+		Category:         r.Category,
+		ItemType:         r.ItemType,
+		ItemId:           r.ItemID,
+		MinSpend:         r.MinSpend,
+		StartsAt:         r.StartsAt,
+		EndsAt:           r.EndsAt,
+		UsageLimit:       r.UsageLimit,
+		PerCustomerLimit: r.PerCustomerLimit,
+	}
+}
+
+// ListPromotions lists promotions
+// @Summary List promotions
+// @Description Retrieves active promotions, or all promotions when include_inactive=true
+// @Tags Promotions
+// @Produce json
+// @Security BearerAuth
+// @Param include_inactive query bool false "Include deactivated promotions"
+// @Success 200 {array} object{id=int32,name=string,code=string,type=string,value=number,scope=string,min_spend=number,starts_at=string,ends_at=string,usage_limit=int32,per_customer_limit=int32,used_count=int32,is_active=boolean} "List of promotions"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /promotions [get]
+func (h *ProductHandler) ListPromotions(c echo.Context) error {
+	resp, err := h.client.ListPromotions(c.Request().Context(), &pb.ListPromotionsRequest{
+		IncludeInactive: c.QueryParam("include_inactive") == "true",
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Promotions)
+}
+
+// CreatePromotion creates a promotion
+// @Summary Create a promotion
+// @Description Creates a promotion. type is percentage (value in %), fixed (value is an amount) or buy_x_get_y (buy_quantity + get_quantity of the same item). scope is all, category (food, accessory, medicine, service) or product (item_type + item_id). An empty code makes the promotion apply automatically; otherwise customers must enter the code. Times are RFC3339, limits of 0 mean unlimited
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{name=string,description=string,code=string,type=string,value=number,max_discount=number,buy_quantity=int32,get_quantity=int32,scope=string,category=string,item_type=string,item_id=int32,min_spend=number,starts_at=string,ends_at=string,usage_limit=int32,per_customer_limit=int32} true "Promotion details"
+// @Success 200 {object} object{promotion_id=int32,status=string} "Promotion created successfully"
+// @Failure 400 {object} object{error=string} "Invalid promotion or duplicate code"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /promotions [post]
+func (h *ProductHandler) CreatePromotion(c echo.Context) error {
+	var req promotionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.CreatePromotion(c.Request().Context(), &pb.CreatePromotionRequest{Promotion: req.toProto(0)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"promotion_id": resp.PromotionId, "status": resp.Status})
+}
+
+// UpdatePromotion updates a promotion
+// @Summary Update a promotion
+// @Description Replaces the conditions of a promotion. Usage count and active status are kept
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Promotion ID"
+// @Param request body object{name=string,description=string,code=string,type=string,value=number,max_discount=number,buy_quantity=int32,get_quantity=int32,scope=string,category=string,item_type=string,item_id=int32,min_spend=number,starts_at=string,ends_at=string,usage_limit=int32,per_customer_limit=int32} true "Promotion details"
+// @Success 200 {object} object{status=string} "Promotion updated successfully"
+// @Failure 400 {object} object{error=string} "Invalid promotion or duplicate code"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Promotion not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /promotions/{id} [put]
+func (h *ProductHandler) UpdatePromotion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	var req promotionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.UpdatePromotion(c.Request().Context(), &pb.UpdatePromotionRequest{Promotion: req.toProto(int32(id))})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// DeactivatePromotion deactivates a promotion
+// @Summary Deactivate a promotion
+// @Description Stops a promotion from being applied to new orders and appointments
+// @Tags Promotions
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Promotion ID"
+// @Success 200 {object} object{status=string} "Promotion deactivated successfully"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Promotion not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /promotions/{id}/deactivate [put]
+func (h *ProductHandler) DeactivatePromotion(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	resp, err := h.client.DeactivatePromotion(c.Request().Context(), &pb.DeactivatePromotionRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// QuoteCart prices a cart with promotions without redeeming them
+// @Summary Quote cart price
+// @Description Prices a cart of products and/or services (item_type food, accessory, medicine or service) with the automatic promotions and the given coupon codes. Nothing is redeemed; the same pricing is applied when the order or appointment is created
+// @Tags Promotions
+// @Accept json
+// @Produce json
+// @Param request body object{customer_id=int32,items=array{item_type=string,item_id=int32,quantity=int32,unit_price=number},coupon_codes=array{string}} true "Cart"
+// @Success 200 {object} object{subtotal=number,discount=number,total=number,applied_promotions=array{promotion_id=int32,code=string,name=string,discount=number}} "Cart pricing"
+// @Failure 400 {object} object{error=string} "Invalid cart or unknown coupon code"
+// @Failure 409 {object} object{error=string} "Coupon cannot be used (expired, usage limit reached, minimum spend not met...)"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /promotions/quote [post]
+func (h *ProductHandler) QuoteCart(c echo.Context) error {
+	var req struct {
+		CustomerID int32 `json:"customer_id"`
+		Items      []struct {
+			ItemType  string  `json:"item_type"`
+			ItemID    int32   `json:"item_id"`
+			Quantity  int32   `json:"quantity"`
+			UnitPrice float32 `json:"unit_price"`
+		} `json:"items"`
+		CouponCodes []string `json:"coupon_codes"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	items := make([]*pb.CartItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = &pb.CartItem{ItemType: item.ItemType, ItemId: item.ItemID, Quantity: item.Quantity, UnitPrice: item.UnitPrice}
+	}
+	resp, err := h.client.PriceCart(c.Request().Context(), &pb.PriceCartRequest{
+		CustomerId:  req.CustomerID,
+		Items:       items,
+		CouponCodes: req.CouponCodes,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/commons/auth"
+	pb "github.com/quanbin27/commons/genproto/products"
+)
+
+// CreateReview lets a customer review a product they bought
+// @Summary Review a purchased product
+// @Description Creates a 1-5 star review with an optional comment for the logged-in customer. Only customers with a COMPLETED order containing the product may review it, once per product. New reviews are pending until staff approve them
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{product_id=int32,product_type=string,rating=int32,comment=string} true "Review"
+// @Success 200 {object} object{id=int32,product_id=int32,product_type=string,customer_id=int32,order_id=int32,rating=int32,comment=string,status=string,created_at=string} "Review created, pending moderation"
+// @Failure 400 {object} object{error=string} "Invalid rating, comment or product type"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Product not found"
+// @Failure 409 {object} object{error=string} "Product not purchased or already reviewed"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /reviews [post]
+func (h *ProductHandler) CreateReview(c echo.Context) error {
+	customerID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	var req struct {
+		ProductID   int32  `json:"product_id"`
+		ProductType string `json:"product_type"`
+		Rating      int32  `json:"rating"`
+		Comment     string `json:"comment"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.CreateReview(c.Request().Context(), &pb.CreateReviewRequest{
+		CustomerId:  customerID,
+		ProductId:   req.ProductID,
+		ProductType: req.ProductType,
+		Rating:      req.Rating,
+		Comment:     req.Comment,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ListProductReviews lists the approved reviews of a product
+// @Summary List product reviews
+// @Description Retrieves one page of the approved reviews of a product, newest first, with the average rating and review count over all approved reviews
+// @Tags Reviews
+// @Produce json
+// @Param product_type path string true "Product type (food, accessory, medicine)"
+// @Param id path int true "Product ID"
+// @Param page query int false "Page number (1-based, default 1)"
+// @Param page_size query int false "Items per page (default 20, max 100)"
+// @Success 200 {object} object{reviews=array,average_rating=number,review_count=int32} "Approved reviews"
+// @Failure 400 {object} object{error=string} "Invalid product type, ID or paging"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /reviews/products/{product_type}/{id} [get]
+func (h *ProductHandler) ListProductReviews(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	page, err := optionalInt32Query(c, "page")
+	if err != nil {
+		return err
+	}
+	pageSize, err := optionalInt32Query(c, "page_size")
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.ListProductReviews(c.Request().Context(), &pb.ListProductReviewsRequest{
+		ProductId:   int32(id),
+		ProductType: c.Param("product_type"),
+		Page:        page,
+		PageSize:    pageSize,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"reviews":        resp.Reviews,
+		"average_rating": resp.AverageRating,
+		"review_count":   resp.ReviewCount,
+	})
+}
+
+// ListReviews lists reviews for moderation
+// @Summary List reviews for moderation
+// @Description Retrieves one page of reviews filtered by status (pending, approved, rejected) and optionally by product, newest first
+// @Tags Reviews
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Review status (pending, approved, rejected)"
+// @Param product_id query int false "Product ID"
+// @Param product_type query string false "Product type, required with product_id"
+// @Param page query int false "Page number (1-based, default 1)"
+// @Param page_size query int false "Items per page (default 20, max 100)"
+// @Success 200 {array} object{id=int32,product_id=int32,product_type=string,customer_id=int32,order_id=int32,rating=int32,comment=string,status=string,moderated_by=int32,moderation_note=string,created_at=string,updated_at=string} "Reviews"
+// @Failure 400 {object} object{error=string} "Invalid filter or paging"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /reviews [get]
+func (h *ProductHandler) ListReviews(c echo.Context) error {
+	req := &pb.ListReviewsRequest{
+		Status:      c.QueryParam("status"),
+		ProductType: c.QueryParam("product_type"),
+	}
+	if v := c.QueryParam("product_id"); v != "" {
+		id, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid product_id, must be an integer"})
+		}
+		req.ProductId = int32(id)
+	}
+	var err error
+	if req.Page, err = optionalInt32Query(c, "page"); err != nil {
+		return err
+	}
+	if req.PageSize, err = optionalInt32Query(c, "page_size"); err != nil {
+		return err
+	}
+	resp, err := h.client.ListReviews(c.Request().Context(), req)
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Reviews)
+}
+
+// ModerateReview approves or rejects a review
+// @Summary Moderate a review
+// @Description Approves or rejects a review. Only approved reviews are shown and counted in product ratings; a review can be moderated again later
+// @Tags Reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Review ID"
+// @Param request body object{status=string,note=string} true "approved or rejected, with an optional note"
+// @Success 200 {object} object{id=int32,status=string,moderated_by=int32,moderation_note=string} "Review moderated"
+// @Failure 400 {object} object{error=string} "Invalid ID or status"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Review not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /reviews/{id}/moderate [put]
+func (h *ProductHandler) ModerateReview(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	moderatorID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	var req struct {
+		Status string `json:"status"`
+		Note   string `json:"note"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.ModerateReview(c.Request().Context(), &pb.ModerateReviewRequest{
+		Id:          int32(id),
+		Status:      req.Status,
+		ModeratorId: moderatorID,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
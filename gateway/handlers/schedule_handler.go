@@ -0,0 +1,557 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/commons/auth"
+	pb "github.com/quanbin27/commons/genproto/appointments"
+	"github.com/quanbin27/commons/utils"
+)
+
+// Khung giờ trống của chi nhánh, ca làm việc và nghỉ phép của nhân viên (AppointmentHandler)
+
+// GetAvailableSlots lists bookable time slots of a branch on a day
+// @Summary Available appointment slots
+// @Description Lists the start times (every 30 minutes) on the given day at which the branch can take an appointment for the given services: within opening hours for the whole duration, under the branch appointment capacity and, when shifts are set up for that day, with a free staff member on shift. With customer_address, staff also need enough travel time from and to their other home visits; customer_address is only used for signed-in callers (Authorization header) and ignored otherwise. Past slots are not returned
+// @Tags Appointments
+// @Produce json
+// @Param branch_id query int true "Branch ID"
+// @Param service_ids query string true "Comma-separated service IDs, repeat an ID to book it twice"
+// @Param date query string true "Day in the branch's local time, YYYY-MM-DD"
+// @Param customer_address query string false "Address of the home visit, only used when signed in"
+// @Success 200 {object} object{timezone=string,duration_minutes=integer,slots=array{start_time=string,end_time=string,remaining_capacity=integer,available_employee_ids=array{integer}}} "Available slots, remaining_capacity = -1 means unlimited"
+// @Failure 400 {object} object{error=string} "Invalid branch_id, service_ids or date"
+// @Failure 404 {object} object{error=string} "Branch not found"
+// @Failure 409 {object} object{error=string} "A service is archived"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/slots [get]
+func (h *AppointmentHandler) GetAvailableSlots(c echo.Context) error {
+	branchID, err := strconv.ParseInt(c.QueryParam("branch_id"), 10, 32)
+	if err != nil || branchID <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid branch_id, must be a positive integer"})
+	}
+	var serviceIDs []int32
+	for _, raw := range strings.Split(c.QueryParam("service_ids"), ",") {
+		if raw = strings.TrimSpace(raw); raw == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil || id <= 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid service_ids, must be comma-separated positive integers"})
+		}
+		serviceIDs = append(serviceIDs, int32(id))
+	}
+	// Địa chỉ phải tra toạ độ qua geocoder bên ngoài (giới hạn lượt gọi), nên khách vãng lai không được dùng
+	address := c.QueryParam("customer_address")
+	if address != "" && !signedIn(c) {
+		address = ""
+	}
+	resp, err := h.client.GetAvailableSlots(c.Request().Context(), &pb.GetAvailableSlotsRequest{
+		BranchId:        int32(branchID),
+		ServiceIds:      serviceIDs,
+		Date:            c.QueryParam("date"),
+		CustomerAddress: address,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	slots := make([]map[string]interface{}, 0, len(resp.Slots))
+	for _, slot := range resp.Slots {
+		employees := slot.AvailableEmployeeIds
+		if employees == nil {
+			employees = []int32{}
+		}
+		slots = append(slots, map[string]interface{}{
+			"start_time":             slot.StartTime,
+			"end_time":               slot.EndTime,
+			"remaining_capacity":     slot.RemainingCapacity,
+			"available_employee_ids": employees,
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"timezone":         resp.Timezone,
+		"duration_minutes": resp.DurationMinutes,
+		"slots":            slots,
+	})
+}
+
+// signedIn - request có token hợp lệ, dùng cho route công khai không qua middleware xác thực
+func signedIn(c echo.Context) bool {
+	tokenString := utils.GetTokenFromRequest(c)
+	if tokenString == "" {
+		return false
+	}
+	token, err := auth.ValidateJWT(tokenString)
+	return err == nil && token.Valid
+}
+
+// CreateEmployeeShift adds a work shift
+// @Summary Create an employee shift
+// @Description Adds a one-off shift of an employee at a branch. Once a branch has shifts on a day, appointments there need a free staff member on shift. Shifts of the same employee must not overlap
+// @Tags Shifts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{employee_id=integer,branch_id=integer,start_time=string,end_time=string} true "Shift, times in RFC3339"
+// @Success 200 {object} object{id=integer,employee_id=integer,branch_id=integer,start_time=string,end_time=string,template_id=integer} "Shift created"
+// @Failure 400 {object} object{error=string} "Invalid request or time range"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 409 {object} object{error=string} "Shift overlaps another shift of the employee"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /shifts [post]
+func (h *AppointmentHandler) CreateEmployeeShift(c echo.Context) error {
+	var req struct {
+		EmployeeID int32  `json:"employee_id"`
+		BranchID   int32  `json:"branch_id"`
+		StartTime  string `json:"start_time"`
+		EndTime    string `json:"end_time"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	shift, err := h.client.CreateEmployeeShift(c.Request().Context(), &pb.CreateEmployeeShiftRequest{
+		EmployeeId: req.EmployeeID,
+		BranchId:   req.BranchID,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, shift)
+}
+
+// ListEmployeeShifts lists shifts in a time range
+// @Summary List employee shifts
+// @Description Lists the shifts overlapping [from, to), optionally filtered by branch and employee
+// @Tags Shifts
+// @Produce json
+// @Security BearerAuth
+// @Param branch_id query int false "Branch ID"
+// @Param employee_id query int false "Employee ID"
+// @Param from query string true "Start of the range, RFC3339"
+// @Param to query string true "End of the range, RFC3339"
+// @Success 200 {array} object{id=integer,employee_id=integer,branch_id=integer,start_time=string,end_time=string,template_id=integer} "Shifts ordered by start time"
+// @Failure 400 {object} object{error=string} "Invalid filter or time range"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /shifts [get]
+func (h *AppointmentHandler) ListEmployeeShifts(c echo.Context) error {
+	branchID, err := optionalInt32Query(c, "branch_id")
+	if err != nil {
+		return err
+	}
+	employeeID, err := optionalInt32Query(c, "employee_id")
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.ListEmployeeShifts(c.Request().Context(), &pb.ListEmployeeShiftsRequest{
+		BranchId:   branchID,
+		EmployeeId: employeeID,
+		From:       c.QueryParam("from"),
+		To:         c.QueryParam("to"),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	shifts := resp.Shifts
+	if shifts == nil {
+		shifts = []*pb.EmployeeShift{}
+	}
+	return c.JSON(http.StatusOK, shifts)
+}
+
+// DeleteEmployeeShift removes a shift
+// @Summary Delete an employee shift
+// @Description Removes a shift. Upcoming pending appointments of the employee that are no longer covered by a shift are automatically reassigned to another free employee (employee_id 0 = left unassigned)
+// @Tags Shifts
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Shift ID"
+// @Success 200 {object} object{status=string,reassignments=array{appointment_id=integer,employee_id=integer}} "Shift deleted"
+// @Failure 400 {object} object{error=string} "Invalid shift ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Shift not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /shifts/{id} [delete]
+func (h *AppointmentHandler) DeleteEmployeeShift(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	resp, err := h.client.DeleteEmployeeShift(c.Request().Context(), &pb.DeleteEmployeeShiftRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status":        resp.Status,
+		"reassignments": reassignmentsJSON(resp.Reassignments),
+	})
+}
+
+// reassignmentsJSON trả về mảng rỗng thay vì null khi không có lịch nào được phân công lại
+func reassignmentsJSON(reassignments []*pb.Reassignment) []map[string]int32 {
+	result := make([]map[string]int32, len(reassignments))
+	for i, r := range reassignments {
+		result[i] = map[string]int32{"appointment_id": r.AppointmentId, "employee_id": r.EmployeeId}
+	}
+	return result
+}
+
+// CreateShiftTemplate adds a recurring weekly shift
+// @Summary Create a weekly shift template
+// @Description Adds a shift repeated every week on the given weekday, in the branch's local time. Shifts for the next 14 days are generated right away and kept rolling forward automatically
+// @Tags Shifts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{employee_id=integer,branch_id=integer,weekday=integer,start_time=string,end_time=string,valid_from=string,valid_until=string} true "Template: weekday 0 = Sunday, times HH:MM, dates YYYY-MM-DD (valid_from defaults to today, empty valid_until never expires)"
+// @Success 200 {object} object{id=integer,employee_id=integer,branch_id=integer,weekday=integer,start_time=string,end_time=string,valid_from=string,valid_until=string} "Template created"
+// @Failure 400 {object} object{error=string} "Invalid weekday, time or date"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 409 {object} object{error=string} "Template overlaps another template of the employee"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /shifts/templates [post]
+func (h *AppointmentHandler) CreateShiftTemplate(c echo.Context) error {
+	var req struct {
+		EmployeeID int32  `json:"employee_id"`
+		BranchID   int32  `json:"branch_id"`
+		Weekday    int32  `json:"weekday"`
+		StartTime  string `json:"start_time"`
+		EndTime    string `json:"end_time"`
+		ValidFrom  string `json:"valid_from"`
+		ValidUntil string `json:"valid_until"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	template, err := h.client.CreateShiftTemplate(c.Request().Context(), &pb.CreateShiftTemplateRequest{
+		EmployeeId: req.EmployeeID,
+		BranchId:   req.BranchID,
+		Weekday:    req.Weekday,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		ValidFrom:  req.ValidFrom,
+		ValidUntil: req.ValidUntil,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, template)
+}
+
+// ListShiftTemplates lists weekly shift templates
+// @Summary List weekly shift templates
+// @Description Lists recurring weekly shifts, optionally filtered by branch and employee
+// @Tags Shifts
+// @Produce json
+// @Security BearerAuth
+// @Param branch_id query int false "Branch ID"
+// @Param employee_id query int false "Employee ID"
+// @Success 200 {array} object{id=integer,employee_id=integer,branch_id=integer,weekday=integer,start_time=string,end_time=string,valid_from=string,valid_until=string} "Templates"
+// @Failure 400 {object} object{error=string} "Invalid filter"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /shifts/templates [get]
+func (h *AppointmentHandler) ListShiftTemplates(c echo.Context) error {
+	branchID, err := optionalInt32Query(c, "branch_id")
+	if err != nil {
+		return err
+	}
+	employeeID, err := optionalInt32Query(c, "employee_id")
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.ListShiftTemplates(c.Request().Context(), &pb.ListShiftTemplatesRequest{
+		BranchId:   branchID,
+		EmployeeId: employeeID,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	templates := resp.Templates
+	if templates == nil {
+		templates = []*pb.ShiftTemplate{}
+	}
+	return c.JSON(http.StatusOK, templates)
+}
+
+// DeleteShiftTemplate removes a weekly shift template
+// @Summary Delete a weekly shift template
+// @Description Removes the template and the shifts generated from it that have not started yet. Upcoming pending appointments of the employee that are no longer covered by a shift are automatically reassigned (employee_id 0 = left unassigned)
+// @Tags Shifts
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Template ID"
+// @Success 200 {object} object{status=string,reassignments=array{appointment_id=integer,employee_id=integer}} "Template deleted"
+// @Failure 400 {object} object{error=string} "Invalid template ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Template not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /shifts/templates/{id} [delete]
+func (h *AppointmentHandler) DeleteShiftTemplate(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	resp, err := h.client.DeleteShiftTemplate(c.Request().Context(), &pb.DeleteShiftTemplateRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status":        resp.Status,
+		"reassignments": reassignmentsJSON(resp.Reassignments),
+	})
+}
+
+// GenerateShifts generates shifts from weekly templates
+// @Summary Generate shifts from templates
+// @Description Creates the shifts of the weekly templates for the days from_date..to_date (at most 62 days). Days where the employee already has an overlapping shift are skipped, so it is safe to run again
+// @Tags Shifts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{branch_id=integer,from_date=string,to_date=string} true "Branch (0 = all) and days, YYYY-MM-DD"
+// @Success 200 {object} object{created=integer} "Number of shifts created"
+// @Failure 400 {object} object{error=string} "Invalid date range"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /shifts/generate [post]
+func (h *AppointmentHandler) GenerateShifts(c echo.Context) error {
+	var req struct {
+		BranchID int32  `json:"branch_id"`
+		FromDate string `json:"from_date"`
+		ToDate   string `json:"to_date"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.GenerateShifts(c.Request().Context(), &pb.GenerateShiftsRequest{
+		BranchId: req.BranchID,
+		FromDate: req.FromDate,
+		ToDate:   req.ToDate,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]int32{"created": resp.Created})
+}
+
+// RequestLeave submits a leave request for the logged-in employee
+// @Summary Request leave
+// @Description Submits a leave request for the logged-in employee. It must not overlap a pending or approved request; once approved the employee cannot be booked during the leave
+// @Tags Leaves
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{start_time=string,end_time=string,reason=string} true "Leave, times in RFC3339"
+// @Success 200 {object} object{id=integer,employee_id=integer,start_time=string,end_time=string,reason=string,status=string} "Leave request created"
+// @Failure 400 {object} object{error=string} "Invalid time range"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 409 {object} object{error=string} "Overlaps another leave request"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /leaves [post]
+func (h *AppointmentHandler) RequestLeave(c echo.Context) error {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	var req struct {
+		StartTime string `json:"start_time"`
+		EndTime   string `json:"end_time"`
+		Reason    string `json:"reason"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	leave, err := h.client.RequestLeave(c.Request().Context(), &pb.RequestLeaveRequest{
+		EmployeeId: userID,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, leave)
+}
+
+// ListLeaves lists leave requests in a time range
+// @Summary List leave requests
+// @Description Lists leave requests overlapping [from, to), optionally filtered by employee and status
+// @Tags Leaves
+// @Produce json
+// @Security BearerAuth
+// @Param employee_id query int false "Employee ID"
+// @Param status query string false "pending, approved or rejected"
+// @Param from query string true "Start of the range, RFC3339"
+// @Param to query string true "End of the range, RFC3339"
+// @Success 200 {array} object{id=integer,employee_id=integer,start_time=string,end_time=string,reason=string,status=string,reviewed_by=integer,reviewed_at=string} "Leave requests"
+// @Failure 400 {object} object{error=string} "Invalid filter or time range"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /leaves [get]
+func (h *AppointmentHandler) ListLeaves(c echo.Context) error {
+	employeeID, err := optionalInt32Query(c, "employee_id")
+	if err != nil {
+		return err
+	}
+	resp, err := h.client.ListLeaves(c.Request().Context(), &pb.ListLeavesRequest{
+		EmployeeId: employeeID,
+		Status:     c.QueryParam("status"),
+		From:       c.QueryParam("from"),
+		To:         c.QueryParam("to"),
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	leaves := resp.Leaves
+	if leaves == nil {
+		leaves = []*pb.EmployeeLeave{}
+	}
+	return c.JSON(http.StatusOK, leaves)
+}
+
+// ReviewLeave approves or rejects a pending leave request
+// @Summary Review a leave request
+// @Description Approves or rejects a pending leave request. When approved, the appointments already assigned to the employee during the leave are returned so they can be reassigned
+// @Tags Leaves
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Leave request ID"
+// @Param request body object{approve=boolean} true "Decision"
+// @Success 200 {object} object{leave=object,affected_appointment_ids=array{integer}} "Leave reviewed"
+// @Failure 400 {object} object{error=string} "Invalid leave request ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Leave request not found"
+// @Failure 409 {object} object{error=string} "Leave request already reviewed"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /leaves/{id}/review [put]
+func (h *AppointmentHandler) ReviewLeave(c echo.Context) error {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return err
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	var req struct {
+		Approve bool `json:"approve"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.ReviewLeave(c.Request().Context(), &pb.ReviewLeaveRequest{
+		Id:         int32(id),
+		Approve:    req.Approve,
+		ReviewerId: userID,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	affected := resp.AffectedAppointmentIds
+	if affected == nil {
+		affected = []int32{}
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"leave":                    resp.Leave,
+		"affected_appointment_ids": affected,
+	})
+}
+
+// AutoAssignAppointment assigns a free employee to an appointment
+// @Summary Auto-assign an employee to an appointment
+// @Description Picks an employee who is on shift at the branch for the whole appointment, not on leave and not busy, using the configured strategy (skill_match, least_loaded or round_robin). Only pending appointments can be assigned
+// @Tags Appointments
+// @Produce json
+// @Security BearerAuth
+// @Param appointment_id path int true "Appointment ID"
+// @Success 200 {object} object{employee_id=integer,status=string} "Employee assigned"
+// @Failure 400 {object} object{error=string} "Invalid appointment ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Appointment not found"
+// @Failure 409 {object} object{error=string} "Appointment is not pending or no employee is available"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/{appointment_id}/auto-assign [put]
+func (h *AppointmentHandler) AutoAssignAppointment(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("appointment_id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	resp, err := h.client.AutoAssignAppointment(c.Request().Context(), &pb.AutoAssignAppointmentRequest{AppointmentId: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"employee_id": resp.EmployeeId,
+		"status":      resp.Status,
+	})
+}
+
+// SetEmployeeSkills replaces the services an employee can perform
+// @Summary Set employee skills
+// @Description Replaces the list of services the employee can perform. With the skill_match strategy, a service assigned to at least one employee is only auto-assigned to employees having that skill
+// @Tags Employees
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param employee_id path int true "Employee ID"
+// @Param request body object{service_ids=array{integer}} true "Service IDs (empty clears all skills)"
+// @Success 200 {object} object{status=string} "Skills updated"
+// @Failure 400 {object} object{error=string} "Invalid employee ID or unknown service"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /employees/{employee_id}/skills [put]
+func (h *AppointmentHandler) SetEmployeeSkills(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("employee_id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	var req struct {
+		ServiceIDs []int32 `json:"service_ids"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.SetEmployeeSkills(c.Request().Context(), &pb.SetEmployeeSkillsRequest{
+		EmployeeId: int32(id),
+		ServiceIds: req.ServiceIDs,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// ListEmployeeSkills lists the services an employee can perform
+// @Summary List employee skills
+// @Description Returns the IDs of the services the employee can perform
+// @Tags Employees
+// @Produce json
+// @Security BearerAuth
+// @Param employee_id path int true "Employee ID"
+// @Success 200 {object} object{service_ids=array{integer}} "Service IDs"
+// @Failure 400 {object} object{error=string} "Invalid employee ID"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /employees/{employee_id}/skills [get]
+func (h *AppointmentHandler) ListEmployeeSkills(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("employee_id"), 10, 32)
+	if err != nil || id <= 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	resp, err := h.client.ListEmployeeSkills(c.Request().Context(), &pb.ListEmployeeSkillsRequest{EmployeeId: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	serviceIDs := resp.ServiceIds
+	if serviceIDs == nil {
+		serviceIDs = []int32{}
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"service_ids": serviceIDs})
+}
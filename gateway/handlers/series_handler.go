@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/quanbin27/commons/auth"
+	pb "github.com/quanbin27/commons/genproto/appointments"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Lịch hẹn định kỳ (AppointmentHandler). Khách hàng (role 1) chỉ thao tác được trên chuỗi lịch của mình.
+
+// seriesCustomerID - customer_id gửi cho Appointments service: khách hàng luôn là chính mình, nhân viên dùng giá trị truyền vào
+func seriesCustomerID(c echo.Context, requested int32) (int32, error) {
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return 0, err
+	}
+	if role, _ := c.Get("role").(int); role == 1 {
+		return userID, nil
+	}
+	return requested, nil
+}
+
+func parseSeriesID(c echo.Context) (int32, bool) {
+	id, err := strconv.ParseInt(c.Param("series_id"), 10, 32)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// CreateAppointmentSeries books a recurring appointment
+// @Summary Create a recurring appointment series
+// @Description Books the same services every interval weeks (WEEKLY) or months (MONTHLY, same day of month; months without that day are skipped), starting at start_time and ending at until_date or after count appointments (omit both for no end). The first appointment must be bookable like a one-off appointment. Appointments for the next 8 weeks are created right away and kept rolling forward automatically; occurrences that cannot be booked (branch full, closed) are skipped and reported. employee_id is a preferred employee, replaced by automatic assignment when not available. pet_ids and the pet_id of each service name the customer's pets brought to every appointment, as for a one-off appointment. Customers always book for themselves
+// @Tags Appointments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{customer_id=integer,employee_id=integer,branch_id=integer,customer_address=string,note=string,frequency=string,interval=integer,start_time=string,until_date=string,count=integer,services=array{service_id=integer,quantity=integer,pet_id=string},pet_ids=array{string}} true "Series details, start_time in RFC3339, until_date as YYYY-MM-DD"
+// @Success 200 {object} object{status=string,series=AppointmentSeriesResponse,appointments=array{AppointmentResponse},skipped=array{object{scheduled_time=string,reason=string}}} "Series created"
+// @Failure 400 {object} object{error=string} "Invalid frequency, interval, end condition, services or start_time"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 409 {object} object{error=string} "First appointment cannot be booked or a service is archived"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/series [post]
+func (h *AppointmentHandler) CreateAppointmentSeries(c echo.Context) error {
+	var req struct {
+		CustomerID      int32                `json:"customer_id"`
+		EmployeeID      int32                `json:"employee_id"`
+		BranchID        int32                `json:"branch_id"`
+		CustomerAddress string               `json:"customer_address"`
+		Note            string               `json:"note"`
+		Frequency       string               `json:"frequency"`
+		Interval        int32                `json:"interval"`
+		StartTime       string               `json:"start_time"`
+		UntilDate       string               `json:"until_date"`
+		Count           int32                `json:"count"`
+		Services        []ServiceItemRequest `json:"services"`
+		PetIDs          []string             `json:"pet_ids"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	customerID, err := seriesCustomerID(c, req.CustomerID)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+	startTime, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid start_time, must be RFC3339"})
+	}
+	frequency, ok := pb.RecurrenceFrequency_value[req.Frequency]
+	if !ok || frequency == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid frequency, must be WEEKLY or MONTHLY"})
+	}
+	details := make([]*pb.AppointmentDetail, len(req.Services))
+	for i, svc := range req.Services {
+		details[i] = &pb.AppointmentDetail{ServiceId: svc.ServiceID, Quantity: svc.Quantity, PetId: svc.PetID}
+	}
+	resp, err := h.client.CreateAppointmentSeries(c.Request().Context(), &pb.CreateAppointmentSeriesRequest{
+		CustomerId:      customerID,
+		EmployeeId:      req.EmployeeID,
+		BranchId:        req.BranchID,
+		CustomerAddress: req.CustomerAddress,
+		Note:            req.Note,
+		Frequency:       pb.RecurrenceFrequency(frequency),
+		Interval:        req.Interval,
+		StartTime:       timestamppb.New(startTime),
+		UntilDate:       req.UntilDate,
+		Count:           req.Count,
+		Detail:          details,
+		PetIds:          req.PetIDs,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status":       resp.Status,
+		"series":       toAppointmentSeriesResponse(resp.Series),
+		"appointments": toAppointmentResponses(resp.Appointments),
+		"skipped":      skippedOccurrencesJSON(resp.Skipped),
+	})
+}
+
+// GetAppointmentSeries returns a recurring series with its appointments
+// @Summary Get a recurring appointment series
+// @Description Returns the series and every appointment generated from it so far, oldest first
+// @Tags Appointments
+// @Produce json
+// @Security BearerAuth
+// @Param series_id path int true "Series ID"
+// @Success 200 {object} object{series=AppointmentSeriesResponse,appointments=array{AppointmentResponse}} "Series"
+// @Failure 400 {object} object{error=string} "Invalid series ID"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Series not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/series/{series_id} [get]
+func (h *AppointmentHandler) GetAppointmentSeries(c echo.Context) error {
+	id, ok := parseSeriesID(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	customerID, err := seriesCustomerID(c, 0)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+	resp, err := h.client.GetAppointmentSeries(c.Request().Context(), &pb.GetAppointmentSeriesRequest{SeriesId: id, CustomerId: customerID})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"series":       toAppointmentSeriesResponse(resp.Series),
+		"appointments": toAppointmentResponses(resp.Appointments),
+	})
+}
+
+// UpdateAppointmentSeries moves the upcoming appointments of a series
+// @Summary Update a recurring appointment series
+// @Description Moves the whole series: the next upcoming appointment goes to scheduled_time and every later one moves by the same number of days to the same time of day. employee_id changes the preferred employee. Appointments rescheduled individually, already started or cancelled are left alone; appointments whose new slot cannot be booked keep their time and are reported in skipped. To change a single appointment use PUT /appointments/{appointment_id}/reschedule or PUT /appointments/update-status
+// @Tags Appointments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param series_id path int true "Series ID"
+// @Param request body object{scheduled_time=string,employee_id=integer} true "New time of the next appointment (RFC3339, omit to keep) and preferred employee (0 or omitted = keep)"
+// @Success 200 {object} object{status=string,series=AppointmentSeriesResponse,appointments=array{AppointmentResponse},skipped=array{object{scheduled_time=string,reason=string}}} "Series updated, appointments lists the moved appointments"
+// @Failure 400 {object} object{error=string} "Invalid series ID or scheduled time, or nothing to update"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Series not found"
+// @Failure 409 {object} object{error=string} "Series cancelled"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/series/{series_id} [put]
+func (h *AppointmentHandler) UpdateAppointmentSeries(c echo.Context) error {
+	id, ok := parseSeriesID(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	var req struct {
+		ScheduledTime string `json:"scheduled_time"`
+		EmployeeID    int32  `json:"employee_id"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	customerID, err := seriesCustomerID(c, 0)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+	pbReq := &pb.UpdateAppointmentSeriesRequest{SeriesId: id, CustomerId: customerID, EmployeeId: req.EmployeeID}
+	if req.ScheduledTime != "" {
+		scheduledTime, err := time.Parse(time.RFC3339, req.ScheduledTime)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid scheduled_time, must be RFC3339"})
+		}
+		pbReq.ScheduledTime = timestamppb.New(scheduledTime)
+	}
+	resp, err := h.client.UpdateAppointmentSeries(c.Request().Context(), pbReq)
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status":       resp.Status,
+		"series":       toAppointmentSeriesResponse(resp.Series),
+		"appointments": toAppointmentResponses(resp.Appointments),
+		"skipped":      skippedOccurrencesJSON(resp.Skipped),
+	})
+}
+
+// CancelAppointmentSeries cancels a recurring series
+// @Summary Cancel a recurring appointment series
+// @Description Stops generating appointments for the series and cancels its upcoming pending or confirmed appointments. Late cancellation fees apply to each appointment as when cancelling it alone. To cancel a single appointment use PUT /appointments/update-status
+// @Tags Appointments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param series_id path int true "Series ID"
+// @Param request body object{reason=string} true "Cancellation reason"
+// @Success 200 {object} object{status=string,cancelled_appointment_ids=array{integer},cancellation_fee=number} "Series cancelled, cancellation_fee is the total late cancellation fee"
+// @Failure 400 {object} object{error=string} "Invalid series ID or missing reason"
+// @Failure 401 {object} object{error=string} "Unauthorized"
+// @Failure 404 {object} object{error=string} "Series not found"
+// @Failure 409 {object} object{error=string} "Series already cancelled"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /appointments/series/{series_id}/cancel [put]
+func (h *AppointmentHandler) CancelAppointmentSeries(c echo.Context) error {
+	id, ok := parseSeriesID(c)
+	if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be a positive integer"})
+	}
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	userID, err := auth.GetUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+	customerID, _ := seriesCustomerID(c, 0)
+	resp, err := h.client.CancelAppointmentSeries(c.Request().Context(), &pb.CancelAppointmentSeriesRequest{
+		SeriesId:   id,
+		CustomerId: customerID,
+		Reason:     req.Reason,
+		ChangedBy:  userID,
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	cancelled := resp.CancelledAppointmentIds
+	if cancelled == nil {
+		cancelled = []int32{}
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"status":                    resp.Status,
+		"cancelled_appointment_ids": cancelled,
+		"cancellation_fee":          resp.CancellationFee,
+	})
+}
@@ -13,6 +13,8 @@ type ServiceResponse struct {
 	Description string  `json:"description" example:"Complete pet grooming service" description:"Detailed description of the service"`
 	Price       float32 `json:"price" example:"150.50" description:"Price of the service"`
 	ImgURL      string  `json:"imgUrl" example:"https://example.com/images/grooming.jpg" description:"URL to the service image"`
+	// DurationMinutes - thời gian thực hiện một lượt dịch vụ
+	DurationMinutes int32 `json:"durationMinutes" example:"45" description:"Duration of one service in minutes"`
 }
 
 // UserResponse represents a user in the system
@@ -29,13 +31,15 @@ type UserResponse struct {
 // AllProductResponse represents a product with type information
 // @Description Complete product information including type
 type AllProductResponse struct {
-	ID           int32   `json:"id" example:"101" description:"Unique identifier of the product"`
-	Name         string  `json:"name" example:"Premium Dog Food" description:"Name of the product"`
-	Price        float32 `json:"price" example:"45.99" description:"Price of the product"`
-	Description  string  `json:"description" example:"High-quality nutrition for dogs" description:"Detailed description of the product"`
-	ImgURL       string  `json:"imgUrl" example:"https://example.com/images/dog-food.jpg" description:"URL to the product image"`
-	ProductType  string  `json:"productType" example:"Food" description:"Type of the product"`
-	IsAttachable bool    `json:"isAttachable" example:"false" description:"Whether the product can be attached to other products"`
+	ID            int32   `json:"id" example:"101" description:"Unique identifier of the product"`
+	Name          string  `json:"name" example:"Premium Dog Food" description:"Name of the product"`
+	Price         float32 `json:"price" example:"45.99" description:"Price of the product"`
+	Description   string  `json:"description" example:"High-quality nutrition for dogs" description:"Detailed description of the product"`
+	ImgURL        string  `json:"imgUrl" example:"https://example.com/images/dog-food.jpg" description:"URL to the product image"`
+	ProductType   string  `json:"productType" example:"Food" description:"Type of the product"`
+	IsAttachable  bool    `json:"isAttachable" example:"false" description:"Whether the product can be attached to other products"`
+	AverageRating float32 `json:"averageRating" example:"4.5" description:"Average star rating of approved reviews"`
+	ReviewCount   int32   `json:"reviewCount" example:"12" description:"Number of approved reviews"`
 }
 type UserWithRole struct {
 	UserID      int32  `json:"userId" example:"1001" description:"Unique identifier of the user"`
@@ -58,6 +62,8 @@ type AvailableProductResponse struct {
 	ProductType       string  `json:"productType" example:"Food" description:"Type of the product"`
 	IsAttachable      bool    `json:"isAttachable" example:"false" description:"Whether the product can be attached to other products"`
 	AvailableQuantity int32   `json:"availableQuantity" example:"100" description:"Available stock quantity of the product"`
+	AverageRating     float32 `json:"averageRating" example:"4.5" description:"Average star rating of approved reviews"`
+	ReviewCount       int32   `json:"reviewCount" example:"12" description:"Number of approved reviews"`
 }
 type OrderItemReq struct {
 	ProductID   int32   `json:"product_id"`
@@ -81,22 +87,71 @@ type ProductResponse struct {
 // AppointmentResponse represents an appointment
 // @Description Appointment information
 type AppointmentResponse struct {
-	ID              int32   `json:"id" example:"5001" description:"Unique identifier of the appointment"`
-	CustomerID      int32   `json:"customer_id" example:"1001" description:"ID of the customer who made the appointment"`
-	EmployeeID      int32   `json:"employee_id" example:"2001" description:"ID of the employee assigned to the appointment"`
-	CustomerAddress string  `json:"customer_address" example:"123 Main St, City" description:"Address where the service will be provided"`
-	ScheduledTime   string  `json:"scheduled_time" example:"2025-03-07T10:00:00Z" description:"Scheduled time for the appointment (ISO 8601 format)"`
-	Status          string  `json:"status" example:"PENDING" description:"Current status of the appointment"`
-	Note            string  `json:"note" example:"Please bring all necessary equipment" description:"Additional notes for the appointment"`
-	Total           float32 `json:"total" example:"180.50" description:"Total cost of the appointment"`
-	BranchID        int32   `json:"branch_id" example:"301" description:"ID of the branch handling the appointment"`
+	ID              int32    `json:"id" example:"5001" description:"Unique identifier of the appointment"`
+	CustomerID      int32    `json:"customer_id" example:"1001" description:"ID of the customer who made the appointment"`
+	EmployeeID      int32    `json:"employee_id" example:"2001" description:"ID of the employee assigned to the appointment"`
+	CustomerAddress string   `json:"customer_address" example:"123 Main St, City" description:"Address where the service will be provided"`
+	ScheduledTime   string   `json:"scheduled_time" example:"2025-03-07T10:00:00Z" description:"Scheduled time for the appointment (ISO 8601 format)"`
+	Status          string   `json:"status" example:"PENDING" description:"Current status of the appointment"`
+	Note            string   `json:"note" example:"Please bring all necessary equipment" description:"Additional notes for the appointment"`
+	Total           float32  `json:"total" example:"180.50" description:"Total cost of the appointment"`
+	BranchID        int32    `json:"branch_id" example:"301" description:"ID of the branch handling the appointment"`
+	Subtotal        float32  `json:"subtotal" example:"200.50" description:"Cost before promotions"`
+	Discount        float32  `json:"discount" example:"20" description:"Total discount from promotions"`
+	DurationMinutes int32    `json:"duration_minutes" example:"60" description:"Total duration of the booked services, the appointment ends at scheduled_time + duration"`
+	CancellationFee float32  `json:"cancellation_fee" example:"0" description:"Late cancellation fee, charged when cancelled after the free cancellation deadline"`
+	RescheduleCount int32    `json:"reschedule_count" example:"0" description:"Number of times the appointment has been rescheduled"`
+	SeriesID        int32    `json:"series_id" example:"0" description:"Recurring series the appointment belongs to, 0 for a one-off appointment"`
+	PetIDs          []string `json:"pet_ids" example:"67c3f1a2b4d5e6f7a8b9c0d1" description:"Pets (records service pet IDs) brought to the appointment"`
+
+	AppliedPromotions []AppliedPromotionResponse `json:"applied_promotions" description:"Promotions applied to the appointment"`
+}
+
+// AppointmentStatusChangeResponse represents one entry of an appointment's status history
+// @Description Appointment status change
+type AppointmentStatusChangeResponse struct {
+	ID              int32   `json:"id" example:"1" description:"Unique identifier of the history entry"`
+	FromStatus      string  `json:"from_status" example:"CONFIRMED" description:"Previous status, UNSPECIFIED when the appointment was created"`
+	ToStatus        string  `json:"to_status" example:"CANCELLED" description:"New status"`
+	Reason          string  `json:"reason" example:"Customer is travelling" description:"Reason given for the change"`
+	ChangedBy       int32   `json:"changed_by" example:"1001" description:"ID of the user who changed the status, 0 for the system"`
+	CancellationFee float32 `json:"cancellation_fee" example:"18.05" description:"Late cancellation fee charged by this change"`
+	ChangedAt       string  `json:"changed_at" example:"2025-03-06T10:00:00Z" description:"Time of the change (ISO 8601 format)"`
+}
+
+// AppointmentSeriesResponse represents a recurring appointment series
+// @Description Recurring appointment series
+type AppointmentSeriesResponse struct {
+	ID              int32                `json:"id" example:"12" description:"Unique identifier of the series"`
+	CustomerID      int32                `json:"customer_id" example:"1001" description:"ID of the customer"`
+	EmployeeID      int32                `json:"employee_id" example:"2001" description:"Preferred employee, 0 to assign automatically"`
+	BranchID        int32                `json:"branch_id" example:"301" description:"ID of the branch"`
+	CustomerAddress string               `json:"customer_address" example:"123 Main St, City" description:"Address where the service will be provided"`
+	Note            string               `json:"note" example:"Short trim" description:"Note copied to every appointment"`
+	Frequency       string               `json:"frequency" example:"WEEKLY" description:"WEEKLY or MONTHLY"`
+	Interval        int32                `json:"interval" example:"4" description:"Repeat every interval weeks or months"`
+	StartTime       string               `json:"start_time" example:"2025-03-07T10:00:00Z" description:"First appointment, later ones keep the same local time at the branch (ISO 8601 format)"`
+	UntilDate       string               `json:"until_date" example:"2025-12-31" description:"Last day of the series, empty for no end date"`
+	Count           int32                `json:"count" example:"6" description:"Number of appointments in the series, 0 for no limit"`
+	Status          string               `json:"status" example:"active" description:"active, ended (all appointments generated) or cancelled"`
+	Services        []ServiceItemRequest `json:"services" description:"Services booked for every appointment"`
+	PetIDs          []string             `json:"pet_ids" example:"67c3f1a2b4d5e6f7a8b9c0d1" description:"Pets (records service pet IDs) brought to every appointment"`
+}
+
+// AppliedPromotionResponse represents a promotion applied to an order or appointment
+type AppliedPromotionResponse struct {
+	PromotionID int32   `json:"promotion_id" example:"3" description:"ID of the promotion"`
+	Code        string  `json:"code" example:"SPA10" description:"Coupon code, empty for automatic promotions"`
+	Name        string  `json:"name" example:"10% off spa services" description:"Name of the promotion"`
+	Discount    float32 `json:"discount" example:"20" description:"Discount amount from this promotion"`
 }
 
 // ServiceItemRequest represents a service item for appointment creation
 // @Description Service item for creating an appointment
 type ServiceItemRequest struct {
-	ServiceID int32 `json:"service_id" example:"1" description:"ID of the service"`
-	Quantity  int32 `json:"quantity" example:"1" description:"Quantity of the service"`
+	ServiceID int32  `json:"service_id" example:"1" description:"ID of the service"`
+	Quantity  int32  `json:"quantity" example:"1" description:"Quantity of the service"`
+	PetID     string `json:"pet_id" example:"67c3f1a2b4d5e6f7a8b9c0d1" description:"Pet the service is for, empty for the whole appointment"`
 }
 
 // CreateAppointmentRequest represents a request to create a new appointment
@@ -121,13 +176,15 @@ type CreateAppointmentResponse struct {
 // @Description Request to update appointment status
 type UpdateAppointmentStatusRequest struct {
 	AppointmentID string `json:"appointment_id" example:"5001" description:"ID of the appointment to update"`
-	Status        string `json:"status" example:"COMPLETED" description:"New status for the appointment"`
+	Status        string `json:"status" example:"COMPLETED" description:"New status: CONFIRMED, IN_PROGRESS, COMPLETED, CANCELLED or NO_SHOW"`
+	Reason        string `json:"reason" example:"Customer is travelling" description:"Reason for the change, required when cancelling"`
 }
 
 // UpdateAppointmentStatusResponse represents a response after updating appointment status
 // @Description Response after updating appointment status
 type UpdateAppointmentStatusResponse struct {
-	Status string `json:"status" example:"success" description:"Status of the operation"`
+	Status          string  `json:"status" example:"success" description:"Status of the operation"`
+	CancellationFee float32 `json:"cancellation_fee" example:"0" description:"Late cancellation fee charged, if any"`
 }
 
 // AppointmentDetailsResponse represents detailed appointment information
@@ -138,6 +195,80 @@ type AppointmentDetailsResponse struct {
 	Order       interface{}                       `json:"order" description:"Associated order information if available"`
 }
 
+// AgendaPetResponse represents a pet brought to an appointment
+// @Description Pet brought to an appointment
+type AgendaPetResponse struct {
+	ID           string  `json:"id" example:"67c3f1a2b4d5e6f7a8b9c0d1" description:"Records service pet ID"`
+	Name         string  `json:"name" example:"Milo"`
+	Species      string  `json:"species" example:"dog"`
+	Dob          string  `json:"dob" example:"2021-05-10"`
+	Color        string  `json:"color" example:"brown"`
+	Weight       float32 `json:"weight" example:"8.5"`
+	IdentityMark string  `json:"identity_mark" example:"white spot on the left ear"`
+}
+
+// AgendaAppointmentResponse represents an appointment in an employee's agenda
+// @Description Appointment in an employee's agenda with customer, pets and services
+type AgendaAppointmentResponse struct {
+	Appointment     AppointmentResponse                `json:"appointment"`
+	CustomerName    string                             `json:"customer_name" example:"Nguyen Van A"`
+	CustomerPhone   string                             `json:"customer_phone" example:"0901234567"`
+	CustomerAddress string                             `json:"customer_address" example:"123 Main St, City" description:"Appointment address, or the customer's profile address when the appointment has none"`
+	Pets            []AgendaPetResponse                `json:"pets"`
+	Details         []*pb.AppointmentDetailWithService `json:"details" description:"Services with their names"`
+}
+
+// RouteStopResponse represents a stop in an employee's home-visit route
+// @Description Stop in an employee's home-visit route
+type RouteStopResponse struct {
+	Appointment   AppointmentResponse `json:"appointment"`
+	Latitude      float64             `json:"latitude" example:"10.7769"`
+	Longitude     float64             `json:"longitude" example:"106.7009"`
+	DistanceKm    float64             `json:"distance_km" example:"3.2" description:"Estimated road distance from the previous stop, or from the branch for the first stop"`
+	TravelMinutes int32               `json:"travel_minutes" example:"8" description:"Estimated travel time from the previous stop"`
+}
+
+func toRouteStopResponses(stops []*pb.RouteStop) []RouteStopResponse {
+	result := make([]RouteStopResponse, len(stops))
+	for i, s := range stops {
+		result[i] = RouteStopResponse{
+			Appointment:   toAppointmentResponse(s.Appointment),
+			Latitude:      s.Latitude,
+			Longitude:     s.Longitude,
+			DistanceKm:    s.DistanceKm,
+			TravelMinutes: s.TravelMinutes,
+		}
+	}
+	return result
+}
+
+func toAgendaAppointmentResponse(a *pb.AgendaAppointment) AgendaAppointmentResponse {
+	pets := make([]AgendaPetResponse, len(a.Pets))
+	for i, p := range a.Pets {
+		pets[i] = AgendaPetResponse{
+			ID:           p.Id,
+			Name:         p.Name,
+			Species:      p.Species,
+			Dob:          p.Dob,
+			Color:        p.Color,
+			Weight:       p.Weight,
+			IdentityMark: p.IdentityMark,
+		}
+	}
+	details := a.Details
+	if details == nil {
+		details = []*pb.AppointmentDetailWithService{}
+	}
+	return AgendaAppointmentResponse{
+		Appointment:     toAppointmentResponse(a.Appointment),
+		CustomerName:    a.CustomerName,
+		CustomerPhone:   a.CustomerPhone,
+		CustomerAddress: a.CustomerAddress,
+		Pets:            pets,
+		Details:         details,
+	}
+}
+
 // VerifyEmailRequest represents a request to verify email
 // @Description Request to verify email
 type VerifyEmailRequest struct {
@@ -171,7 +302,84 @@ func toAppointmentResponse(pbApp *pb.Appointment) AppointmentResponse {
 		Note:            pbApp.Note,
 		Total:           pbApp.Total,
 		BranchID:        pbApp.BranchId,
+		Subtotal:        pbApp.Subtotal,
+		Discount:        pbApp.Discount,
+		DurationMinutes: pbApp.DurationMinutes,
+		CancellationFee: pbApp.CancellationFee,
+		RescheduleCount: pbApp.RescheduleCount,
+		SeriesID:        pbApp.SeriesId,
+		PetIDs:          append([]string{}, pbApp.PetIds...),
+
+		AppliedPromotions: toAppliedPromotionResponses(pbApp.AppliedPromotions),
+	}
+}
+
+func toAppointmentResponses(appointments []*pb.Appointment) []AppointmentResponse {
+	result := make([]AppointmentResponse, len(appointments))
+	for i, a := range appointments {
+		result[i] = toAppointmentResponse(a)
+	}
+	return result
+}
+
+func toAppointmentSeriesResponse(series *pb.AppointmentSeries) AppointmentSeriesResponse {
+	services := make([]ServiceItemRequest, len(series.Services))
+	for i, svc := range series.Services {
+		services[i] = ServiceItemRequest{ServiceID: svc.ServiceId, Quantity: svc.Quantity, PetID: svc.PetId}
+	}
+	return AppointmentSeriesResponse{
+		ID:              series.Id,
+		CustomerID:      series.CustomerId,
+		EmployeeID:      series.EmployeeId,
+		BranchID:        series.BranchId,
+		CustomerAddress: series.CustomerAddress,
+		Note:            series.Note,
+		Frequency:       series.Frequency.String(),
+		Interval:        series.Interval,
+		StartTime:       series.StartTime.AsTime().Format(time.RFC3339),
+		UntilDate:       series.UntilDate,
+		Count:           series.Count,
+		Status:          series.Status,
+		Services:        services,
+		PetIDs:          append([]string{}, series.PetIds...),
+	}
+}
+
+func skippedOccurrencesJSON(skipped []*pb.SkippedOccurrence) []map[string]string {
+	result := make([]map[string]string, len(skipped))
+	for i, o := range skipped {
+		result[i] = map[string]string{"scheduled_time": o.ScheduledTime.AsTime().Format(time.RFC3339), "reason": o.Reason}
+	}
+	return result
+}
+
+func toAppointmentStatusChangeResponses(history []*pb.AppointmentStatusChange) []AppointmentStatusChangeResponse {
+	result := make([]AppointmentStatusChangeResponse, len(history))
+	for i, h := range history {
+		result[i] = AppointmentStatusChangeResponse{
+			ID:              h.Id,
+			FromStatus:      pb.AppointmentStatus_name[int32(h.FromStatus)],
+			ToStatus:        pb.AppointmentStatus_name[int32(h.ToStatus)],
+			Reason:          h.Reason,
+			ChangedBy:       h.ChangedBy,
+			CancellationFee: h.CancellationFee,
+			ChangedAt:       h.ChangedAt.AsTime().Format(time.RFC3339),
+		}
+	}
+	return result
+}
+
+func toAppliedPromotionResponses(promotions []*pb.AppliedPromotion) []AppliedPromotionResponse {
+	responses := make([]AppliedPromotionResponse, len(promotions))
+	for i, p := range promotions {
+		responses[i] = AppliedPromotionResponse{
+			PromotionID: p.PromotionId,
+			Code:        p.Code,
+			Name:        p.Name,
+			Discount:    p.Discount,
+		}
 	}
+	return responses
 }
 
 // Additional request and response types for Swagger documentation
@@ -179,6 +387,7 @@ type CreateServiceRequest struct {
 	Name        string  `json:"name" example:"Pet Bathing" description:"Name of the service"`
 	Description string  `json:"description" example:"Full bathing service for pets" description:"Detailed description of the service"`
 	Price       float32 `json:"price" example:"75.50" description:"Price of the service"`
+	ImgURL      string  `json:"imgurl" example:"http://localhost:8080/media/services/10/a1b2.jpg" description:"Primary image URL of the service"`
 }
 
 type CreateServiceResponse struct {
@@ -191,6 +400,9 @@ type UpdateServiceRequest struct {
 	Name        string  `json:"name" example:"Premium Pet Bathing" description:"Updated name of the service"`
 	Description string  `json:"description" example:"Enhanced bathing service for pets" description:"Updated description of the service"`
 	Price       float32 `json:"price" example:"85.50" description:"Updated price of the service"`
+	ImgURL      string  `json:"imgurl" example:"http://localhost:8080/media/services/10/a1b2.jpg" description:"Updated primary image URL, empty keeps the current image"`
+	// DurationMinutes - 0 giữ nguyên thời lượng hiện tại
+	DurationMinutes int32 `json:"duration_minutes" example:"45" description:"Updated duration in minutes, 0 keeps the current duration"`
 }
 
 type UpdateServiceResponse struct {
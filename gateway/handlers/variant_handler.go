@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	pb "github.com/quanbin27/commons/genproto/products"
+)
+
+// variantRequest - Thông tin biến thể dùng chung cho tạo mới và cập nhật
+type variantRequest struct {
+	ProductID   int32   `json:"product_id"`
+	ProductType string  `json:"product_type"`
+	Name        string  `json:"name"`
+	SKU         string  `json:"sku"`
+	Barcode     string  `json:"barcode"`
+	Size        string  `json:"size"`
+	Weight      string  `json:"weight"`
+	Flavour     string  `json:"flavour"`
+	Price       float32 `json:"price"`
+}
+
+func (r *variantRequest) toProto(id int32) *pb.ProductVariant {
+	return &pb.ProductVariant{
+		Id:          id,
+		ProductId:   r.ProductID,
+		ProductType: r.ProductType,
+		Name:        r.Name,
+		Sku:         r.SKU,
+		Barcode:     r.Barcode,
+		Size:        r.Size,
+		Weight:      r.Weight,
+		Flavour:     r.Flavour,
+		Price:       r.Price,
+	}
+}
+
+// ListVariants lists the variants of a product
+// @Summary List product variants
+// @Description Retrieves the variants (size, weight, flavour) of a product with their own SKU, barcode and price. Deactivated variants are included when include_inactive=true
+// @Tags Variants
+// @Produce json
+// @Param product_type path string true "Product type (food, accessory, medicine)"
+// @Param id path int true "Product ID"
+// @Param include_inactive query bool false "Include deactivated variants"
+// @Success 200 {array} object{id=int32,product_id=int32,product_type=string,name=string,sku=string,barcode=string,size=string,weight=string,flavour=string,price=number,is_active=boolean} "List of variants"
+// @Failure 400 {object} object{error=string} "Invalid product type or ID"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /variants/products/{product_type}/{id} [get]
+func (h *ProductHandler) ListVariants(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	resp, err := h.client.ListVariants(c.Request().Context(), &pb.ListVariantsRequest{
+		ProductId:       int32(id),
+		ProductType:     c.Param("product_type"),
+		IncludeInactive: c.QueryParam("include_inactive") == "true",
+	})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp.Variants)
+}
+
+// GetVariant retrieves a variant by ID
+// @Summary Get variant by ID
+// @Description Retrieves a product variant by its ID
+// @Tags Variants
+// @Produce json
+// @Param id path int true "Variant ID"
+// @Success 200 {object} object{id=int32,product_id=int32,product_type=string,name=string,sku=string,barcode=string,size=string,weight=string,flavour=string,price=number,is_active=boolean} "Variant"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 404 {object} object{error=string} "Variant not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /variants/{id} [get]
+func (h *ProductHandler) GetVariant(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	resp, err := h.client.GetVariant(c.Request().Context(), &pb.GetVariantRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateVariant creates a product variant
+// @Summary Create a product variant
+// @Description Creates a variant of a food, accessory or medicine product. SKU is required and unique (stored uppercase). Once a product has active variants, its stock, reservations, purchase order lines and order items must reference a variant
+// @Tags Variants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body object{product_id=int32,product_type=string,name=string,sku=string,barcode=string,size=string,weight=string,flavour=string,price=number} true "Variant details"
+// @Success 200 {object} object{variant_id=int32,status=string} "Variant created successfully"
+// @Failure 400 {object} object{error=string} "Invalid variant or duplicate SKU"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Product not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /variants [post]
+func (h *ProductHandler) CreateVariant(c echo.Context) error {
+	var req variantRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.CreateVariant(c.Request().Context(), &pb.CreateVariantRequest{Variant: req.toProto(0)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{"variant_id": resp.VariantId, "status": resp.Status})
+}
+
+// UpdateVariant updates a product variant
+// @Summary Update a product variant
+// @Description Replaces the name, SKU, barcode, attributes and price of a variant. The parent product and active status are kept
+// @Tags Variants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Variant ID"
+// @Param request body object{name=string,sku=string,barcode=string,size=string,weight=string,flavour=string,price=number} true "Variant details"
+// @Success 200 {object} object{status=string} "Variant updated successfully"
+// @Failure 400 {object} object{error=string} "Invalid variant or duplicate SKU"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Variant not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /variants/{id} [put]
+func (h *ProductHandler) UpdateVariant(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	var req variantRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request"})
+	}
+	resp, err := h.client.UpdateVariant(c.Request().Context(), &pb.UpdateVariantRequest{Variant: req.toProto(int32(id))})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
+
+// DeactivateVariant stops selling a product variant
+// @Summary Deactivate a product variant
+// @Description Stops a variant from being reserved or ordered. Stock already held for it can still be picked up or released
+// @Tags Variants
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Variant ID"
+// @Success 200 {object} object{status=string} "Variant deactivated successfully"
+// @Failure 400 {object} object{error=string} "Invalid ID format"
+// @Failure 401 {object} object{error=string} "Unauthorized or insufficient role"
+// @Failure 404 {object} object{error=string} "Variant not found"
+// @Failure 500 {object} object{error=string} "Internal server error"
+// @Router /variants/{id}/deactivate [put]
+func (h *ProductHandler) DeactivateVariant(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid ID format, must be an integer"})
+	}
+	resp, err := h.client.DeactivateVariant(c.Request().Context(), &pb.DeactivateVariantRequest{Id: int32(id)})
+	if err != nil {
+		return grpcErrorToHTTP(c, err)
+	}
+	return c.JSON(http.StatusOK, map[string]string{"status": resp.Status})
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/quanbin27/BEPetCare-gateway/docs"
 	"github.com/quanbin27/BEPetCare-gateway/handlers"
+	"github.com/quanbin27/BEPetCare-gateway/storage"
 	"github.com/quanbin27/commons/config"
 	pbAppointments "github.com/quanbin27/commons/genproto/appointments"
 	pbOrders "github.com/quanbin27/commons/genproto/orders"
@@ -109,6 +110,8 @@ func main() {
 		AllowCredentials: false,
 	}))
 	e.Use(middleware.Logger())
+	// Ảnh upload được lưu trên ổ đĩa của gateway và phục vụ tĩnh tại /media
+	e.Static("/media", config.Envs.MediaDir)
 	subrouter := e.Group("/api/v1")
 	subrouter.GET("/swagger/*", echoSwagger.WrapHandler)
 	gateway, err := NewGateway()
@@ -127,6 +130,11 @@ func main() {
 	appointmentHandler.RegisterRoutes(subrouter)
 	recordsHandler := handlers.NewRecordsHandler(gateway.PetRecordClient)
 	recordsHandler.RegisterRoutes(subrouter)
+	posHandler := handlers.NewPosHandler(gateway.ProductsClient, gateway.OrdersClient, gateway.UsersClient, gateway.PaymentsClient)
+	posHandler.RegisterRoutes(subrouter)
+	imageHandler := handlers.NewImageHandler(gateway.ProductsClient, gateway.AppointmentsClient,
+		storage.NewLocalBlobStore(config.Envs.MediaDir, config.Envs.MediaBaseURL))
+	imageHandler.RegisterRoutes(subrouter)
 	log.Println("Starting server on", httpAddr)
 	log.Println("Test log gateway")
 	if err := e.Start(httpAddr); err != nil {
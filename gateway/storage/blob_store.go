@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// BlobStore - Nơi lưu file (ảnh sản phẩm, dịch vụ...).
+// Key là đường dẫn tương đối dạng "products/food/1/abc.jpg", Put trả về URL public của file.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// LocalBlobStore lưu file trên ổ đĩa của gateway, thư mục root được phục vụ tĩnh tại baseURL
+type LocalBlobStore struct {
+	root    string
+	baseURL string
+}
+
+func NewLocalBlobStore(root, baseURL string) *LocalBlobStore {
+	return &LocalBlobStore{root: root, baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+// path chuyển key thành đường dẫn trong root, chặn key thoát ra ngoài root ("../")
+func (s *LocalBlobStore) path(key string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(clean) || clean == "." || strings.HasPrefix(clean, "..") {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, clean), nil
+}
+
+func (s *LocalBlobStore) Put(ctx context.Context, key string, r io.Reader, contentType string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		os.Remove(path)
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return s.baseURL + "/" + filepath.ToSlash(filepath.Clean(filepath.FromSlash(key))), nil
+}
+
+// Delete xoá file, file không tồn tại không bị coi là lỗi
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"log"
 	"net"
+	"time"
 
 	"github.com/quanbin27/commons/config"
+	pbAppointment "github.com/quanbin27/commons/genproto/appointments"
 	"github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gopkg.in/gomail.v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -68,6 +71,74 @@ func startKafkaConsumer(service *Service, kafkaAddr string) {
 	}
 }
 
+// startAppointmentConsumer đọc các sự kiện lịch hẹn từ Appointments service và gửi email
+func startAppointmentConsumer(service *Service, kafkaAddr string) {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     []string{kafkaAddr},
+		GroupTopics: []string{"appointment.rescheduled", "appointment.reminder"},
+		GroupID:     "notification-service-appointments", // tách group với order_topic để hai reader không chia nhau partition
+		MinBytes:    10e3,
+		MaxBytes:    10e6,
+	})
+	defer reader.Close()
+
+	log.Println("Kafka consumer started, listening to appointment topics...")
+
+	for {
+		msg, err := reader.ReadMessage(context.Background())
+		if err != nil {
+			log.Printf("Error reading Kafka message: %v", err)
+			continue
+		}
+
+		switch msg.Topic {
+		case "appointment.rescheduled":
+			var event AppointmentRescheduledData
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				log.Printf("Error unmarshaling Kafka message: %v", err)
+				continue
+			}
+			if err := service.SendAppointmentRescheduledEmails(context.Background(), event); err != nil {
+				log.Printf("Failed to send reschedule emails for appointment %d: %v", event.AppointmentID, err)
+			} else {
+				log.Printf("Reschedule emails sent for appointment %d", event.AppointmentID)
+			}
+		case "appointment.reminder":
+			var event AppointmentReminderData
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				log.Printf("Error unmarshaling Kafka message: %v", err)
+				continue
+			}
+			go sendReminderWithRetry(service, event)
+		}
+	}
+}
+
+const (
+	reminderRetries    = 3
+	reminderRetryDelay = time.Minute
+)
+
+// sendReminderWithRetry gửi nhắc lịch, lỗi thì thử lại sau reminderRetryDelay (gấp đôi sau mỗi lần).
+// Offset Kafka đã commit và Appointments service chỉ phát mỗi mốc nhắc một lần nên phải thử lại ở đây;
+// lần lỗi chưa gửi được email nào đã nhả claim nên lần sau claim lại được, lần đã gửi một phần thì lần sau bỏ qua.
+func sendReminderWithRetry(service *Service, event AppointmentReminderData) {
+	delay := reminderRetryDelay
+	for attempt := 1; ; attempt++ {
+		err := service.SendAppointmentReminderEmails(context.Background(), event)
+		if err == nil {
+			return
+		}
+		if attempt > reminderRetries {
+			log.Printf("Failed to send reminder emails for appointment %d, giving up: %v", event.AppointmentID, err)
+			return
+		}
+		log.Printf("Failed to send reminder emails for appointment %d, retrying in %s: %v", event.AppointmentID, delay, err)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
 func main() {
 	dsn := config.Envs.NotificationsDSN
 	log.Println("Connecting to database ...", dsn)
@@ -79,7 +150,7 @@ func main() {
 		log.Fatal(err)
 	}
 	initStorage(db)
-	db.AutoMigrate(&EmailNotification{})
+	db.AutoMigrate(&EmailNotification{}, &SentReminder{})
 
 	grpcServer := grpc.NewServer()
 	l, err := net.Listen("tcp", grpcAddr)
@@ -90,10 +161,16 @@ func main() {
 
 	store := NewMySQLNotificationStore(db)
 	mailDialer := gomail.NewDialer("smtp.gmail.com", 587, config.Envs.EmailAddr, config.Envs.EmailPassword)
-	service := NewService(store, mailDialer)
+	appointmentsConn, err := grpc.NewClient(config.Envs.AppointmentsGrpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to Appointments service: %v", err)
+	}
+	defer appointmentsConn.Close()
+	service := NewService(store, mailDialer, pbAppointment.NewAppointmentServiceClient(appointmentsConn))
 	NewGRPCHandler(grpcServer, service)
 
 	go startKafkaConsumer(service, kafkaAddr)
+	go startAppointmentConsumer(service, kafkaAddr)
 
 	log.Println("Notification Service Listening on", grpcAddr)
 	if err := grpcServer.Serve(l); err != nil {
@@ -4,21 +4,25 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
+	pbAppointment "github.com/quanbin27/commons/genproto/appointments"
 	"gopkg.in/gomail.v2"
 )
 
 // Service triển khai NotificationService
 type Service struct {
-	store      NotificationStore
-	mailDialer *gomail.Dialer
+	store             NotificationStore
+	mailDialer        *gomail.Dialer
+	appointmentClient pbAppointment.AppointmentServiceClient
 }
 
 // NewService tạo instance mới
-func NewService(store NotificationStore, mailDialer *gomail.Dialer) *Service {
+func NewService(store NotificationStore, mailDialer *gomail.Dialer, appointmentClient pbAppointment.AppointmentServiceClient) *Service {
 	return &Service{
-		store:      store,
-		mailDialer: mailDialer,
+		store:             store,
+		mailDialer:        mailDialer,
+		appointmentClient: appointmentClient,
 	}
 }
 
@@ -141,3 +145,148 @@ func (s *Service) SendOrderConfirmationEmail(ctx context.Context, email string,
 
 	return "Order confirmation email sent", nil
 }
+
+// sendEmail lưu và gửi một email, cập nhật trạng thái sent/failed
+func (s *Service) sendEmail(ctx context.Context, email, subject, body string) error {
+	notification := &EmailNotification{
+		Email:   email,
+		Subject: subject,
+		Body:    body,
+	}
+	if err := s.store.SaveNotification(ctx, notification); err != nil {
+		return fmt.Errorf("failed to save notification: %v", err)
+	}
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", "votrungquan2002@gmail.com")
+	m.SetHeader("To", email)
+	m.SetHeader("Subject", notification.Subject)
+	m.SetBody("text/plain", notification.Body)
+
+	if err := s.mailDialer.DialAndSend(m); err != nil {
+		if updateErr := s.store.UpdateNotificationStatus(ctx, notification.ID, "failed"); updateErr != nil {
+			return fmt.Errorf("failed to send email: %v, and failed to update status: %v", err, updateErr)
+		}
+		return fmt.Errorf("failed to send email: %v", err)
+	}
+	if err := s.store.UpdateNotificationStatus(ctx, notification.ID, "sent"); err != nil {
+		return fmt.Errorf("failed to update status to sent: %v", err)
+	}
+	return nil
+}
+
+// formatAppointmentServices liệt kê dịch vụ của lịch hẹn trong email
+func formatAppointmentServices(services []AppointmentService) string {
+	body := ""
+	for _, svc := range services {
+		body += fmt.Sprintf("- %s (Qty: %d)\n", svc.Name, svc.Quantity)
+	}
+	return body
+}
+
+// SendAppointmentRescheduledEmails báo lịch hẹn bị dời cho khách và nhân viên liên quan
+func (s *Service) SendAppointmentRescheduledEmails(ctx context.Context, event AppointmentRescheduledData) error {
+	var failed []string
+	for _, r := range event.Recipients {
+		body := fmt.Sprintf("Hello %s,\n\nAppointment #%d has been rescheduled from %s to %s.\n",
+			r.Name, event.AppointmentID, formatAppointmentTime(event.PreviousScheduledTime), formatAppointmentTime(event.ScheduledTime))
+		if event.BranchID != event.PreviousBranchID {
+			body += fmt.Sprintf("It has also moved from branch #%d to branch #%d.\n", event.PreviousBranchID, event.BranchID)
+		}
+		if r.Role == "employee" && r.UserID != event.EmployeeID {
+			body += "You are no longer assigned to this appointment.\n"
+		}
+		if len(event.Services) > 0 {
+			body += "Services:\n" + formatAppointmentServices(event.Services)
+		}
+		if err := s.sendEmail(ctx, r.Email, fmt.Sprintf("Appointment #%d Rescheduled", event.AppointmentID), body); err != nil {
+			log.Printf("Failed to send reschedule email to %s: %v", r.Email, err)
+			failed = append(failed, r.Email)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to notify %v", failed)
+	}
+	return nil
+}
+
+// SendAppointmentReminderEmails gửi email nhắc lịch kèm danh sách dịch vụ lấy từ Appointments service.
+// Mỗi mốc nhắc của một giờ hẹn chỉ gửi một lần; lịch đã huỷ hoặc đã dời sau khi phát sự kiện thì bỏ qua.
+// Claim trước để hai consumer không cùng gửi; chưa gửi được email nào thì nhả claim để lần phát sau gửi lại,
+// đã gửi được cho một phần người nhận thì giữ claim để không ai nhận trùng.
+func (s *Service) SendAppointmentReminderEmails(ctx context.Context, event AppointmentReminderData) error {
+	scheduledTime, err := time.Parse(time.RFC3339, event.ScheduledTime)
+	if err != nil {
+		return fmt.Errorf("invalid scheduled_time %q: %v", event.ScheduledTime, err)
+	}
+	reminder := &SentReminder{
+		AppointmentID:       event.AppointmentID,
+		RemindBeforeMinutes: event.RemindBeforeMinutes,
+		ScheduledTime:       scheduledTime.UTC(),
+	}
+	claimed, err := s.store.ClaimReminder(ctx, reminder)
+	if err != nil {
+		return fmt.Errorf("failed to save reminder: %v", err)
+	}
+	if !claimed {
+		log.Printf("Reminder for appointment %d already sent, skipping", event.AppointmentID)
+		return nil
+	}
+
+	res, err := s.appointmentClient.GetAppointmentDetails(ctx, &pbAppointment.GetAppointmentDetailsRequest{AppointmentId: event.AppointmentID})
+	if err != nil {
+		s.releaseReminder(ctx, reminder)
+		return fmt.Errorf("failed to get appointment details: %v", err)
+	}
+	appointment := res.GetAppointment()
+	status := appointment.GetStatus()
+	if status != pbAppointment.AppointmentStatus_PENDING && status != pbAppointment.AppointmentStatus_CONFIRMED {
+		log.Printf("Appointment %d is %s, skipping reminder", event.AppointmentID, status)
+		return nil
+	}
+	if !appointment.GetScheduledTime().AsTime().Equal(scheduledTime) {
+		log.Printf("Appointment %d has been rescheduled, skipping reminder", event.AppointmentID)
+		return nil
+	}
+	services := make([]AppointmentService, len(res.GetDetails()))
+	for i, d := range res.GetDetails() {
+		services[i] = AppointmentService{Name: d.GetServiceName(), Quantity: d.GetQuantity()}
+	}
+
+	var failed []string
+	for _, r := range event.Recipients {
+		body := fmt.Sprintf("Hello %s,\n\nThis is a reminder of your appointment #%d at %s (branch #%d).\n",
+			r.Name, event.AppointmentID, formatAppointmentTime(event.ScheduledTime), event.BranchID)
+		if len(services) > 0 {
+			body += "Services:\n" + formatAppointmentServices(services)
+		}
+		if err := s.sendEmail(ctx, r.Email, fmt.Sprintf("Reminder: Appointment #%d", event.AppointmentID), body); err != nil {
+			log.Printf("Failed to send reminder email to %s: %v", r.Email, err)
+			failed = append(failed, r.Email)
+		}
+	}
+	if len(failed) > 0 {
+		if len(failed) == len(event.Recipients) {
+			s.releaseReminder(ctx, reminder)
+		}
+		return fmt.Errorf("failed to notify %v", failed)
+	}
+	return nil
+}
+
+// releaseReminder nhả claim nhắc lịch chưa gửi được để sendReminderWithRetry thử lại; lỗi chỉ ghi log
+// (nhắc lịch đó sẽ không được gửi lại)
+func (s *Service) releaseReminder(ctx context.Context, reminder *SentReminder) {
+	if err := s.store.ReleaseReminder(ctx, reminder); err != nil {
+		log.Printf("Failed to release reminder for appointment %d: %v", reminder.AppointmentID, err)
+	}
+}
+
+// formatAppointmentTime hiển thị thời gian RFC3339 dễ đọc, giữ nguyên nếu không parse được
+func formatAppointmentTime(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return t.Format("15:04 02/01/2006 (UTC-07:00)")
+}
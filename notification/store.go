@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // MySQLNotificationStore triển khai NotificationStore với GORM và MySQL
@@ -70,6 +71,22 @@ func (s *MySQLNotificationStore) UpdateNotificationStatus(ctx context.Context, i
 	return nil
 }
 
+// ClaimReminder chèn bản ghi nhắc lịch, trùng khoá nghĩa là đã có consumer xử lý
+func (s *MySQLNotificationStore) ClaimReminder(ctx context.Context, reminder *SentReminder) (bool, error) {
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(reminder)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (s *MySQLNotificationStore) ReleaseReminder(ctx context.Context, reminder *SentReminder) error {
+	return s.db.WithContext(ctx).
+		Where("appointment_id = ? AND remind_before_minutes = ? AND scheduled_time = ?",
+			reminder.AppointmentID, reminder.RemindBeforeMinutes, reminder.ScheduledTime).
+		Delete(&SentReminder{}).Error
+}
+
 func generateUUID() string {
 	return uuid.New().String()
 }
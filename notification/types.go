@@ -16,6 +16,14 @@ type EmailNotification struct {
 	Status    string    `gorm:"type:varchar(50);not null"`
 }
 
+// SentReminder đánh dấu nhắc lịch đã xử lý để sự kiện appointment.reminder bị đọc lại không gửi email trùng
+type SentReminder struct {
+	AppointmentID       int32     `gorm:"primaryKey;autoIncrement:false"`
+	RemindBeforeMinutes int32     `gorm:"primaryKey;autoIncrement:false"`
+	ScheduledTime       time.Time `gorm:"primaryKey"` // lịch bị dời thì nhắc lại theo giờ mới
+	CreatedAt           time.Time `gorm:"autoCreateTime"`
+}
+
 // For later update
 //type Notification struct {
 //	ID        primitive.ObjectID `bson:"_id,omitempty"`        // MongoDB ObjectID
@@ -43,6 +51,10 @@ type NotificationStore interface {
 	SaveNotification(ctx context.Context, notification *EmailNotification) error
 	GetNotification(ctx context.Context, id string) (*EmailNotification, error)
 	UpdateNotificationStatus(ctx context.Context, id, status string) error
+	// ClaimReminder ghi nhận nhắc lịch, false nếu đã được xử lý trước đó
+	ClaimReminder(ctx context.Context, reminder *SentReminder) (bool, error)
+	// ReleaseReminder xoá bản ghi nhắc lịch đã claim để lần phát sự kiện sau gửi lại được
+	ReleaseReminder(ctx context.Context, reminder *SentReminder) error
 }
 
 // NotificationService interface
@@ -65,3 +77,34 @@ type OrderItem struct {
 	ProductType string  `json:"product_type"`
 	ProductName string  `json:"product_name"`
 }
+
+// AppointmentRescheduledData - sự kiện appointment.rescheduled từ Appointments service
+type AppointmentRescheduledData struct {
+	AppointmentID         int32                  `json:"appointment_id"`
+	BranchID              int32                  `json:"branch_id"`
+	PreviousBranchID      int32                  `json:"previous_branch_id"`
+	ScheduledTime         string                 `json:"scheduled_time"`
+	PreviousScheduledTime string                 `json:"previous_scheduled_time"`
+	EmployeeID            int32                  `json:"employee_id"`
+	Services              []AppointmentService   `json:"services"`
+	Recipients            []AppointmentRecipient `json:"recipients"`
+}
+
+// AppointmentReminderData - sự kiện appointment.reminder từ Appointments service
+type AppointmentReminderData struct {
+	AppointmentID       int32                  `json:"appointment_id"`
+	BranchID            int32                  `json:"branch_id"`
+	ScheduledTime       string                 `json:"scheduled_time"`
+	RemindBeforeMinutes int32                  `json:"remind_before_minutes"`
+	Recipients          []AppointmentRecipient `json:"recipients"`
+}
+type AppointmentService struct {
+	Name     string `json:"name"`
+	Quantity int32  `json:"quantity"`
+}
+type AppointmentRecipient struct {
+	UserID int32  `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	Role   string `json:"role"` // customer | employee
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/quanbin27/commons/config"
 	pb "github.com/quanbin27/commons/genproto/orders"
 	pbProduct "github.com/quanbin27/commons/genproto/products"
@@ -12,11 +14,16 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 )
 
+// pickupReservationGrace - Thời gian giữ hàng thêm sau giờ hẹn lấy trước khi tự trả lại kho
+const pickupReservationGrace = 24 * time.Hour
+
 type OrderGrpcHandler struct {
 	orderService OrderService
 	pb.UnimplementedOrderServiceServer
@@ -56,6 +63,7 @@ func (h *OrderGrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderR
 	for i, item := range req.Items {
 		items[i] = OrderItem{
 			ProductID:   item.ProductId,
+			VariantID:   item.VariantId,
 			Quantity:    item.Quantity,
 			UnitPrice:   item.UnitPrice,
 			ProductType: item.ProductType,
@@ -63,6 +71,22 @@ func (h *OrderGrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderR
 		}
 	}
 
+	// ====== Định giá theo bảng giá của Products tại thời điểm đặt, không dùng giá client gửi lên ======
+	pricedAt := time.Now().Format(time.RFC3339)
+	for i, item := range req.Items {
+		priceResp, err := h.productClient.GetPriceAt(ctx, &pbProduct.GetPriceAtRequest{
+			ProductType: item.ProductType,
+			ProductId:   item.ProductId,
+			VariantId:   item.VariantId,
+			At:          pricedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		item.UnitPrice = priceResp.Price
+		items[i].UnitPrice = priceResp.Price
+	}
+
 	var pickupTime *time.Time
 	if req.PickupTime != "" {
 		t, err := time.Parse(time.RFC3339, req.PickupTime)
@@ -70,48 +94,127 @@ func (h *OrderGrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderR
 			return nil, status.Errorf(codes.InvalidArgument, "invalid pickup_time format: %v", err)
 		}
 		pickupTime = &t
+
+		// Giờ lấy hàng phải nằm trong giờ mở cửa của chi nhánh
+		openResp, err := h.productClient.CheckBranchOpen(ctx, &pbProduct.CheckBranchOpenRequest{
+			BranchId: req.BranchId,
+			Time:     req.PickupTime,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !openResp.IsOpen {
+			return nil, status.Errorf(codes.FailedPrecondition, "pickup time is outside branch opening hours: %s", openResp.Reason)
+		}
 	}
 
-	// ====== Gọi ReserveProduct cho từng item ======
-	var reservedItems []*pbProduct.ReserveProductRequest
-	for _, item := range req.Items {
-		reserveReq := &pbProduct.ReserveProductRequest{
+	// ====== Tính khuyến mãi và ghi nhận lượt dùng ======
+	cartItems := make([]*pbProduct.CartItem, len(req.Items))
+	for i, item := range req.Items {
+		cartItems[i] = &pbProduct.CartItem{
+			ItemType:  item.ProductType,
+			ItemId:    item.ProductId,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		}
+	}
+	pricing, err := h.productClient.RedeemPromotions(ctx, &pbProduct.PriceCartRequest{
+		CustomerId:  req.CustomerId,
+		Items:       cartItems,
+		CouponCodes: req.CouponCodes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	promotions := make([]OrderPromotion, len(pricing.AppliedPromotions))
+	var redemptionIDs []int32
+	for i, p := range pricing.AppliedPromotions {
+		promotions[i] = OrderPromotion{
+			PromotionID:  p.PromotionId,
+			RedemptionID: p.RedemptionId,
+			Code:         p.Code,
+			Name:         p.Name,
+			Discount:     p.Discount,
+		}
+		redemptionIDs = append(redemptionIDs, p.RedemptionId)
+	}
+	releasePromotions := func() {
+		if len(redemptionIDs) == 0 {
+			return
+		}
+		if _, err := h.productClient.ReleasePromotionRedemptions(ctx, &pbProduct.ReleasePromotionRedemptionsRequest{RedemptionIds: redemptionIDs}); err != nil {
+			log.Printf("Failed to release promotion redemptions %v: %v", redemptionIDs, err)
+		}
+	}
+
+	// ====== Giữ hàng cho cả giỏ trong một lần ======
+	reserveItems := make([]*pbProduct.ReserveItem, len(req.Items))
+	for i, item := range req.Items {
+		reserveItems[i] = &pbProduct.ReserveItem{
 			ProductId:   item.ProductId,
 			ProductType: item.ProductType,
+			VariantId:   item.VariantId,
 			Quantity:    item.Quantity,
-			BranchId:    req.BranchId,
 		}
-		_, err := h.productClient.ReserveProduct(ctx, reserveReq)
-		if err != nil {
-			// Rollback các item đã reserve trước đó
-			for _, reserved := range reservedItems {
-				_, _ = h.productClient.ReleaseReservation(ctx, &pbProduct.ReleaseReservationRequest{
-					ProductId:   reserved.ProductId,
-					ProductType: reserved.ProductType,
-					Quantity:    reserved.Quantity,
-					BranchId:    reserved.BranchId,
-				})
+	}
+	reserveResp, err := h.productClient.ReserveProducts(ctx, &pbProduct.ReserveProductsRequest{
+		BranchId: req.BranchId,
+		Items:    reserveItems,
+	})
+	if err != nil {
+		releasePromotions()
+		return nil, err
+	}
+	if !reserveResp.Success {
+		releasePromotions()
+		missing := make([]string, len(reserveResp.Shortfalls))
+		for i, sf := range reserveResp.Shortfalls {
+			name := fmt.Sprintf("%s %d", sf.ProductType, sf.ProductId)
+			if sf.VariantId != 0 {
+				name += fmt.Sprintf(" variant %d", sf.VariantId)
+			}
+			missing[i] = fmt.Sprintf("%s (requested %d, available %d)", name, sf.Requested, sf.Available)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "not enough stock: %s", strings.Join(missing, ", "))
+	}
+	reservationIDs := make([]int32, len(reserveResp.Reservations))
+	for i, r := range reserveResp.Reservations {
+		items[i].ReservationID = r.Id
+		reservationIDs[i] = r.Id
+	}
+	releaseReservations := func() {
+		for _, id := range reservationIDs {
+			if _, err := h.productClient.ReleaseReservation(ctx, &pbProduct.ReleaseReservationRequest{ReservationId: id}); err != nil {
+				log.Printf("Failed to release reservation %d: %v", id, err)
 			}
-			return nil, status.Errorf(codes.Internal, "failed to reserve product: %v", err)
 		}
-		reservedItems = append(reservedItems, reserveReq)
 	}
 
 	// ====== Gọi orderService để tạo order ======
-	orderID, statusMsg, err := h.orderService.CreateOrder(ctx, req.CustomerId, req.BranchId, items, &req.AppointmentId, pickupTime)
+	orderID, statusMsg, err := h.orderService.CreateOrder(ctx, req.CustomerId, req.BranchId, items, &req.AppointmentId, pickupTime, promotions)
 	if err != nil {
 		// Rollback nếu tạo order thất bại
-		for _, reserved := range reservedItems {
-			_, _ = h.productClient.ReleaseReservation(ctx, &pbProduct.ReleaseReservationRequest{
-				ProductId:   reserved.ProductId,
-				ProductType: reserved.ProductType,
-				Quantity:    reserved.Quantity,
-				BranchId:    reserved.BranchId,
-			})
-		}
+		releaseReservations()
+		releasePromotions()
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	// Gắn các lần giữ vào đơn hàng: giữ đến hết ngày sau giờ hẹn lấy, hoặc đến khi hoàn thành / huỷ nếu không hẹn giờ.
+	// Không gắn được thì hàng sẽ hết hạn giữ theo TTL mặc định trong khi đơn vẫn chờ, nên huỷ đơn
+	// (trả hàng và lượt khuyến mãi, sweeper thử lại nếu lỗi) và báo lỗi cho client.
+	var reservationExpiresAt string
+	if pickupTime != nil {
+		reservationExpiresAt = pickupTime.Add(pickupReservationGrace).Format(time.RFC3339)
+	}
+	if _, err := h.productClient.AssignReservations(ctx, &pbProduct.AssignReservationsRequest{
+		ReservationIds: reservationIDs,
+		OrderId:        orderID,
+		ExpiresAt:      reservationExpiresAt,
+	}); err != nil {
+		h.cancelOrder(ctx, orderID)
+		return nil, status.Errorf(codes.Unavailable, "failed to hold stock for order %d, order cancelled: %v", orderID, err)
+	}
+
 	// ====== Gửi Kafka async ======
 	go func() {
 		orderData := map[string]interface{}{
@@ -138,7 +241,29 @@ func (h *OrderGrpcHandler) CreateOrder(ctx context.Context, req *pb.CreateOrderR
 		}
 	}()
 
-	return &pb.CreateOrderResponse{OrderId: orderID, Status: statusMsg}, nil
+	return &pb.CreateOrderResponse{
+		OrderId:           orderID,
+		Status:            statusMsg,
+		Subtotal:          pricing.Subtotal,
+		Discount:          pricing.Discount,
+		TotalPrice:        pricing.Total,
+		AppliedPromotions: toPbAppliedPromotions(promotions),
+	}, nil
+}
+
+// cancelOrder huỷ đơn vừa tạo khi không hoàn tất được; kho chưa xử lý xong thì để sweeper thử lại
+func (h *OrderGrpcHandler) cancelOrder(ctx context.Context, orderID int32) {
+	if _, err := h.orderService.UpdateOrderStatus(ctx, orderID, OrderStatusCancelled); err != nil {
+		log.Printf("Failed to cancel order %d: %v", orderID, err)
+		return
+	}
+	order, err := h.orderService.GetOrder(ctx, orderID)
+	if err == nil {
+		err = h.settleStock(ctx, order)
+	}
+	if err != nil {
+		log.Printf("Failed to settle stock of cancelled order %d, will retry: %v", orderID, err)
+	}
 }
 
 func (h *OrderGrpcHandler) Close() {
@@ -161,14 +286,46 @@ func (h *OrderGrpcHandler) GetOrderByAppointmentID(ctx context.Context, req *pb.
 	}
 	return &pb.GetOrderByAppointmentIDResponse{Order: toPbOrder(order)}, nil
 }
+
+// UpdateOrderStatus đổi trạng thái trước (có điều kiện, trong transaction) rồi mới xử lý kho, để kho không bị
+// xuất / trả cho đơn mà trạng thái không đổi được. Xử lý kho lỗi thì đơn vẫn đánh dấu StockPending cho sweeper
+// thử lại, và trả Unavailable: gọi lại với cùng trạng thái sẽ xử lý tiếp phần còn lại.
 func (h *OrderGrpcHandler) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
-	statusMsg, err := h.orderService.UpdateOrderStatus(ctx, req.OrderId, fromPbOrderStatus(req.Status))
+	newStatus := fromPbOrderStatus(req.Status)
+	if newStatus == OrderStatusCompleted {
+		if err := h.checkReservationsHeld(ctx, req.OrderId); err != nil {
+			return nil, err
+		}
+	}
+	statusMsg, err := h.orderService.UpdateOrderStatus(ctx, req.OrderId, newStatus)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
+	}
+	if newStatus.isFinal() {
+		order, err := h.orderService.GetOrder(ctx, req.OrderId)
+		if err == nil {
+			err = h.settleStock(ctx, order)
+		}
+		if err != nil {
+			log.Printf("Order %d is %s but stock settlement failed, will retry: %v", req.OrderId, newStatus, err)
+			return nil, status.Errorf(codes.Unavailable, "order %d is %s but stock settlement failed and will be retried: %v", req.OrderId, newStatus, err)
+		}
 	}
 	return &pb.UpdateOrderStatusResponse{Status: statusMsg}, nil
 }
 
+// toGrpcError ánh xạ lỗi nghiệp vụ sang mã gRPC tương ứng
+func toGrpcError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 func (h *OrderGrpcHandler) GetOrderItems(ctx context.Context, req *pb.GetOrderItemsRequest) (*pb.GetOrderItemsResponse, error) {
 	items, err := h.orderService.GetOrderItems(ctx, req.OrderId)
 	if err != nil {
@@ -191,6 +348,13 @@ func (h *OrderGrpcHandler) GetOrdersByCustomerID(ctx context.Context, req *pb.Ge
 	}
 	return &pb.GetOrdersByCustomerIDResponse{Orders: pbOrders}, nil
 }
+func (h *OrderGrpcHandler) FindCompletedPurchase(ctx context.Context, req *pb.FindCompletedPurchaseRequest) (*pb.FindCompletedPurchaseResponse, error) {
+	orderID, err := h.orderService.FindCompletedPurchase(ctx, req.CustomerId, req.ProductId, req.ProductType)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return &pb.FindCompletedPurchaseResponse{OrderId: orderID}, nil
+}
 func (h *OrderGrpcHandler) GetAllOrders(ctx context.Context, req *pb.GetAllOrdersRequest) (*pb.GetAllOrdersResponse, error) {
 	orders, err := h.orderService.GetAllOrders(ctx)
 	if err != nil {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"github.com/quanbin27/commons/config"
 	"google.golang.org/grpc"
 	"gorm.io/driver/mysql"
@@ -37,7 +38,9 @@ func main() {
 		log.Fatal(err)
 	}
 	initStorage(db)
-	db.AutoMigrate(Order{}, OrderItem{})
+	if err := migrate(db); err != nil {
+		log.Fatal(err)
+	}
 	grpcServer := grpc.NewServer()
 	l, err := net.Listen("tcp", grpcAddr)
 	if err != nil {
@@ -46,7 +49,8 @@ func main() {
 	defer l.Close()
 	orderStore := NewOrderStore(db)
 	orderService := NewOrderService(orderStore)
-	NewOrderGrpcHandler(grpcServer, orderService, kafkaAddr)
+	handler := NewOrderGrpcHandler(grpcServer, orderService, kafkaAddr)
+	go runStockSweeper(context.Background(), handler, stockSweepInterval)
 	log.Println("Orders Service Listening on", grpcAddr)
 	grpcServer.Serve(l)
 }
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Migration của Orders Service. AutoMigrate không đổi khoá chính của bảng đã có nên phần đó làm tay sau AutoMigrate;
+// mỗi bước kiểm tra trạng thái hiện tại trước nên chạy lại nhiều lần vẫn an toàn.
+
+func migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(Order{}, OrderItem{}, OrderPromotion{}); err != nil {
+		return err
+	}
+	// Đơn có nhiều biến thể của cùng sản phẩm: bảng tạo trước khi có variant_id vẫn giữ khoá (order_id, product_id, product_type)
+	return ensurePrimaryKey(db, &OrderItem{}, "order_id", "product_id", "product_type", "variant_id")
+}
+
+// ensurePrimaryKey tạo lại khoá chính của bảng thành columns nếu khoá hiện tại khác.
+// Drop và add trong cùng một câu ALTER TABLE nên bảng không lúc nào mất khoá.
+func ensurePrimaryKey(db *gorm.DB, model interface{}, columns ...string) error {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return err
+	}
+	table := stmt.Schema.Table
+	var current []string
+	if err := db.Raw(`SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY'
+		ORDER BY ORDINAL_POSITION`, table).Scan(&current).Error; err != nil {
+		return err
+	}
+	if strings.Join(current, ",") == strings.Join(columns, ",") {
+		return nil
+	}
+	return db.Exec(fmt.Sprintf("ALTER TABLE `%s` DROP PRIMARY KEY, ADD PRIMARY KEY (`%s`)", table, strings.Join(columns, "`, `"))).Error
+}
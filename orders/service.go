@@ -15,7 +15,8 @@ func NewOrderService(store OrderStore) OrderService {
 }
 
 // CreateOrder tạo đơn hàng mới
-func (s *Service) CreateOrder(ctx context.Context, customerID, branchID int32, items []OrderItem, appointmentID *int32, pickupTime *time.Time) (int32, string, error) {
+// promotions là các khuyến mãi Products service đã tính và ghi nhận lượt dùng cho đơn này
+func (s *Service) CreateOrder(ctx context.Context, customerID, branchID int32, items []OrderItem, appointmentID *int32, pickupTime *time.Time, promotions []OrderPromotion) (int32, string, error) {
 	order := &Order{
 		CustomerID:    customerID,
 		BranchID:      branchID,
@@ -24,13 +25,19 @@ func (s *Service) CreateOrder(ctx context.Context, customerID, branchID int32, i
 		CreatedAt:     time.Now(),
 		Items:         items,
 		PickupTime:    pickupTime,
+		Promotions:    promotions,
 	}
 	var total float32 = 0
 	for _, item := range items {
 		total += float32(item.Quantity) * item.UnitPrice
 	}
-	order.TotalPrice = total
-	println("total price:", total)
+	var discount float32
+	for _, p := range promotions {
+		discount += p.Discount
+	}
+	order.Subtotal = total
+	order.Discount = min(discount, total)
+	order.TotalPrice = total - order.Discount
 	// Lưu vào database
 	if err := s.store.CreateOrder(ctx, order); err != nil {
 		return 0, "Failed", err
@@ -68,6 +75,10 @@ func (s *Service) GetAllOrders(ctx context.Context) ([]Order, error) {
 	return orders, nil
 }
 
+func (s *Service) FindCompletedPurchase(ctx context.Context, customerID, productID int32, productType string) (int32, error) {
+	return s.store.FindCompletedPurchase(ctx, customerID, productID, productType)
+}
+
 // UpdateOrderStatus cập nhật trạng thái đơn hàng
 func (s *Service) UpdateOrderStatus(ctx context.Context, orderID int32, status OrderStatus) (string, error) {
 	if err := s.store.UpdateOrderStatus(ctx, orderID, status); err != nil {
@@ -76,6 +87,14 @@ func (s *Service) UpdateOrderStatus(ctx context.Context, orderID int32, status O
 	return "Success", nil
 }
 
+func (s *Service) ListStockPendingOrders(ctx context.Context, limit int) ([]Order, error) {
+	return s.store.ListStockPendingOrders(ctx, limit)
+}
+
+func (s *Service) MarkStockSettled(ctx context.Context, orderID int32) error {
+	return s.store.MarkStockSettled(ctx, orderID)
+}
+
 // GetOrderItems lấy danh sách sản phẩm trong đơn hàng
 func (s *Service) GetOrderItems(ctx context.Context, orderID int32) ([]OrderItem, error) {
 	return s.store.GetOrderItems(ctx, orderID)
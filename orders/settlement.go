@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	pbProduct "github.com/quanbin27/commons/genproto/products"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Xử lý kho khi đơn kết thúc: hoàn thành thì xuất kho các lần giữ hàng, huỷ thì trả lại kho và trả lượt dùng khuyến mãi.
+// Trạng thái đơn đổi trước và đơn được đánh dấu StockPending; các bước bên Products service đều bỏ qua phần đã xử lý
+// nên chạy lại nhiều lần (sweeper hoặc client gọi lại) vẫn an toàn.
+
+const (
+	stockSweepInterval = time.Minute
+	stockSweepBatch    = 50
+)
+
+// checkReservationsHeld - đơn chỉ hoàn thành được khi hàng vẫn đang được giữ (hoặc đã xuất) cho đơn
+func (h *OrderGrpcHandler) checkReservationsHeld(ctx context.Context, orderID int32) error {
+	items, err := h.orderService.GetOrderItems(ctx, orderID)
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	for _, item := range items {
+		if item.ReservationID == 0 {
+			continue
+		}
+		reservation, err := h.productClient.GetReservation(ctx, &pbProduct.GetReservationRequest{Id: item.ReservationID})
+		if err != nil {
+			return err
+		}
+		if reservation.Status != "held" && reservation.Status != "confirmed" {
+			return status.Errorf(codes.FailedPrecondition, "reservation %d of product %d is %s, stock is no longer held for this order",
+				reservation.Id, item.ProductID, reservation.Status)
+		}
+	}
+	return nil
+}
+
+// settleStock xử lý kho cho đơn đã kết thúc rồi bỏ đánh dấu StockPending
+func (h *OrderGrpcHandler) settleStock(ctx context.Context, order *Order) error {
+	if !order.Status.isFinal() {
+		return nil
+	}
+	if err := h.settleReservations(ctx, order.ID, order.Status); err != nil {
+		return err
+	}
+	if order.Status == OrderStatusCancelled {
+		var redemptionIDs []int32
+		for _, p := range order.Promotions {
+			if p.RedemptionID != 0 {
+				redemptionIDs = append(redemptionIDs, p.RedemptionID)
+			}
+		}
+		if len(redemptionIDs) > 0 {
+			if _, err := h.productClient.ReleasePromotionRedemptions(ctx, &pbProduct.ReleasePromotionRedemptionsRequest{RedemptionIds: redemptionIDs}); err != nil {
+				return err
+			}
+		}
+	}
+	return h.orderService.MarkStockSettled(ctx, order.ID)
+}
+
+// settleReservations xuất kho các lần giữ hàng khi đơn hoàn thành, hoặc trả lại kho khi đơn bị huỷ.
+// Lần giữ đã được xử lý trước đó (hoặc đã hết hạn) thì bỏ qua.
+func (h *OrderGrpcHandler) settleReservations(ctx context.Context, orderID int32, newStatus OrderStatus) error {
+	items, err := h.orderService.GetOrderItems(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item.ReservationID == 0 {
+			continue
+		}
+		reservation, err := h.productClient.GetReservation(ctx, &pbProduct.GetReservationRequest{Id: item.ReservationID})
+		if err != nil {
+			return err
+		}
+		if reservation.Status != "held" {
+			if newStatus == OrderStatusCompleted && reservation.Status != "confirmed" {
+				// Hết hạn giữa lúc kiểm tra và lúc xuất kho: hàng đã về kho, không còn gì để xuất
+				log.Printf("Reservation %d of completed order %d is %s, nothing to pick up", reservation.Id, orderID, reservation.Status)
+			}
+			continue
+		}
+		if newStatus == OrderStatusCompleted {
+			_, err = h.productClient.ConfirmPickup(ctx, &pbProduct.ConfirmPickupRequest{ReservationId: item.ReservationID})
+		} else {
+			_, err = h.productClient.ReleaseReservation(ctx, &pbProduct.ReleaseReservationRequest{ReservationId: item.ReservationID})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runStockSweeper định kỳ xử lý kho cho các đơn còn StockPending cho đến khi ctx bị huỷ
+func runStockSweeper(ctx context.Context, h *OrderGrpcHandler, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			orders, err := h.orderService.ListStockPendingOrders(ctx, stockSweepBatch)
+			if err != nil {
+				log.Printf("Failed to list orders with pending stock: %v", err)
+				continue
+			}
+			for i := range orders {
+				if err := h.settleStock(ctx, &orders[i]); err != nil {
+					log.Printf("Failed to settle stock of order %d: %v", orders[i].ID, err)
+				}
+			}
+		}
+	}
+}
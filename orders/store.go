@@ -3,8 +3,10 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Store triển khai OrderStore
@@ -30,7 +32,7 @@ func (s *Store) CreateOrder(ctx context.Context, order *Order) error {
 // GetOrderByID lấy thông tin đơn hàng theo ID
 func (s *Store) GetOrderByID(ctx context.Context, orderID int32) (*Order, error) {
 	var order Order
-	if err := s.db.WithContext(ctx).Preload("Items").First(&order, orderID).Error; err != nil {
+	if err := s.db.WithContext(ctx).Preload("Items").Preload("Promotions").First(&order, orderID).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
 		}
@@ -42,6 +44,7 @@ func (s *Store) GetOrderByAppointmentID(ctx context.Context, appointmentID int32
 	var order Order
 	if err := s.db.WithContext(ctx).
 		Preload("Items").
+		Preload("Promotions").
 		Where("appointment_id = ?", appointmentID).
 		First(&order).Error; err != nil {
 
@@ -56,6 +59,7 @@ func (s *Store) GetOrdersByCustomerID(ctx context.Context, customerID int32) ([]
 	var orders []Order
 	if err := s.db.WithContext(ctx).
 		Preload("Items").
+		Preload("Promotions").
 		Where("customer_id = ?", customerID).
 		Find(&orders).Error; err != nil {
 		return nil, err
@@ -66,15 +70,65 @@ func (s *Store) GetAllOrders(ctx context.Context) ([]Order, error) {
 	var orders []Order
 	if err := s.db.WithContext(ctx).
 		Preload("Items").
+		Preload("Promotions").
 		Find(&orders).Error; err != nil {
 		return nil, err
 	}
 	return orders, nil
 }
 
-// UpdateOrderStatus cập nhật trạng thái đơn hàng
+// FindCompletedPurchase trả về đơn COMPLETED gần nhất của khách có chứa sản phẩm (mọi biến thể), 0 nếu không có
+func (s *Store) FindCompletedPurchase(ctx context.Context, customerID, productID int32, productType string) (int32, error) {
+	var orderIDs []int32
+	err := s.db.WithContext(ctx).Model(&Order{}).
+		Joins("JOIN order_items ON order_items.order_id = orders.id").
+		Where("orders.customer_id = ? AND orders.status = ? AND order_items.product_id = ? AND order_items.product_type = ?",
+			customerID, OrderStatusCompleted, productID, productType).
+		Order("orders.id DESC").Limit(1).
+		Pluck("orders.id", &orderIDs).Error
+	if err != nil || len(orderIDs) == 0 {
+		return 0, err
+	}
+	return orderIDs[0], nil
+}
+
+// UpdateOrderStatus cập nhật trạng thái đơn hàng, khoá dòng để hai request đổi trạng thái cùng lúc không ghi đè nhau
 func (s *Store) UpdateOrderStatus(ctx context.Context, orderID int32, status OrderStatus) error {
-	return s.db.WithContext(ctx).Model(&Order{}).Where("id = ?", orderID).Update("status", status).Error
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var order Order
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, orderID).Error; err != nil {
+			return err
+		}
+		if order.Status == status {
+			return nil
+		}
+		if order.Status.isFinal() {
+			return fmt.Errorf("%w: order %d is already %s", ErrInvalidState, orderID, order.Status)
+		}
+		updates := map[string]interface{}{"status": status}
+		if status.isFinal() {
+			updates["stock_pending"] = true
+		}
+		return tx.Model(&order).Updates(updates).Error
+	})
+}
+
+// ListStockPendingOrders - các đơn chưa xử lý xong kho, cũ nhất trước
+func (s *Store) ListStockPendingOrders(ctx context.Context, limit int) ([]Order, error) {
+	var orders []Order
+	if err := s.db.WithContext(ctx).
+		Preload("Promotions").
+		Where("stock_pending = ?", true).
+		Order("updated_at").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (s *Store) MarkStockSettled(ctx context.Context, orderID int32) error {
+	return s.db.WithContext(ctx).Model(&Order{}).Where("id = ?", orderID).Update("stock_pending", false).Error
 }
 
 // GetOrderItems lấy danh sách sản phẩm trong đơn hàng
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	pb "github.com/quanbin27/commons/genproto/orders"
@@ -17,6 +18,14 @@ const (
 	OrderStatusCancelled OrderStatus = "CANCELLED"
 )
 
+// ErrInvalidState - thao tác không hợp lệ với trạng thái hiện tại của đơn
+var ErrInvalidState = errors.New("invalid state")
+
+// isFinal - đơn đã hoàn thành hoặc đã huỷ: kho đã xuất / trả nên không đổi sang trạng thái khác được nữa
+func (s OrderStatus) isFinal() bool {
+	return s == OrderStatusCompleted || s == OrderStatusCancelled
+}
+
 // Order đại diện cho đơn hàng
 type Order struct {
 	ID            int32 `gorm:"primaryKey"`
@@ -29,6 +38,24 @@ type Order struct {
 	PickupTime    *time.Time  `gorm:"column:pickup_time"`
 	UpdatedAt     time.Time   `gorm:"autoUpdateTime"`
 	Items         []OrderItem `gorm:"foreignKey:OrderID"`
+	// Subtotal là tổng tiền trước khuyến mãi, TotalPrice = Subtotal - Discount
+	Subtotal   float32          `gorm:"not null;default:0"`
+	Discount   float32          `gorm:"not null;default:0"`
+	Promotions []OrderPromotion `gorm:"foreignKey:OrderID"`
+	// StockPending - đơn đã sang COMPLETED/CANCELLED nhưng chưa xử lý xong các lần giữ hàng (và lượt dùng
+	// khuyến mãi khi huỷ) bên Products service; sweeper thử lại cho đến khi xong
+	StockPending bool `gorm:"not null;default:false;index"`
+}
+
+// OrderPromotion - Khuyến mãi đã áp dụng cho đơn hàng, RedemptionID là lượt dùng ghi nhận bên Products service
+type OrderPromotion struct {
+	ID           int32   `gorm:"primaryKey"`
+	OrderID      int32   `gorm:"index;not null"`
+	PromotionID  int32   `gorm:"not null"`
+	RedemptionID int32   `gorm:"index"`
+	Code         string  `gorm:"size:50"`
+	Name         string  `gorm:"size:255"`
+	Discount     float32 `gorm:"not null"`
 }
 
 // OrderItem đại diện cho sản phẩm trong đơn hàng
@@ -36,31 +63,43 @@ type OrderItem struct {
 	OrderID     int32   `gorm:"primaryKey"`
 	ProductID   int32   `gorm:"primaryKey"`
 	ProductType string  `gorm:"primaryKey"`
+	VariantID   int32   `gorm:"primaryKey;default:0"` // Biến thể bên Products service, 0 = sản phẩm không có biến thể
 	Quantity    int32   `gorm:"not null"`
 	UnitPrice   float32 `gorm:"not null"`
 	ProductName string  `gorm:"not null"`
+	// ReservationID là lần giữ hàng bên Products service, được xác nhận khi hoàn thành và trả lại khi huỷ đơn
+	ReservationID int32 `gorm:"index"`
 }
 
 // OrderStore interface làm việc với database
 type OrderStore interface {
 	CreateOrder(ctx context.Context, order *Order) error
 	GetOrderByID(ctx context.Context, orderID int32) (*Order, error)
+	// UpdateOrderStatus đổi trạng thái có điều kiện: đơn đã COMPLETED/CANCELLED không đổi được, chuyển sang
+	// COMPLETED/CANCELLED thì đánh dấu StockPending. Đặt lại đúng trạng thái hiện tại không đổi gì.
 	UpdateOrderStatus(ctx context.Context, orderID int32, status OrderStatus) error
+	ListStockPendingOrders(ctx context.Context, limit int) ([]Order, error)
+	MarkStockSettled(ctx context.Context, orderID int32) error
 	GetOrderItems(ctx context.Context, orderID int32) ([]OrderItem, error)
 	GetOrderByAppointmentID(ctx context.Context, appointmentID int32) (*Order, error)
 	GetOrdersByCustomerID(ctx context.Context, customerID int32) ([]Order, error)
 	GetAllOrders(ctx context.Context) ([]Order, error)
+	// FindCompletedPurchase trả về đơn COMPLETED gần nhất của khách có chứa sản phẩm, 0 nếu không có
+	FindCompletedPurchase(ctx context.Context, customerID, productID int32, productType string) (int32, error)
 }
 
 // OrderService interface cho logic xử lý với dữ liệu nội bộ
 type OrderService interface {
-	CreateOrder(ctx context.Context, customerID, branchID int32, items []OrderItem, appointmentID *int32, pickupTime *time.Time) (int32, string, error) // Trả về orderID, status
+	CreateOrder(ctx context.Context, customerID, branchID int32, items []OrderItem, appointmentID *int32, pickupTime *time.Time, promotions []OrderPromotion) (int32, string, error) // Trả về orderID, status
 	GetOrder(ctx context.Context, orderID int32) (*Order, error)
 	UpdateOrderStatus(ctx context.Context, orderID int32, status OrderStatus) (string, error) // Trả về status
+	ListStockPendingOrders(ctx context.Context, limit int) ([]Order, error)
+	MarkStockSettled(ctx context.Context, orderID int32) error
 	GetOrderItems(ctx context.Context, orderID int32) ([]OrderItem, error)
 	GetOrderByAppointmentID(ctx context.Context, appointmentId int32) (*Order, error)
 	GetOrdersByCustomerID(ctx context.Context, customerID int32) ([]Order, error)
 	GetAllOrders(ctx context.Context) ([]Order, error)
+	FindCompletedPurchase(ctx context.Context, customerID, productID int32, productType string) (int32, error)
 }
 
 // Helper functions to convert between internal types and protobuf types
@@ -116,27 +155,50 @@ func toPbOrder(o *Order) *pb.Order {
 			ProductName: item.ProductName,
 		}
 	}
+	subtotal := o.Subtotal
+	if subtotal == 0 && o.Discount == 0 {
+		// Đơn tạo trước khi có khuyến mãi chưa lưu Subtotal
+		subtotal = o.TotalPrice
+	}
 	return &pb.Order{
-		Id:            o.ID,
-		CustomerId:    o.CustomerID,
-		BranchId:      o.BranchID,
-		TotalPrice:    o.TotalPrice,
-		AppointmentId: appointmentID,
-		Status:        toPbOrderStatus(o.Status),
-		CreatedAt:     o.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:     o.UpdatedAt.Format(time.RFC3339),
-		Items:         pbItems,
-		PickupTime:    pbPickupTime,
+		Id:                o.ID,
+		CustomerId:        o.CustomerID,
+		BranchId:          o.BranchID,
+		TotalPrice:        o.TotalPrice,
+		AppointmentId:     appointmentID,
+		Status:            toPbOrderStatus(o.Status),
+		CreatedAt:         o.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         o.UpdatedAt.Format(time.RFC3339),
+		Items:             pbItems,
+		PickupTime:        pbPickupTime,
+		Subtotal:          subtotal,
+		Discount:          o.Discount,
+		AppliedPromotions: toPbAppliedPromotions(o.Promotions),
+	}
+}
+
+func toPbAppliedPromotions(promotions []OrderPromotion) []*pb.AppliedPromotion {
+	pbPromotions := make([]*pb.AppliedPromotion, len(promotions))
+	for i, p := range promotions {
+		pbPromotions[i] = &pb.AppliedPromotion{
+			PromotionId: p.PromotionID,
+			Code:        p.Code,
+			Name:        p.Name,
+			Discount:    p.Discount,
+		}
 	}
+	return pbPromotions
 }
 
 func toPbOrderItem(item OrderItem) *pb.OrderItem {
 	return &pb.OrderItem{
-		OrderId:     item.OrderID,
-		ProductId:   item.ProductID,
-		Quantity:    item.Quantity,
-		UnitPrice:   item.UnitPrice,
-		ProductType: item.ProductType,
-		ProductName: item.ProductName,
+		OrderId:       item.OrderID,
+		ProductId:     item.ProductID,
+		Quantity:      item.Quantity,
+		UnitPrice:     item.UnitPrice,
+		ProductType:   item.ProductType,
+		ProductName:   item.ProductName,
+		ReservationId: item.ReservationID,
+		VariantId:     item.VariantID,
 	}
 }
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	_ "time/tzdata" // image chạy service không chắc có sẵn zoneinfo
+)
+
+// Lịch mở cửa của chi nhánh.
+// Giờ mở cửa và ngày nghỉ được hiểu theo giờ địa phương (Branch.Timezone) của chi nhánh,
+// nên thời điểm cần kiểm tra (RFC3339, có offset) được đổi sang múi giờ đó trước khi so sánh.
+
+const (
+	defaultBranchTimezone = "Asia/Ho_Chi_Minh"
+	dateLayout            = "2006-01-02"
+	clockLayout           = "15:04"
+)
+
+// parseClock đổi "HH:MM" sang số phút tính từ 00:00
+func parseClock(s string) (int, error) {
+	t, err := time.Parse(clockLayout, s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid time %q, must be HH:MM", ErrInvalidArgument, s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// formatClock đổi số phút tính từ 00:00 về dạng "HH:MM" có số 0 đứng trước, để so sánh chuỗi đúng thứ tự
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func branchLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		tz = defaultBranchTimezone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown timezone %q", ErrInvalidArgument, tz)
+	}
+	return loc, nil
+}
+
+// validateOpeningHours kiểm tra, chuẩn hoá giờ về "HH:MM" ("9:00" -> "09:00") và sắp xếp các khung giờ;
+// các khung trong cùng một ngày không được chồng nhau
+func validateOpeningHours(hours []BranchOpeningHour) error {
+	for i := range hours {
+		h := &hours[i]
+		if h.Weekday < 0 || h.Weekday > 6 {
+			return fmt.Errorf("%w: weekday must be between 0 (Sunday) and 6 (Saturday)", ErrInvalidArgument)
+		}
+		open, err := parseClock(h.OpenTime)
+		if err != nil {
+			return err
+		}
+		closeAt, err := parseClock(h.CloseTime)
+		if err != nil {
+			return err
+		}
+		if closeAt <= open {
+			return fmt.Errorf("%w: close_time must be after open_time (%s - %s)", ErrInvalidArgument, h.OpenTime, h.CloseTime)
+		}
+		h.OpenTime, h.CloseTime = formatClock(open), formatClock(closeAt)
+	}
+	sort.Slice(hours, func(i, j int) bool {
+		if hours[i].Weekday != hours[j].Weekday {
+			return hours[i].Weekday < hours[j].Weekday
+		}
+		return hours[i].OpenTime < hours[j].OpenTime
+	})
+	for i := 1; i < len(hours); i++ {
+		prev, cur := hours[i-1], hours[i]
+		if prev.Weekday == cur.Weekday && cur.OpenTime < prev.CloseTime {
+			return fmt.Errorf("%w: overlapping opening hours on weekday %d", ErrInvalidArgument, cur.Weekday)
+		}
+	}
+	return nil
+}
+
+// isBranchOpen kiểm tra chi nhánh có mở cửa suốt khoảng [at, at+duration] không, trả về lý do nếu đóng.
+// Chi nhánh chưa cấu hình giờ mở cửa được coi là luôn mở, để dữ liệu cũ không bị chặn.
+func isBranchOpen(branch *Branch, hours []BranchOpeningHour, closures []BranchClosure, at time.Time, duration time.Duration) (bool, string, error) {
+	if !branch.IsActive {
+		return false, "branch is inactive", nil
+	}
+	loc, err := branchLocation(branch.Timezone)
+	if err != nil {
+		return false, "", err
+	}
+	start := at.In(loc)
+	end := start.Add(duration)
+
+	for _, c := range closures {
+		// Khoảng thời gian có thể kéo qua ngày hôm sau, kiểm tra cả ngày bắt đầu và ngày kết thúc
+		for _, day := range []string{start.Format(dateLayout), end.Format(dateLayout)} {
+			if day >= c.StartDate && day <= c.EndDate {
+				if c.Reason != "" {
+					return false, fmt.Sprintf("branch is closed on %s: %s", day, c.Reason), nil
+				}
+				return false, fmt.Sprintf("branch is closed on %s", day), nil
+			}
+		}
+	}
+
+	if len(hours) == 0 {
+		return true, "", nil
+	}
+	// to có thể vượt quá 24:00 khi khoảng thời gian kéo qua nửa đêm, khi đó không khung giờ nào chứa được
+	from := start.Hour()*60 + start.Minute()
+	to := from + int(duration/time.Minute)
+	for _, h := range hours {
+		if h.Weekday != int32(start.Weekday()) {
+			continue
+		}
+		open, err := parseClock(h.OpenTime)
+		if err != nil {
+			return false, "", err
+		}
+		closeAt, err := parseClock(h.CloseTime)
+		if err != nil {
+			return false, "", err
+		}
+		if from >= open && to <= closeAt {
+			return true, "", nil
+		}
+	}
+	return false, fmt.Sprintf("branch is not open at %s on %s", start.Format(clockLayout), start.Weekday()), nil
+}
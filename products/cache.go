@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/quanbin27/commons/cache"
+)
+
+// Cache danh mục sản phẩm trên Redis. cachedStore bọc ProductStore: các hàm liệt kê đọc qua cache,
+// các hàm ghi làm thay đổi dữ liệu được liệt kê thì xoá key tương ứng sau khi ghi.
+const (
+	cacheKeyAllProducts       = "all_products"
+	cacheKeyProductsWithStock = "all_products_with_stock"
+	cacheKeyReviewSummaries   = "review_summaries"
+
+	allProductsCacheTTL       = 10 * time.Minute
+	productsWithStockCacheTTL = time.Minute // tồn kho đổi liên tục theo giữ hàng, để TTL ngắn phòng khi xoá key lỗi
+	reviewSummariesCacheTTL   = 10 * time.Minute
+)
+
+// catalogKeys - Các key chứa thông tin sản phẩm (tên, giá, ảnh...)
+var catalogKeys = []string{cacheKeyAllProducts, cacheKeyProductsWithStock}
+
+type cachedStore struct {
+	ProductStore
+	cache *cache.Cache
+}
+
+func newCachedStore(store ProductStore, c *cache.Cache) ProductStore {
+	return &cachedStore{ProductStore: store, cache: c}
+}
+
+func (s *cachedStore) ListAllProducts(ctx context.Context) ([]GeneralProduct, error) {
+	return cache.Load(ctx, s.cache, cacheKeyAllProducts, allProductsCacheTTL, func() ([]GeneralProduct, error) {
+		return s.ProductStore.ListAllProducts(ctx)
+	})
+}
+
+func (s *cachedStore) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStock, error) {
+	return cache.Load(ctx, s.cache, cacheKeyProductsWithStock, productsWithStockCacheTTL, func() ([]ProductWithStock, error) {
+		return s.ProductStore.ListAllProductsWithStock(ctx)
+	})
+}
+
+func (s *cachedStore) ReviewSummaries(ctx context.Context) (map[string]ReviewSummary, error) {
+	return cache.Load(ctx, s.cache, cacheKeyReviewSummaries, reviewSummariesCacheTTL, func() (map[string]ReviewSummary, error) {
+		return s.ProductStore.ReviewSummaries(ctx)
+	})
+}
+
+// invalidate xoá keys khi err == nil rồi trả lại err, để các hàm ghi bên dưới viết gọn một dòng
+func (s *cachedStore) invalidate(ctx context.Context, err error, keys ...string) error {
+	if err == nil {
+		s.cache.Invalidate(ctx, keys...)
+	}
+	return err
+}
+
+// Sản phẩm
+func (s *cachedStore) CreateFood(ctx context.Context, food *Food) error {
+	return s.invalidate(ctx, s.ProductStore.CreateFood(ctx, food), catalogKeys...)
+}
+func (s *cachedStore) UpdateFood(ctx context.Context, food *Food) error {
+	return s.invalidate(ctx, s.ProductStore.UpdateFood(ctx, food), catalogKeys...)
+}
+func (s *cachedStore) DeleteFood(ctx context.Context, id int32) error {
+	return s.invalidate(ctx, s.ProductStore.DeleteFood(ctx, id), catalogKeys...)
+}
+func (s *cachedStore) CreateAccessory(ctx context.Context, accessory *Accessory) error {
+	return s.invalidate(ctx, s.ProductStore.CreateAccessory(ctx, accessory), catalogKeys...)
+}
+func (s *cachedStore) UpdateAccessory(ctx context.Context, accessory *Accessory) error {
+	return s.invalidate(ctx, s.ProductStore.UpdateAccessory(ctx, accessory), catalogKeys...)
+}
+func (s *cachedStore) DeleteAccessory(ctx context.Context, id int32) error {
+	return s.invalidate(ctx, s.ProductStore.DeleteAccessory(ctx, id), catalogKeys...)
+}
+func (s *cachedStore) CreateMedicine(ctx context.Context, medicine *Medicine) error {
+	return s.invalidate(ctx, s.ProductStore.CreateMedicine(ctx, medicine), catalogKeys...)
+}
+func (s *cachedStore) UpdateMedicine(ctx context.Context, medicine *Medicine) error {
+	return s.invalidate(ctx, s.ProductStore.UpdateMedicine(ctx, medicine), catalogKeys...)
+}
+func (s *cachedStore) DeleteMedicine(ctx context.Context, id int32) error {
+	return s.invalidate(ctx, s.ProductStore.DeleteMedicine(ctx, id), catalogKeys...)
+}
+func (s *cachedStore) RestoreProduct(ctx context.Context, productType string, productID int32) error {
+	return s.invalidate(ctx, s.ProductStore.RestoreProduct(ctx, productType, productID), catalogKeys...)
+}
+func (s *cachedStore) ImportProducts(ctx context.Context, rows []ProductImportRow) error {
+	return s.invalidate(ctx, s.ProductStore.ImportProducts(ctx, rows), catalogKeys...)
+}
+
+// Ảnh chính được đồng bộ vào img_url của sản phẩm
+func (s *cachedStore) AddProductImages(ctx context.Context, images []ProductImage) error {
+	return s.invalidate(ctx, s.ProductStore.AddProductImages(ctx, images), catalogKeys...)
+}
+func (s *cachedStore) DeleteProductImage(ctx context.Context, id int32) (*ProductImage, error) {
+	image, err := s.ProductStore.DeleteProductImage(ctx, id)
+	return image, s.invalidate(ctx, err, catalogKeys...)
+}
+
+// Tồn kho: mọi thay đổi stock_quantity (nhập hàng, điều chỉnh, giữ hàng và trả hàng giữ).
+// Xác nhận lấy hàng chỉ giảm reserved_quantity nên không ảnh hưởng số lượng có thể bán.
+func (s *cachedStore) UpdateBranchInventory(ctx context.Context, branchID int32, productID int32, productType string, variantID int32, stockQuantity int32) error {
+	err := s.ProductStore.UpdateBranchInventory(ctx, branchID, productID, productType, variantID, stockQuantity)
+	return s.invalidate(ctx, err, cacheKeyProductsWithStock)
+}
+func (s *cachedStore) ImportInventory(ctx context.Context, rows []InventoryImportRow) error {
+	return s.invalidate(ctx, s.ProductStore.ImportInventory(ctx, rows), cacheKeyProductsWithStock)
+}
+func (s *cachedStore) ReceivePurchaseOrder(ctx context.Context, purchaseOrderID int32, lines []GoodsReceiptLine, note string) (*GoodsReceipt, PurchaseOrderStatus, error) {
+	receipt, status, err := s.ProductStore.ReceivePurchaseOrder(ctx, purchaseOrderID, lines, note)
+	return receipt, status, s.invalidate(ctx, err, cacheKeyProductsWithStock)
+}
+func (s *cachedStore) ReserveProduct(ctx context.Context, reservation *Reservation) error {
+	return s.invalidate(ctx, s.ProductStore.ReserveProduct(ctx, reservation), cacheKeyProductsWithStock)
+}
+func (s *cachedStore) ReserveProducts(ctx context.Context, reservations []Reservation) ([]ReservationShortfall, error) {
+	shortfalls, err := s.ProductStore.ReserveProducts(ctx, reservations)
+	if len(shortfalls) > 0 {
+		return shortfalls, err
+	}
+	return shortfalls, s.invalidate(ctx, err, cacheKeyProductsWithStock)
+}
+func (s *cachedStore) ReleaseReservation(ctx context.Context, id int32) (*Reservation, error) {
+	reservation, err := s.ProductStore.ReleaseReservation(ctx, id)
+	return reservation, s.invalidate(ctx, err, cacheKeyProductsWithStock)
+}
+func (s *cachedStore) ExpireReservations(ctx context.Context, now time.Time, limit int) (int, error) {
+	n, err := s.ProductStore.ExpireReservations(ctx, now, limit)
+	if n > 0 {
+		s.cache.Invalidate(ctx, cacheKeyProductsWithStock)
+	}
+	return n, err
+}
+
+// Giá hẹn trước được ghi thẳng vào sản phẩm khi đến hạn
+func (s *cachedStore) ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]PriceChange, error) {
+	applied, err := s.ProductStore.ApplyDuePriceChanges(ctx, now, limit)
+	if len(applied) > 0 {
+		s.cache.Invalidate(ctx, catalogKeys...)
+	}
+	return applied, err
+}
+
+// Đánh giá: chỉ đánh giá đã duyệt được tính điểm nên chỉ kiểm duyệt mới làm đổi điểm
+func (s *cachedStore) UpdateReviewModeration(ctx context.Context, review *Review) error {
+	return s.invalidate(ctx, s.ProductStore.UpdateReviewModeration(ctx, review), cacheKeyReviewSummaries)
+}
@@ -2,22 +2,39 @@ package main
 
 import (
 	"context"
+	"errors"
+	"github.com/quanbin27/commons/cache"
+	"github.com/quanbin27/commons/config"
+	pbOrder "github.com/quanbin27/commons/genproto/orders"
 	pb "github.com/quanbin27/commons/genproto/products"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"log"
+	"time"
 )
 
 type ProductGrpcHandler struct {
 	productService ProductService
+	orderClient    pbOrder.OrderServiceClient
+	catalogCache   *cache.Cache
 	pb.UnimplementedProductServiceServer
 }
 
-func NewProductGrpcHandler(grpc *grpc.Server, productService ProductService) {
+func NewProductGrpcHandler(grpcServer *grpc.Server, productService ProductService, catalogCache *cache.Cache) {
+	// Orders service dùng để kiểm tra khách đã mua sản phẩm trước khi cho đánh giá
+	ordersConn, err := grpc.NewClient(config.Envs.OrdersGrpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to dial orders server: %v", err)
+	}
 	grpcHandler := &ProductGrpcHandler{
 		productService: productService,
+		orderClient:    pbOrder.NewOrderServiceClient(ordersConn),
+		catalogCache:   catalogCache,
 	}
-	pb.RegisterProductServiceServer(grpc, grpcHandler)
+	pb.RegisterProductServiceServer(grpcServer, grpcHandler)
 }
 
 // Thực phẩm
@@ -42,15 +59,15 @@ func (h *ProductGrpcHandler) ListFoods(ctx context.Context, req *pb.ListFoodRequ
 }
 
 func (h *ProductGrpcHandler) CreateFood(ctx context.Context, req *pb.CreateFoodRequest) (*pb.CreateFoodResponse, error) {
-	stt, err := h.productService.CreateFood(ctx, req.Name, req.Description, req.Price)
+	stt, err := h.productService.CreateFood(ctx, req.Name, req.Description, req.Price, req.Imgurl, req.Sku, req.Barcode)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.CreateFoodResponse{Status: stt}, nil
 }
 
 func (h *ProductGrpcHandler) UpdateFood(ctx context.Context, req *pb.UpdateFoodRequest) (*pb.UpdateFoodResponse, error) {
-	stt, err := h.productService.UpdateFood(ctx, req.Id, req.Name, req.Description, req.Price)
+	stt, err := h.productService.UpdateFood(ctx, req.Id, req.Name, req.Description, req.Price, req.Imgurl, req.Sku, req.Barcode)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -60,7 +77,7 @@ func (h *ProductGrpcHandler) UpdateFood(ctx context.Context, req *pb.UpdateFoodR
 func (h *ProductGrpcHandler) DeleteFood(ctx context.Context, req *pb.DeleteFoodRequest) (*pb.DeleteFoodResponse, error) {
 	stt, err := h.productService.DeleteFood(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.DeleteFoodResponse{Status: stt}, nil
 }
@@ -87,15 +104,15 @@ func (h *ProductGrpcHandler) ListAccessories(ctx context.Context, req *pb.ListAc
 }
 
 func (h *ProductGrpcHandler) CreateAccessory(ctx context.Context, req *pb.CreateAccessoryRequest) (*pb.CreateAccessoryResponse, error) {
-	stt, err := h.productService.CreateAccessory(ctx, req.Name, req.Description, req.Price)
+	stt, err := h.productService.CreateAccessory(ctx, req.Name, req.Description, req.Price, req.Imgurl, req.Sku, req.Barcode)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.CreateAccessoryResponse{Status: stt}, nil
 }
 
 func (h *ProductGrpcHandler) UpdateAccessory(ctx context.Context, req *pb.UpdateAccessoryRequest) (*pb.UpdateAccessoryResponse, error) {
-	stt, err := h.productService.UpdateAccessory(ctx, req.Id, req.Name, req.Description, req.Price)
+	stt, err := h.productService.UpdateAccessory(ctx, req.Id, req.Name, req.Description, req.Price, req.Imgurl, req.Sku, req.Barcode)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -105,7 +122,7 @@ func (h *ProductGrpcHandler) UpdateAccessory(ctx context.Context, req *pb.Update
 func (h *ProductGrpcHandler) DeleteAccessory(ctx context.Context, req *pb.DeleteAccessoryRequest) (*pb.DeleteAccessoryResponse, error) {
 	stt, err := h.productService.DeleteAccessory(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.DeleteAccessoryResponse{Status: stt}, nil
 }
@@ -132,15 +149,15 @@ func (h *ProductGrpcHandler) ListMedicines(ctx context.Context, req *pb.ListMedi
 }
 
 func (h *ProductGrpcHandler) CreateMedicine(ctx context.Context, req *pb.CreateMedicineRequest) (*pb.CreateMedicineResponse, error) {
-	stt, err := h.productService.CreateMedicine(ctx, req.Name, req.Description, req.Price)
+	stt, err := h.productService.CreateMedicine(ctx, req.Name, req.Description, req.Price, req.Imgurl, req.Sku, req.Barcode)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.CreateMedicineResponse{Status: stt}, nil
 }
 
 func (h *ProductGrpcHandler) UpdateMedicine(ctx context.Context, req *pb.UpdateMedicineRequest) (*pb.UpdateMedicineResponse, error) {
-	stt, err := h.productService.UpdateMedicine(ctx, req.Id, req.Name, req.Description, req.Price)
+	stt, err := h.productService.UpdateMedicine(ctx, req.Id, req.Name, req.Description, req.Price, req.Imgurl, req.Sku, req.Barcode)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
@@ -150,11 +167,32 @@ func (h *ProductGrpcHandler) UpdateMedicine(ctx context.Context, req *pb.UpdateM
 func (h *ProductGrpcHandler) DeleteMedicine(ctx context.Context, req *pb.DeleteMedicineRequest) (*pb.DeleteMedicineResponse, error) {
 	stt, err := h.productService.DeleteMedicine(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.DeleteMedicineResponse{Status: stt}, nil
 }
 
+// Sản phẩm đã lưu trữ
+func (h *ProductGrpcHandler) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.RestoreProductResponse, error) {
+	stt, err := h.productService.RestoreProduct(ctx, req.ProductType, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.RestoreProductResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) ListArchivedProducts(ctx context.Context, req *pb.ListArchivedProductsRequest) (*pb.ListAllProductsResponse, error) {
+	products, err := h.productService.ListArchivedProducts(ctx)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListAllProductsResponse{}
+	for _, p := range products {
+		resp.Products = append(resp.Products, toProtoGeneralProduct(&p))
+	}
+	return resp, nil
+}
+
 // Chi nhánh
 func (h *ProductGrpcHandler) GetBranchByID(ctx context.Context, req *pb.GetBranchRequest) (*pb.Branch, error) {
 	branch, err := h.productService.GetBranchByID(ctx, req.Id)
@@ -165,7 +203,7 @@ func (h *ProductGrpcHandler) GetBranchByID(ctx context.Context, req *pb.GetBranc
 }
 
 func (h *ProductGrpcHandler) ListBranches(ctx context.Context, req *pb.ListBranchRequest) (*pb.ListBranchResponse, error) {
-	branches, err := h.productService.ListBranches(ctx)
+	branches, err := h.productService.ListBranches(ctx, req.IncludeInactive)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -176,6 +214,119 @@ func (h *ProductGrpcHandler) ListBranches(ctx context.Context, req *pb.ListBranc
 	return resp, nil
 }
 
+func (h *ProductGrpcHandler) CreateBranch(ctx context.Context, req *pb.CreateBranchRequest) (*pb.CreateBranchResponse, error) {
+	id, stt, err := h.productService.CreateBranch(ctx, &Branch{
+		Name:                req.Name,
+		Location:            req.Location,
+		Phone:               req.Phone,
+		Email:               req.Email,
+		Latitude:            req.Latitude,
+		Longitude:           req.Longitude,
+		Timezone:            req.Timezone,
+		AppointmentCapacity: req.AppointmentCapacity,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CreateBranchResponse{BranchId: id, Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateBranch(ctx context.Context, req *pb.UpdateBranchRequest) (*pb.UpdateBranchResponse, error) {
+	stt, err := h.productService.UpdateBranch(ctx, &Branch{
+		ID:                  req.Id,
+		Name:                req.Name,
+		Location:            req.Location,
+		Phone:               req.Phone,
+		Email:               req.Email,
+		Latitude:            req.Latitude,
+		Longitude:           req.Longitude,
+		Timezone:            req.Timezone,
+		AppointmentCapacity: req.AppointmentCapacity,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.UpdateBranchResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) DeactivateBranch(ctx context.Context, req *pb.DeactivateBranchRequest) (*pb.DeactivateBranchResponse, error) {
+	stt, err := h.productService.DeactivateBranch(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.DeactivateBranchResponse{Status: stt}, nil
+}
+
+// Giờ mở cửa & ngày nghỉ
+func (h *ProductGrpcHandler) SetBranchOpeningHours(ctx context.Context, req *pb.SetBranchOpeningHoursRequest) (*pb.SetBranchOpeningHoursResponse, error) {
+	hours := make([]BranchOpeningHour, len(req.Hours))
+	for i, oh := range req.Hours {
+		hours[i] = BranchOpeningHour{
+			Weekday:   oh.Weekday,
+			OpenTime:  oh.OpenTime,
+			CloseTime: oh.CloseTime,
+		}
+	}
+	stt, err := h.productService.SetBranchOpeningHours(ctx, req.BranchId, hours)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.SetBranchOpeningHoursResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) GetBranchSchedule(ctx context.Context, req *pb.GetBranchScheduleRequest) (*pb.BranchSchedule, error) {
+	branch, hours, closures, err := h.productService.GetBranchSchedule(ctx, req.BranchId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.BranchSchedule{
+		BranchId:            branch.ID,
+		Timezone:            branch.Timezone,
+		AppointmentCapacity: branch.AppointmentCapacity,
+		IsActive:            branch.IsActive,
+	}
+	for _, oh := range hours {
+		resp.OpeningHours = append(resp.OpeningHours, toProtoOpeningHour(&oh))
+	}
+	for _, c := range closures {
+		resp.Closures = append(resp.Closures, toProtoBranchClosure(&c))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) AddBranchClosure(ctx context.Context, req *pb.AddBranchClosureRequest) (*pb.BranchClosure, error) {
+	closure, err := h.productService.AddBranchClosure(ctx, &BranchClosure{
+		BranchID:  req.BranchId,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoBranchClosure(closure), nil
+}
+
+func (h *ProductGrpcHandler) DeleteBranchClosure(ctx context.Context, req *pb.DeleteBranchClosureRequest) (*pb.DeleteBranchClosureResponse, error) {
+	stt, err := h.productService.DeleteBranchClosure(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.DeleteBranchClosureResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) CheckBranchOpen(ctx context.Context, req *pb.CheckBranchOpenRequest) (*pb.CheckBranchOpenResponse, error) {
+	at, err := time.Parse(time.RFC3339, req.Time)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time, must be RFC3339: %v", err)
+	}
+	open, reason, err := h.productService.CheckBranchOpen(ctx, req.BranchId, at, time.Duration(req.DurationMinutes)*time.Minute)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CheckBranchOpenResponse{IsOpen: open, Reason: reason}, nil
+}
+
 // Tồn kho
 func (h *ProductGrpcHandler) GetBranchInventory(ctx context.Context, req *pb.GetBranchInventoryRequest) (*pb.GetBranchInventoryResponse, error) {
 	inventory, err := h.productService.GetBranchInventory(ctx, req.BranchId)
@@ -190,9 +341,9 @@ func (h *ProductGrpcHandler) GetBranchInventory(ctx context.Context, req *pb.Get
 }
 
 func (h *ProductGrpcHandler) UpdateBranchInventory(ctx context.Context, req *pb.UpdateBranchInventoryRequest) (*pb.UpdateBranchInventoryResponse, error) {
-	stt, err := h.productService.UpdateBranchInventory(ctx, req.BranchId, req.ProductId, req.ProductType, req.StockQuantity)
+	stt, err := h.productService.UpdateBranchInventory(ctx, req.BranchId, req.ProductId, req.ProductType, req.VariantId, req.StockQuantity)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	return &pb.UpdateBranchInventoryResponse{Status: stt}, nil
 }
@@ -232,10 +383,92 @@ func (h *ProductGrpcHandler) ListAllProductsWithStock(ctx context.Context, req *
 	return resp, nil
 }
 
+func (h *ProductGrpcHandler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	result, err := h.productService.SearchProducts(ctx, SearchQuery{
+		Keyword:         req.Keyword,
+		ProductTypes:    req.ProductTypes,
+		MinPrice:        req.MinPrice,
+		MaxPrice:        req.MaxPrice,
+		IsAttachable:    req.IsAttachable,
+		InStockBranchID: req.InStockBranchId,
+		SortBy:          req.SortBy,
+		Page:            req.Page,
+		PageSize:        req.PageSize,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.SearchProductsResponse{
+		Total:  result.Total,
+		Facets: toProtoSearchFacets(&result.Facets),
+	}
+	for _, p := range result.Products {
+		resp.Products = append(resp.Products, toProtoGeneralProduct(&p))
+	}
+	return resp, nil
+}
+
+// Ảnh sản phẩm
+func (h *ProductGrpcHandler) AddProductImage(ctx context.Context, req *pb.AddProductImageRequest) (*pb.ProductImage, error) {
+	images, err := h.productService.AddProductImages(ctx, []ProductImage{fromProtoAddProductImage(req)})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoProductImage(&images[0]), nil
+}
+
+func (h *ProductGrpcHandler) AddProductImages(ctx context.Context, req *pb.AddProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	images := make([]ProductImage, len(req.Images))
+	for i, img := range req.Images {
+		images[i] = fromProtoAddProductImage(img)
+	}
+	images, err := h.productService.AddProductImages(ctx, images)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListProductImagesResponse{}
+	for i := range images {
+		resp.Images = append(resp.Images, toProtoProductImage(&images[i]))
+	}
+	return resp, nil
+}
+
+func fromProtoAddProductImage(req *pb.AddProductImageRequest) ProductImage {
+	return ProductImage{
+		ProductID:    req.ProductId,
+		ProductType:  req.ProductType,
+		URL:          req.Url,
+		ThumbnailURL: req.ThumbnailUrl,
+		StorageKey:   req.StorageKey,
+		ThumbnailKey: req.ThumbnailKey,
+		IsPrimary:    req.IsPrimary,
+	}
+}
+
+func (h *ProductGrpcHandler) ListProductImages(ctx context.Context, req *pb.ListProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	images, err := h.productService.ListProductImages(ctx, req.ProductId, req.ProductType)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListProductImagesResponse{}
+	for _, img := range images {
+		resp.Images = append(resp.Images, toProtoProductImage(&img))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) DeleteProductImage(ctx context.Context, req *pb.DeleteProductImageRequest) (*pb.ProductImage, error) {
+	image, err := h.productService.DeleteProductImage(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoProductImage(image), nil
+}
+
 func (h *ProductGrpcHandler) ListAvailableProductsByBranch(ctx context.Context, req *pb.ListAvailableProductsByBranchRequest) (*pb.ListAvailableProductsByBranchResponse, error) {
 	products, err := h.productService.ListAvailableProductsByBranch(ctx, req.BranchId, req.ProductType)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toGrpcError(err)
 	}
 	resp := &pb.ListAvailableProductsByBranchResponse{}
 	for _, p := range products {
@@ -254,26 +487,569 @@ func (h *ProductGrpcHandler) ListAvailableAllProductsByBranch(ctx context.Contex
 	}
 	return resp, nil
 }
+func (h *ProductGrpcHandler) GetProductByBarcode(ctx context.Context, req *pb.GetProductByBarcodeRequest) (*pb.ProductLookup, error) {
+	lookup, err := h.productService.GetProductByBarcode(ctx, req.Code, req.BranchId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoProductLookup(lookup), nil
+}
+
+func (h *ProductGrpcHandler) CreateVariant(ctx context.Context, req *pb.CreateVariantRequest) (*pb.CreateVariantResponse, error) {
+	if req.Variant == nil {
+		return nil, status.Error(codes.InvalidArgument, "variant is required")
+	}
+	id, stt, err := h.productService.CreateVariant(ctx, fromProtoVariant(req.Variant))
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CreateVariantResponse{VariantId: id, Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateVariant(ctx context.Context, req *pb.UpdateVariantRequest) (*pb.UpdateVariantResponse, error) {
+	if req.Variant == nil {
+		return nil, status.Error(codes.InvalidArgument, "variant is required")
+	}
+	stt, err := h.productService.UpdateVariant(ctx, fromProtoVariant(req.Variant))
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.UpdateVariantResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) GetVariant(ctx context.Context, req *pb.GetVariantRequest) (*pb.ProductVariant, error) {
+	variant, err := h.productService.GetVariant(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoVariant(variant), nil
+}
+
+func (h *ProductGrpcHandler) ListVariants(ctx context.Context, req *pb.ListVariantsRequest) (*pb.ListVariantsResponse, error) {
+	variants, err := h.productService.ListVariants(ctx, req.ProductId, req.ProductType, req.IncludeInactive)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListVariantsResponse{}
+	for i := range variants {
+		resp.Variants = append(resp.Variants, toProtoVariant(&variants[i]))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) DeactivateVariant(ctx context.Context, req *pb.DeactivateVariantRequest) (*pb.DeactivateVariantResponse, error) {
+	stt, err := h.productService.DeactivateVariant(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.DeactivateVariantResponse{Status: stt}, nil
+}
+
 func (h *ProductGrpcHandler) ReserveProduct(ctx context.Context, req *pb.ReserveProductRequest) (*pb.ReserveProductResponse, error) {
-	err := h.productService.ReserveProduct(ctx, req.BranchId, req.ProductId, req.ProductType, req.Quantity)
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	reservation, err := h.productService.ReserveProduct(ctx, req.BranchId, req.ProductId, req.ProductType, req.VariantId, req.Quantity, req.OrderId, ttl)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.ReserveProductResponse{
+		Success:       true,
+		Message:       "Success",
+		ReservationId: reservation.ID,
+		ExpiresAt:     formatOptionalTime(reservation.ExpiresAt),
+	}, nil
+}
+
+func (h *ProductGrpcHandler) ReserveProducts(ctx context.Context, req *pb.ReserveProductsRequest) (*pb.ReserveProductsResponse, error) {
+	items := make([]ReserveItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = ReserveItem{ProductID: item.ProductId, ProductType: item.ProductType, VariantID: item.VariantId, Quantity: item.Quantity}
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	reservations, shortfalls, err := h.productService.ReserveProducts(ctx, req.BranchId, items, req.OrderId, ttl)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	if len(shortfalls) > 0 {
+		resp := &pb.ReserveProductsResponse{Success: false, Message: "Not enough stock available"}
+		for _, sf := range shortfalls {
+			resp.Shortfalls = append(resp.Shortfalls, &pb.ReservationShortfall{
+				ProductId:   sf.ProductID,
+				ProductType: sf.ProductType,
+				VariantId:   sf.VariantID,
+				Requested:   sf.Requested,
+				Available:   sf.Available,
+			})
+		}
+		return resp, nil
+	}
+	resp := &pb.ReserveProductsResponse{Success: true, Message: "Success"}
+	for i := range reservations {
+		resp.Reservations = append(resp.Reservations, toProtoReservation(&reservations[i]))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) GetReservation(ctx context.Context, req *pb.GetReservationRequest) (*pb.Reservation, error) {
+	reservation, err := h.productService.GetReservation(ctx, req.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to reserve product: %v", err)
+		return nil, toGrpcError(err)
 	}
-	return &pb.ReserveProductResponse{}, nil
+	return toProtoReservation(reservation), nil
 }
 
 func (h *ProductGrpcHandler) ConfirmPickup(ctx context.Context, req *pb.ConfirmPickupRequest) (*pb.ConfirmPickupResponse, error) {
-	err := h.productService.ConfirmPickup(ctx, req.BranchId, req.ProductId, req.ProductType, req.Quantity)
+	reservation, err := h.productService.ConfirmPickup(ctx, req.ReservationId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to confirm pickup: %v", err)
+		return nil, toGrpcError(err)
 	}
-	return &pb.ConfirmPickupResponse{}, nil
+	return &pb.ConfirmPickupResponse{Success: true, Message: "Success", Reservation: toProtoReservation(reservation)}, nil
 }
 
 func (h *ProductGrpcHandler) ReleaseReservation(ctx context.Context, req *pb.ReleaseReservationRequest) (*pb.ReleaseReservationResponse, error) {
-	err := h.productService.ReleaseReservation(ctx, req.BranchId, req.ProductId, req.ProductType, req.Quantity)
+	reservation, err := h.productService.ReleaseReservation(ctx, req.ReservationId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.ReleaseReservationResponse{Success: true, Message: "Success", Reservation: toProtoReservation(reservation)}, nil
+}
+
+func (h *ProductGrpcHandler) AssignReservations(ctx context.Context, req *pb.AssignReservationsRequest) (*pb.AssignReservationsResponse, error) {
+	expiresAt, err := parseOptionalTime("expires_at", req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	stt, err := h.productService.AssignReservations(ctx, req.ReservationIds, req.OrderId, expiresAt)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.AssignReservationsResponse{Status: stt}, nil
+}
+
+// toGrpcError ánh xạ lỗi nghiệp vụ sang mã gRPC tương ứng để gateway trả đúng HTTP status
+func toGrpcError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidArgument), errors.Is(err, ErrInvalidProductType):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrInvalidState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// Nhà cung cấp
+func (h *ProductGrpcHandler) CreateSupplier(ctx context.Context, req *pb.CreateSupplierRequest) (*pb.CreateSupplierResponse, error) {
+	id, stt, err := h.productService.CreateSupplier(ctx, &Supplier{
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Phone:       req.Phone,
+		Email:       req.Email,
+		Address:     req.Address,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CreateSupplierResponse{SupplierId: id, Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) UpdateSupplier(ctx context.Context, req *pb.UpdateSupplierRequest) (*pb.UpdateSupplierResponse, error) {
+	stt, err := h.productService.UpdateSupplier(ctx, &Supplier{
+		ID:          req.Id,
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Phone:       req.Phone,
+		Email:       req.Email,
+		Address:     req.Address,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.UpdateSupplierResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) ListSuppliers(ctx context.Context, req *pb.ListSuppliersRequest) (*pb.ListSuppliersResponse, error) {
+	suppliers, err := h.productService.ListSuppliers(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	resp := &pb.ListSuppliersResponse{}
+	for _, s := range suppliers {
+		resp.Suppliers = append(resp.Suppliers, toProtoSupplier(&s))
+	}
+	return resp, nil
+}
+
+// Đơn nhập hàng
+func (h *ProductGrpcHandler) CreatePurchaseOrder(ctx context.Context, req *pb.CreatePurchaseOrderRequest) (*pb.CreatePurchaseOrderResponse, error) {
+	var expectedDate *time.Time
+	if req.ExpectedDate != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpectedDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expected_date format: %v", err)
+		}
+		expectedDate = &t
+	}
+	lines := make([]PurchaseOrderLine, len(req.Lines))
+	for i, l := range req.Lines {
+		lines[i] = PurchaseOrderLine{
+			ProductID:       l.ProductId,
+			ProductType:     l.ProductType,
+			VariantID:       l.VariantId,
+			QuantityOrdered: l.Quantity,
+			UnitCost:        l.UnitCost,
+		}
+	}
+	id, stt, err := h.productService.CreatePurchaseOrder(ctx, req.SupplierId, req.BranchId, expectedDate, req.Note, lines)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CreatePurchaseOrderResponse{PurchaseOrderId: id, Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) GetPurchaseOrder(ctx context.Context, req *pb.GetPurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	order, err := h.productService.GetPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoPurchaseOrder(order), nil
+}
+
+func (h *ProductGrpcHandler) ListPurchaseOrders(ctx context.Context, req *pb.ListPurchaseOrdersRequest) (*pb.ListPurchaseOrdersResponse, error) {
+	orders, err := h.productService.ListPurchaseOrders(ctx, PurchaseOrderFilter{
+		SupplierID: req.SupplierId,
+		BranchID:   req.BranchId,
+		Status:     PurchaseOrderStatus(req.Status),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	resp := &pb.ListPurchaseOrdersResponse{}
+	for _, o := range orders {
+		resp.PurchaseOrders = append(resp.PurchaseOrders, toProtoPurchaseOrder(&o))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) CancelPurchaseOrder(ctx context.Context, req *pb.CancelPurchaseOrderRequest) (*pb.CancelPurchaseOrderResponse, error) {
+	stt, err := h.productService.CancelPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CancelPurchaseOrderResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) ReceivePurchaseOrder(ctx context.Context, req *pb.ReceivePurchaseOrderRequest) (*pb.ReceivePurchaseOrderResponse, error) {
+	lines := make([]GoodsReceiptLine, len(req.Lines))
+	for i, l := range req.Lines {
+		lines[i] = GoodsReceiptLine{
+			PurchaseOrderLineID: l.LineId,
+			Quantity:            l.Quantity,
+			UnitCost:            l.UnitCost,
+		}
+	}
+	receiptID, poStatus, err := h.productService.ReceivePurchaseOrder(ctx, req.PurchaseOrderId, lines, req.Note)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.ReceivePurchaseOrderResponse{ReceiptId: receiptID, PurchaseOrderStatus: string(poStatus)}, nil
+}
+
+// Nhập hàng loạt
+func (h *ProductGrpcHandler) ImportProducts(ctx context.Context, req *pb.ImportProductsRequest) (*pb.ImportResponse, error) {
+	rows := make([]ProductImportRow, len(req.Rows))
+	for i, r := range req.Rows {
+		rows[i] = ProductImportRow{
+			Row:          r.Row,
+			ProductType:  r.ProductType,
+			ProductID:    r.ProductId,
+			Name:         r.Name,
+			Description:  r.Description,
+			Price:        r.Price,
+			IsAttachable: r.IsAttachable,
+			ImgUrl:       r.Imgurl,
+		}
+	}
+	result, err := h.productService.ImportProducts(ctx, rows, req.DryRun)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoImportResult(result), nil
+}
+
+func (h *ProductGrpcHandler) ImportInventory(ctx context.Context, req *pb.ImportInventoryRequest) (*pb.ImportResponse, error) {
+	rows := make([]InventoryImportRow, len(req.Rows))
+	for i, r := range req.Rows {
+		rows[i] = InventoryImportRow{
+			Row:           r.Row,
+			BranchID:      r.BranchId,
+			ProductType:   r.ProductType,
+			ProductID:     r.ProductId,
+			VariantID:     r.VariantId,
+			StockQuantity: r.StockQuantity,
+		}
+	}
+	result, err := h.productService.ImportInventory(ctx, rows, req.DryRun)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoImportResult(result), nil
+}
+
+// Khuyến mãi
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s, must be RFC3339: %v", field, err)
+	}
+	return &t, nil
+}
+
+func fromProtoPromotion(p *pb.Promotion) (*Promotion, error) {
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "promotion is required")
+	}
+	startsAt, err := parseOptionalTime("starts_at", p.StartsAt)
+	if err != nil {
+		return nil, err
+	}
+	endsAt, err := parseOptionalTime("ends_at", p.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Promotion{
+		ID:               p.Id,
+		Name:             p.Name,
+		Description:      p.Description,
+		Code:             CouponCode(p.Code),
+		Type:             PromotionType(p.Type),
+		Value:            p.Value,
+		MaxDiscount:      p.MaxDiscount,
+		BuyQuantity:      p.BuyQuantity,
+		GetQuantity:      p.GetQuantity,
+		Scope:            PromotionScope(p.Scope),
+		Category:         p.Category,
+		ItemType:         p.ItemType,
+		ItemID:           p.ItemId,
+		MinSpend:         p.MinSpend,
+		StartsAt:         startsAt,
+		EndsAt:           endsAt,
+		UsageLimit:       p.UsageLimit,
+		PerCustomerLimit: p.PerCustomerLimit,
+	}, nil
+}
+
+func fromProtoCartItems(items []*pb.CartItem) []CartItem {
+	cart := make([]CartItem, len(items))
+	for i, item := range items {
+		cart[i] = CartItem{
+			ItemType:  item.ItemType,
+			ItemID:    item.ItemId,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		}
+	}
+	return cart
+}
+
+func (h *ProductGrpcHandler) CreatePromotion(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.CreatePromotionResponse, error) {
+	promotion, err := fromProtoPromotion(req.Promotion)
+	if err != nil {
+		return nil, err
+	}
+	id, stt, err := h.productService.CreatePromotion(ctx, promotion)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CreatePromotionResponse{PromotionId: id, Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) UpdatePromotion(ctx context.Context, req *pb.UpdatePromotionRequest) (*pb.UpdatePromotionResponse, error) {
+	promotion, err := fromProtoPromotion(req.Promotion)
+	if err != nil {
+		return nil, err
+	}
+	stt, err := h.productService.UpdatePromotion(ctx, promotion)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.UpdatePromotionResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) DeactivatePromotion(ctx context.Context, req *pb.DeactivatePromotionRequest) (*pb.DeactivatePromotionResponse, error) {
+	stt, err := h.productService.DeactivatePromotion(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.DeactivatePromotionResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) ListPromotions(ctx context.Context, req *pb.ListPromotionsRequest) (*pb.ListPromotionsResponse, error) {
+	promotions, err := h.productService.ListPromotions(ctx, req.IncludeInactive)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListPromotionsResponse{}
+	for _, p := range promotions {
+		resp.Promotions = append(resp.Promotions, toProtoPromotion(&p))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) PriceCart(ctx context.Context, req *pb.PriceCartRequest) (*pb.CartPricing, error) {
+	pricing, err := h.productService.PriceCart(ctx, req.CustomerId, fromProtoCartItems(req.Items), req.CouponCodes)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoCartPricing(pricing), nil
+}
+
+func (h *ProductGrpcHandler) RedeemPromotions(ctx context.Context, req *pb.PriceCartRequest) (*pb.CartPricing, error) {
+	pricing, err := h.productService.RedeemPromotions(ctx, req.CustomerId, fromProtoCartItems(req.Items), req.CouponCodes)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoCartPricing(pricing), nil
+}
+
+func (h *ProductGrpcHandler) ReleasePromotionRedemptions(ctx context.Context, req *pb.ReleasePromotionRedemptionsRequest) (*pb.ReleasePromotionRedemptionsResponse, error) {
+	stt, err := h.productService.ReleasePromotionRedemptions(ctx, req.RedemptionIds)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.ReleasePromotionRedemptionsResponse{Status: stt}, nil
+}
+
+// Đánh giá sản phẩm
+func (h *ProductGrpcHandler) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.Review, error) {
+	productType, err := normalizeProductType(req.ProductType)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	purchase, err := h.orderClient.FindCompletedPurchase(ctx, &pbOrder.FindCompletedPurchaseRequest{
+		CustomerId:  req.CustomerId,
+		ProductId:   req.ProductId,
+		ProductType: productType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	review, err := h.productService.CreateReview(ctx, &Review{
+		ProductID:   req.ProductId,
+		ProductType: productType,
+		CustomerID:  req.CustomerId,
+		Rating:      req.Rating,
+		Comment:     req.Comment,
+	}, purchase.OrderId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoReview(review), nil
+}
+
+func (h *ProductGrpcHandler) ListProductReviews(ctx context.Context, req *pb.ListProductReviewsRequest) (*pb.ListReviewsResponse, error) {
+	reviews, summary, err := h.productService.ListProductReviews(ctx, req.ProductId, req.ProductType, req.Page, req.PageSize)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListReviewsResponse{AverageRating: summary.AverageRating, ReviewCount: summary.ReviewCount}
+	for _, r := range reviews {
+		resp.Reviews = append(resp.Reviews, toProtoReview(&r))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	reviews, err := h.productService.ListReviews(ctx, ReviewFilter{
+		Status:      ReviewStatus(req.Status),
+		ProductID:   req.ProductId,
+		ProductType: req.ProductType,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListReviewsResponse{}
+	for _, r := range reviews {
+		resp.Reviews = append(resp.Reviews, toProtoReview(&r))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) ModerateReview(ctx context.Context, req *pb.ModerateReviewRequest) (*pb.Review, error) {
+	review, err := h.productService.ModerateReview(ctx, req.Id, ReviewStatus(req.Status), req.ModeratorId, req.Note)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoReview(review), nil
+}
+
+func (h *ProductGrpcHandler) GetCacheStats(ctx context.Context, req *pb.GetCacheStatsRequest) (*pb.CacheStats, error) {
+	stats := h.catalogCache.Stats()
+	return &pb.CacheStats{Hits: stats.Hits, Misses: stats.Misses, Errors: stats.Errors, HitRatio: stats.HitRatio}, nil
+}
+
+// Lịch sử giá
+func (h *ProductGrpcHandler) SchedulePriceChange(ctx context.Context, req *pb.SchedulePriceChangeRequest) (*pb.PriceChange, error) {
+	effectiveAt, err := parseOptionalTime("effective_at", req.EffectiveAt)
+	if err != nil {
+		return nil, err
+	}
+	if effectiveAt == nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_at is required")
+	}
+	change, err := h.productService.SchedulePriceChange(ctx, &PriceChange{
+		ProductID:   req.ProductId,
+		ProductType: req.ProductType,
+		VariantID:   req.VariantId,
+		Price:       req.Price,
+		EffectiveAt: *effectiveAt,
+		CreatedBy:   req.CreatedBy,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return toProtoPriceChange(change), nil
+}
+
+func (h *ProductGrpcHandler) CancelPriceChange(ctx context.Context, req *pb.CancelPriceChangeRequest) (*pb.CancelPriceChangeResponse, error) {
+	stt, err := h.productService.CancelPriceChange(ctx, req.Id)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	return &pb.CancelPriceChangeResponse{Status: stt}, nil
+}
+
+func (h *ProductGrpcHandler) ListPriceHistory(ctx context.Context, req *pb.ListPriceHistoryRequest) (*pb.ListPriceHistoryResponse, error) {
+	changes, current, err := h.productService.ListPriceHistory(ctx, req.ProductType, req.ProductId, req.VariantId)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	resp := &pb.ListPriceHistoryResponse{CurrentPrice: current}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, toProtoPriceChange(&c))
+	}
+	return resp, nil
+}
+
+func (h *ProductGrpcHandler) GetPriceAt(ctx context.Context, req *pb.GetPriceAtRequest) (*pb.GetPriceAtResponse, error) {
+	at, err := parseOptionalTime("at", req.At)
+	if err != nil {
+		return nil, err
+	}
+	if at == nil {
+		now := time.Now()
+		at = &now
+	}
+	price, err := h.productService.GetPriceAt(ctx, req.ProductType, req.ProductId, req.VariantId, *at)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to release reservation: %v", err)
+		return nil, toGrpcError(err)
 	}
-	return &pb.ReleaseReservationResponse{}, nil
+	return &pb.GetPriceAtResponse{Price: price}, nil
 }
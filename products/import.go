@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Nhập hàng loạt sản phẩm và tồn kho.
+// Gateway đọc file và gửi các dòng đã tách cột; ở đây chỉ kiểm tra nghiệp vụ.
+// Một dòng lỗi thì cả file không được ghi, để người dùng sửa rồi nhập lại từ đầu.
+
+const maxImportRows = 5000
+
+func branchStockKey(branchID int32, productType string, productID, variantID int32) string {
+	return fmt.Sprintf("%d:%s:%d", branchID, stockKey(productType, productID), variantID)
+}
+
+// validateProductImportRows kiểm tra các dòng nhập sản phẩm và chuẩn hoá ProductType.
+// existing chứa stockKey của các sản phẩm đang có trong DB.
+func validateProductImportRows(rows []ProductImportRow, existing map[string]bool) *ImportResult {
+	result := &ImportResult{TotalRows: int32(len(rows))}
+	addErr := func(row int32, field, msg string) {
+		result.Errors = append(result.Errors, ImportRowError{Row: row, Field: field, Message: msg})
+	}
+	seen := make(map[string]int32)
+	for i := range rows {
+		r := &rows[i]
+		productType, err := normalizeProductType(r.ProductType)
+		if err != nil {
+			addErr(r.Row, "product_type", err.Error())
+		}
+		r.ProductType = productType
+		r.Name = strings.TrimSpace(r.Name)
+		if r.Name == "" {
+			addErr(r.Row, "name", "name is required")
+		}
+		if r.Price <= 0 {
+			addErr(r.Row, "price", "price must be positive")
+		}
+		if r.ProductID < 0 {
+			addErr(r.Row, "product_id", "product_id must not be negative")
+			continue
+		}
+		if r.ProductID == 0 {
+			result.Created++
+			continue
+		}
+		if productType == "" {
+			continue
+		}
+		key := stockKey(productType, r.ProductID)
+		if !existing[key] {
+			addErr(r.Row, "product_id", fmt.Sprintf("%s %d not found", productType, r.ProductID))
+			continue
+		}
+		if prev, ok := seen[key]; ok {
+			addErr(r.Row, "product_id", fmt.Sprintf("duplicate of row %d", prev))
+			continue
+		}
+		seen[key] = r.Row
+		result.Updated++
+	}
+	return result
+}
+
+// validateInventoryImportRows kiểm tra các dòng nhập tồn kho và chuẩn hoá ProductType.
+// stocked chứa branchStockKey của các dòng BranchProduct đã có, dùng để đếm tạo mới / cập nhật.
+// Như checkItemVariants: sản phẩm còn biến thể đang bán thì tồn kho ghi theo từng biến thể (variant_id bắt buộc),
+// để dòng variant_id = 0 không cộng thêm vào tồn kho của các biến thể. Biến thể đã ngừng bán vẫn nhập được
+// (file xuất ra có dòng của chúng) vì số lượng đó không được bán.
+func validateInventoryImportRows(rows []InventoryImportRow, branches map[int32]bool, products, stocked map[string]bool,
+	variants map[int32]ProductVariant, hasVariants map[string]int32) *ImportResult {
+	result := &ImportResult{TotalRows: int32(len(rows))}
+	addErr := func(row int32, field, msg string) {
+		result.Errors = append(result.Errors, ImportRowError{Row: row, Field: field, Message: msg})
+	}
+	seen := make(map[string]int32)
+	for i := range rows {
+		r := &rows[i]
+		valid := true
+		if !branches[r.BranchID] {
+			addErr(r.Row, "branch_id", fmt.Sprintf("branch %d not found", r.BranchID))
+			valid = false
+		}
+		productType, err := normalizeProductType(r.ProductType)
+		if err != nil {
+			addErr(r.Row, "product_type", err.Error())
+			valid = false
+		} else if !products[stockKey(productType, r.ProductID)] {
+			addErr(r.Row, "product_id", fmt.Sprintf("%s %d not found", productType, r.ProductID))
+			valid = false
+		} else if r.VariantID < 0 {
+			addErr(r.Row, "variant_id", "variant_id must not be negative")
+			valid = false
+		} else if r.VariantID == 0 {
+			if hasVariants[stockKey(productType, r.ProductID)] > 0 {
+				addErr(r.Row, "variant_id", fmt.Sprintf("%s %d has variants, variant_id is required", productType, r.ProductID))
+				valid = false
+			}
+		} else if v, ok := variants[r.VariantID]; !ok || v.ProductID != r.ProductID || v.ProductType != productType {
+			addErr(r.Row, "variant_id", fmt.Sprintf("variant %d does not belong to %s %d", r.VariantID, productType, r.ProductID))
+			valid = false
+		}
+		r.ProductType = productType
+		if r.StockQuantity < 0 {
+			addErr(r.Row, "stock_quantity", "stock_quantity must not be negative")
+			valid = false
+		}
+		if !valid {
+			continue
+		}
+		key := branchStockKey(r.BranchID, productType, r.ProductID, r.VariantID)
+		if prev, ok := seen[key]; ok {
+			addErr(r.Row, "product_id", fmt.Sprintf("duplicate of row %d", prev))
+			continue
+		}
+		seen[key] = r.Row
+		if stocked[key] {
+			result.Updated++
+		} else {
+			result.Created++
+		}
+	}
+	return result
+}
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"github.com/quanbin27/commons/cache"
 	"github.com/quanbin27/commons/config"
 	"google.golang.org/grpc"
 	"gorm.io/driver/mysql"
@@ -10,7 +13,7 @@ import (
 )
 
 func NewMySQLStorage(dsn string) (*gorm.DB, error) {
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -36,16 +39,22 @@ func main() {
 		log.Fatal(err)
 	}
 	initStorage(db)
-	db.AutoMigrate(Food{}, Medicine{}, Accessory{}, Branch{}, BranchProduct{})
+	if err := migrate(db); err != nil {
+		log.Fatal(err)
+	}
 	grpcServer := grpc.NewServer()
 	l, err := net.Listen("tcp", grpcAddr)
 	if err != nil {
 		log.Fatal("failed to listen")
 	}
 	defer l.Close()
-	productStore := NewStore(db)
+	redisClient := redis.NewClient(&redis.Options{Addr: config.Envs.RedisAddr})
+	catalogCache := cache.New(redisClient, "products:")
+	productStore := newCachedStore(NewStore(db), catalogCache)
 	productService := NewProductService(productStore)
-	NewProductGrpcHandler(grpcServer, productService)
+	NewProductGrpcHandler(grpcServer, productService, catalogCache)
+	go runReservationSweeper(context.Background(), productService, reservationSweepInterval)
+	go runPriceScheduler(context.Background(), productService, priceSweepInterval)
 	log.Println("Product Service Listening on", grpcAddr)
 	grpcServer.Serve(l)
 }
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Migration của Product Service. AutoMigrate chỉ thêm bảng, cột và index còn thiếu: không đổi khoá chính
+// và không biến index thường thành unique, nên các thay đổi đó được làm tay quanh AutoMigrate.
+// Mỗi bước kiểm tra trạng thái hiện tại trước nên chạy lại nhiều lần vẫn an toàn.
+
+func migrate(db *gorm.DB) error {
+	if err := migratePromotionCodes(db); err != nil {
+		return err
+	}
+	if err := migrateProductCodes(db); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(Food{}, Medicine{}, Accessory{}, Branch{}, BranchProduct{}, ProductVariant{}, ProductImage{},
+		Supplier{}, PurchaseOrder{}, PurchaseOrderLine{}, GoodsReceipt{}, GoodsReceiptLine{},
+		BranchOpeningHour{}, BranchClosure{}, Promotion{}, PromotionRedemption{}, Reservation{}, Review{}, PriceChange{}); err != nil {
+		return err
+	}
+	// Tồn kho theo biến thể: bảng tạo trước khi có variant_id vẫn giữ khoá (branch_id, product_id, product_type)
+	return ensurePrimaryKey(db, &BranchProduct{}, "branch_id", "product_id", "product_type", "variant_id")
+}
+
+// ensurePrimaryKey tạo lại khoá chính của bảng thành columns nếu khoá hiện tại khác.
+// Drop và add trong cùng một câu ALTER TABLE nên bảng không lúc nào mất khoá.
+func ensurePrimaryKey(db *gorm.DB, model interface{}, columns ...string) error {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(model); err != nil {
+		return err
+	}
+	table := stmt.Schema.Table
+	var current []string
+	if err := db.Raw(`SELECT COLUMN_NAME FROM information_schema.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY'
+		ORDER BY ORDINAL_POSITION`, table).Scan(&current).Error; err != nil {
+		return err
+	}
+	if strings.Join(current, ",") == strings.Join(columns, ",") {
+		return nil
+	}
+	return db.Exec(fmt.Sprintf("ALTER TABLE `%s` DROP PRIMARY KEY, ADD PRIMARY KEY (`%s`)", table, strings.Join(columns, "`, `"))).Error
+}
+
+// migratePromotionCodes bỏ index thường cũ của promotions.code và đổi mã rỗng (khuyến mãi tự động) thành NULL,
+// để AutoMigrate tạo được unique index chỉ áp dụng cho mã giảm giá. Mã trùng có sẵn phải sửa tay trước.
+func migratePromotionCodes(db *gorm.DB) error {
+	m := db.Migrator()
+	if !m.HasTable(&Promotion{}) {
+		return nil
+	}
+	if m.HasIndex(&Promotion{}, "idx_promotions_code") {
+		if err := m.DropIndex(&Promotion{}, "idx_promotions_code"); err != nil {
+			return err
+		}
+	}
+	return db.Model(&Promotion{}).Where("code = ?", "").Update("code", nil).Error
+}
+
+// migrateProductCodes bỏ index thường cũ của SKU / mã vạch và đổi mã rỗng thành NULL, để AutoMigrate tạo được
+// unique index (xem ItemCode). SKU biến thể vốn đã unique và bắt buộc. Mã trùng có sẵn phải sửa tay trước.
+func migrateProductCodes(db *gorm.DB) error {
+	m := db.Migrator()
+	columns := map[interface{}][]string{
+		&Food{}:           {"sku", "barcode"},
+		&Accessory{}:      {"sku", "barcode"},
+		&Medicine{}:       {"sku", "barcode"},
+		&ProductVariant{}: {"barcode"},
+	}
+	for model, cols := range columns {
+		if !m.HasTable(model) {
+			continue
+		}
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		for _, col := range cols {
+			index := "idx_" + stmt.Schema.Table + "_" + col
+			if m.HasIndex(model, index) {
+				if err := m.DropIndex(model, index); err != nil {
+					return err
+				}
+			}
+			if err := db.Model(model).Where(col+" = ?", "").Update(col, nil).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Lịch sử giá: mọi lần đổi giá (sửa trực tiếp, nhập file, giá hẹn trước) được ghi vào PriceChange kèm giá ngay
+// trước đó, nên giá tại một thời điểm bất kỳ tính được từ giá hiện tại và các thay đổi sau thời điểm đó.
+
+const (
+	// priceSweepInterval - Chu kỳ áp dụng các giá hẹn trước đã đến hạn
+	priceSweepInterval = time.Minute
+	priceSweepBatch    = 100
+)
+
+// recordPriceChange ghi lịch sử khi giá được sửa trực tiếp. Giá đã được lưu nên lỗi ở đây chỉ ghi log.
+func (s *ProductServiceImpl) recordPriceChange(ctx context.Context, productType string, productID, variantID int32, previous, price float32) {
+	if previous == price {
+		return
+	}
+	now := time.Now()
+	change := &PriceChange{
+		ProductID:     productID,
+		ProductType:   productType,
+		VariantID:     variantID,
+		Price:         price,
+		PreviousPrice: previous,
+		EffectiveAt:   now,
+		AppliedAt:     &now,
+	}
+	if err := s.store.CreatePriceChange(ctx, change); err != nil {
+		log.Printf("Failed to record price change of %s %d variant %d: %v", productType, productID, variantID, err)
+	}
+}
+
+// runPriceScheduler áp dụng định kỳ các giá hẹn trước đã đến hạn cho tới khi ctx bị huỷ
+func runPriceScheduler(ctx context.Context, service ProductService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			applied, err := service.ApplyScheduledPrices(ctx)
+			if err != nil {
+				log.Printf("Failed to apply scheduled prices: %v", err)
+			}
+			if applied > 0 {
+				log.Printf("Applied %d scheduled price changes", applied)
+			}
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Tính giá giỏ hàng theo khuyến mãi, dùng chung cho đơn hàng (sản phẩm) và lịch hẹn (dịch vụ).
+// Khuyến mãi tự động (không có mã) được xét trước theo thứ tự ID, sau đó đến các mã khách nhập.
+// Mỗi khuyến mãi chỉ giảm trên phần tiền còn lại của các dòng trong phạm vi, nên tổng giảm không vượt quá giá trị giỏ hàng.
+// Khuyến mãi tự động không đủ điều kiện thì bỏ qua; mã giảm giá không dùng được thì trả lỗi để khách biết lý do.
+
+// serviceItemType - Loại dòng giỏ hàng cho dịch vụ của Appointments service
+const serviceItemType = "service"
+
+func normalizeCartItemType(itemType string) (string, error) {
+	if strings.EqualFold(strings.TrimSpace(itemType), serviceItemType) {
+		return serviceItemType, nil
+	}
+	if t, err := normalizeProductType(itemType); err == nil {
+		return t, nil
+	}
+	return "", fmt.Errorf("%w: item type must be food, accessory, medicine or service", ErrInvalidArgument)
+}
+
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// validatePromotion kiểm tra và chuẩn hoá khuyến mãi trước khi lưu
+func validatePromotion(p *Promotion) error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return fmt.Errorf("%w: promotion name is required", ErrInvalidArgument)
+	}
+	p.Code = CouponCode(normalizeCouponCode(string(p.Code)))
+
+	switch p.Type {
+	case PromotionPercentage:
+		if p.Value <= 0 || p.Value > 100 {
+			return fmt.Errorf("%w: percentage value must be in (0, 100]", ErrInvalidArgument)
+		}
+	case PromotionFixed:
+		if p.Value <= 0 {
+			return fmt.Errorf("%w: fixed discount must be positive", ErrInvalidArgument)
+		}
+	case PromotionBuyXGetY:
+		if p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+			return fmt.Errorf("%w: buy_quantity and get_quantity must be positive", ErrInvalidArgument)
+		}
+	default:
+		return fmt.Errorf("%w: promotion type must be percentage, fixed or buy_x_get_y", ErrInvalidArgument)
+	}
+	if p.MaxDiscount < 0 || p.MinSpend < 0 || p.UsageLimit < 0 || p.PerCustomerLimit < 0 {
+		return fmt.Errorf("%w: max_discount, min_spend and usage limits must not be negative", ErrInvalidArgument)
+	}
+
+	if p.Scope == "" {
+		p.Scope = PromotionScopeAll
+	}
+	switch p.Scope {
+	case PromotionScopeAll:
+		p.Category, p.ItemType, p.ItemID = "", "", 0
+	case PromotionScopeCategory:
+		category, err := normalizeCartItemType(p.Category)
+		if err != nil {
+			return err
+		}
+		p.Category, p.ItemType, p.ItemID = category, "", 0
+	case PromotionScopeProduct:
+		itemType, err := normalizeCartItemType(p.ItemType)
+		if err != nil {
+			return err
+		}
+		if p.ItemID <= 0 {
+			return fmt.Errorf("%w: item_id is required for product scoped promotions", ErrInvalidArgument)
+		}
+		p.Category, p.ItemType = "", itemType
+	default:
+		return fmt.Errorf("%w: promotion scope must be all, category or product", ErrInvalidArgument)
+	}
+
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// validateCartItems kiểm tra và chuẩn hoá ItemType của các dòng giỏ hàng
+func validateCartItems(items []CartItem) error {
+	if len(items) == 0 {
+		return fmt.Errorf("%w: cart is empty", ErrInvalidArgument)
+	}
+	for i := range items {
+		itemType, err := normalizeCartItemType(items[i].ItemType)
+		if err != nil {
+			return err
+		}
+		if items[i].Quantity <= 0 || items[i].UnitPrice < 0 {
+			return fmt.Errorf("%w: item %d must have positive quantity and non-negative unit price", ErrInvalidArgument, i+1)
+		}
+		items[i].ItemType = itemType
+	}
+	return nil
+}
+
+func (p *Promotion) appliesTo(item CartItem) bool {
+	switch p.Scope {
+	case PromotionScopeCategory:
+		return item.ItemType == p.Category
+	case PromotionScopeProduct:
+		return item.ItemType == p.ItemType && item.ItemID == p.ItemID
+	default:
+		return true
+	}
+}
+
+// ineligibleReason trả về lý do khuyến mãi không dùng được cho giỏ hàng, rỗng là dùng được.
+// customerUsage là số lần khách đã dùng khuyến mãi này.
+func (p *Promotion) ineligibleReason(subtotal float32, customerUsage int32, now time.Time) string {
+	switch {
+	case !p.IsActive:
+		return "promotion is no longer active"
+	case p.StartsAt != nil && now.Before(*p.StartsAt):
+		return "promotion has not started yet"
+	case p.EndsAt != nil && !now.Before(*p.EndsAt):
+		return "promotion has expired"
+	case p.UsageLimit > 0 && p.UsedCount >= p.UsageLimit:
+		return "promotion usage limit reached"
+	case p.PerCustomerLimit > 0 && customerUsage >= p.PerCustomerLimit:
+		return "customer usage limit reached"
+	case subtotal < p.MinSpend:
+		return fmt.Sprintf("minimum spend is %.2f", p.MinSpend)
+	default:
+		return ""
+	}
+}
+
+// apply tính số tiền giảm của khuyến mãi trên các dòng trong phạm vi và trừ vào remaining
+// (phần tiền còn lại của từng dòng sau các khuyến mãi trước)
+func (p *Promotion) apply(items []CartItem, remaining []float32) float32 {
+	lineDiscounts := make([]float32, len(items))
+	var base float32
+	for i, item := range items {
+		if p.appliesTo(item) {
+			base += remaining[i]
+		}
+	}
+	if base <= 0 {
+		return 0
+	}
+
+	for i, item := range items {
+		if !p.appliesTo(item) || remaining[i] <= 0 {
+			continue
+		}
+		switch p.Type {
+		case PromotionPercentage:
+			lineDiscounts[i] = remaining[i] * p.Value / 100
+		case PromotionFixed:
+			// Chia số tiền giảm theo tỷ lệ giá trị các dòng
+			lineDiscounts[i] = remaining[i] * min(p.Value, base) / base
+		case PromotionBuyXGetY:
+			// Mỗi nhóm X + Y đơn vị của cùng một dòng được tặng Y đơn vị
+			free := item.Quantity / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+			lineDiscounts[i] = min(float32(free)*item.UnitPrice, remaining[i])
+		}
+	}
+
+	var total float32
+	for _, d := range lineDiscounts {
+		total += d
+	}
+	if p.MaxDiscount > 0 && total > p.MaxDiscount {
+		scale := p.MaxDiscount / total
+		for i := range lineDiscounts {
+			lineDiscounts[i] *= scale
+		}
+		total = p.MaxDiscount
+	}
+	for i := range remaining {
+		remaining[i] = max(remaining[i]-lineDiscounts[i], 0)
+	}
+	return total
+}
+
+// priceCart tính giá giỏ hàng. automatic là các khuyến mãi tự động đang bật, coupons là các khuyến mãi ứng với mã khách nhập,
+// usage là số lần khách đã dùng từng khuyến mãi (theo ID).
+func priceCart(items []CartItem, automatic, coupons []Promotion, usage map[int32]int32, now time.Time) (*CartPricing, error) {
+	pricing := &CartPricing{}
+	remaining := make([]float32, len(items))
+	for i, item := range items {
+		remaining[i] = float32(item.Quantity) * item.UnitPrice
+		pricing.Subtotal += remaining[i]
+	}
+
+	apply := func(p *Promotion, isCoupon bool) error {
+		if reason := p.ineligibleReason(pricing.Subtotal, usage[p.ID], now); reason != "" {
+			if isCoupon {
+				return fmt.Errorf("%w: coupon %s cannot be used: %s", ErrInvalidState, p.Code, reason)
+			}
+			return nil
+		}
+		discount := p.apply(items, remaining)
+		if discount <= 0 {
+			if isCoupon {
+				return fmt.Errorf("%w: coupon %s does not apply to any item in the cart", ErrInvalidState, p.Code)
+			}
+			return nil
+		}
+		pricing.Discount += discount
+		pricing.Applied = append(pricing.Applied, AppliedPromotion{
+			PromotionID: p.ID,
+			Code:        string(p.Code),
+			Name:        p.Name,
+			Discount:    discount,
+		})
+		return nil
+	}
+
+	for i := range automatic {
+		if err := apply(&automatic[i], false); err != nil {
+			return nil, err
+		}
+	}
+	for i := range coupons {
+		if err := apply(&coupons[i], true); err != nil {
+			return nil, err
+		}
+	}
+	pricing.Total = max(pricing.Subtotal-pricing.Discount, 0)
+	return pricing, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Giữ hàng có thời hạn: lần giữ chưa gắn vào đơn hàng (hoặc đơn chưa đến lấy) sẽ tự trả lại kho khi quá hạn,
+// tránh hàng bị khoá vĩnh viễn khi client gọi ReserveProduct rồi không xác nhận hay huỷ.
+
+const (
+	defaultReservationTTL = 15 * time.Minute
+	maxReservationTTL     = 30 * 24 * time.Hour
+	// reservationSweepInterval - Chu kỳ quét các lần giữ đã quá hạn
+	reservationSweepInterval = time.Minute
+	reservationSweepBatch    = 100
+)
+
+// newReservations kiểm tra, chuẩn hoá ProductType của các dòng cần giữ và tạo Reservation tương ứng với cùng hạn giữ
+func newReservations(branchID int32, items []ReserveItem, orderID int32, ttl time.Duration) ([]Reservation, error) {
+	if ttl < 0 || ttl > maxReservationTTL {
+		return nil, fmt.Errorf("%w: reservation ttl must be between 0 and %s", ErrInvalidArgument, maxReservationTTL)
+	}
+	if ttl == 0 {
+		ttl = defaultReservationTTL
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	reservations := make([]Reservation, len(items))
+	for i, item := range items {
+		productType, err := normalizeProductType(item.ProductType)
+		if err != nil {
+			return nil, err
+		}
+		if item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: quantity of item %d must be positive", ErrInvalidArgument, i+1)
+		}
+		items[i].ProductType = productType
+		reservations[i] = Reservation{
+			BranchID:    branchID,
+			ProductID:   item.ProductID,
+			ProductType: productType,
+			VariantID:   item.VariantID,
+			Quantity:    item.Quantity,
+			ExpiresAt:   &expiresAt,
+		}
+		if orderID > 0 {
+			reservations[i].OrderID = &orderID
+		}
+	}
+	return reservations, nil
+}
+
+// runReservationSweeper định kỳ trả lại kho các lần giữ quá hạn cho đến khi ctx bị huỷ
+func runReservationSweeper(ctx context.Context, service ProductService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			released, err := service.ReleaseExpiredReservations(ctx)
+			if err != nil {
+				log.Printf("Failed to release expired reservations: %v", err)
+			}
+			if released > 0 {
+				log.Printf("Released %d expired reservations", released)
+			}
+		}
+	}
+}
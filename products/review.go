@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Đánh giá sản phẩm: khách chỉ đánh giá được sản phẩm đã nằm trong một đơn COMPLETED của mình (kiểm tra qua
+// Orders service), đánh giá mới ở trạng thái pending và chỉ được hiển thị, tính điểm sau khi nhân viên duyệt.
+
+const (
+	minReviewRating     = 1
+	maxReviewRating     = 5
+	maxReviewCommentLen = 2000
+
+	defaultReviewPageSize = 20
+	maxReviewPageSize     = 100
+)
+
+// reviewPage chuẩn hoá trang của danh sách đánh giá: trang đầu và defaultReviewPageSize khi không truyền,
+// kích thước trang tối đa maxReviewPageSize
+func reviewPage(page, pageSize int32) (int32, int32) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultReviewPageSize
+	}
+	if pageSize > maxReviewPageSize {
+		pageSize = maxReviewPageSize
+	}
+	return page, pageSize
+}
+
+// validateReview kiểm tra và chuẩn hoá đánh giá trước khi lưu
+func validateReview(r *Review) error {
+	r.Comment = strings.TrimSpace(r.Comment)
+	if r.CustomerID <= 0 {
+		return fmt.Errorf("%w: customer_id is required", ErrInvalidArgument)
+	}
+	if r.Rating < minReviewRating || r.Rating > maxReviewRating {
+		return fmt.Errorf("%w: rating must be between %d and %d", ErrInvalidArgument, minReviewRating, maxReviewRating)
+	}
+	if len([]rune(r.Comment)) > maxReviewCommentLen {
+		return fmt.Errorf("%w: comment must be at most %d characters", ErrInvalidArgument, maxReviewCommentLen)
+	}
+	return nil
+}
+
+// applyReviewSummaries gán điểm trung bình và số đánh giá đã duyệt cho danh sách sản phẩm
+func applyReviewSummaries(products []GeneralProduct, summaries map[string]ReviewSummary) {
+	for i := range products {
+		summary := summaries[stockKey(products[i].ProductType, products[i].ProductID)]
+		products[i].AverageRating = summary.AverageRating
+		products[i].ReviewCount = summary.ReviewCount
+	}
+}
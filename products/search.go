@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Tìm kiếm sản phẩm cho storefront.
+// Danh mục chỉ vài nghìn sản phẩm nên việc lọc, xếp hạng và đếm facet được làm
+// trong bộ nhớ trên kết quả ListAllProducts thay vì dựa vào collation của MySQL
+// (utf8mb4_unicode_ci không coi "đ" và "d" là một ký tự).
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+const (
+	SortRelevance = "relevance"
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+	SortNameAsc   = "name_asc"
+	SortNameDesc  = "name_desc"
+)
+
+// priceRange - Khoảng giá dùng cho facet, Max = 0 nghĩa là không có cận trên
+type priceRange struct {
+	Label string
+	Min   float32
+	Max   float32
+}
+
+var searchPriceRanges = []priceRange{
+	{Label: "0-100000", Min: 0, Max: 100000},
+	{Label: "100000-500000", Min: 100000, Max: 500000},
+	{Label: "500000-1000000", Min: 500000, Max: 1000000},
+	{Label: "1000000-", Min: 1000000},
+}
+
+var searchProductTypes = []string{"food", "accessory", "medicine"}
+
+// foldVietnamese chuyển chuỗi về chữ thường không dấu để so khớp, ví dụ "Thức ăn Đồ chơi" -> "thuc an do choi"
+func foldVietnamese(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case r == 'đ':
+			b.WriteRune('d')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// keywordScore trả về điểm liên quan của sản phẩm với các từ khoá (đã fold).
+// Mọi từ khoá đều phải xuất hiện trong tên hoặc mô tả; khớp đầu tên > khớp trong tên > khớp mô tả.
+func keywordScore(p *GeneralProduct, terms []string) (int, bool) {
+	if len(terms) == 0 {
+		return 0, true
+	}
+	name := foldVietnamese(p.Name)
+	desc := foldVietnamese(p.Description)
+	score := 0
+	for _, t := range terms {
+		switch {
+		case strings.HasPrefix(name, t):
+			score += 3
+		case strings.Contains(name, t):
+			score += 2
+		case strings.Contains(desc, t):
+			score += 1
+		default:
+			return 0, false
+		}
+	}
+	return score, true
+}
+
+func inPriceRange(price float32, min, max float32) bool {
+	if min > 0 && price < min {
+		return false
+	}
+	if max > 0 && price >= max {
+		return false
+	}
+	return true
+}
+
+func stockKey(productType string, productID int32) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(productType), productID)
+}
+
+type searchCandidate struct {
+	product         GeneralProduct
+	score           int
+	matchType       bool
+	matchAttachable bool
+	matchPrice      bool
+}
+
+// searchProducts lọc, đếm facet, sắp xếp và phân trang danh sách sản phẩm.
+// stock (có thể nil) là số lượng có thể bán tại chi nhánh InStockBranchID, theo khoá stockKey.
+// Facet của mỗi chiều được đếm trên tập đã áp dụng các bộ lọc của những chiều còn lại,
+// để storefront hiển thị được số lượng khi người dùng đổi lựa chọn trong cùng một chiều.
+func searchProducts(products []GeneralProduct, stock map[string]int32, q SearchQuery) *SearchResult {
+	terms := strings.Fields(foldVietnamese(q.Keyword))
+	types := make(map[string]bool, len(q.ProductTypes))
+	for _, t := range q.ProductTypes {
+		types[t] = true
+	}
+
+	var candidates []searchCandidate
+	for _, p := range products {
+		score, ok := keywordScore(&p, terms)
+		if !ok {
+			continue
+		}
+		if q.InStockBranchID > 0 {
+			available := stock[stockKey(p.ProductType, p.ProductID)]
+			if available <= 0 {
+				continue
+			}
+			p.AvailableQuantity = available
+		}
+		candidates = append(candidates, searchCandidate{
+			product:         p,
+			score:           score,
+			matchType:       len(types) == 0 || types[p.ProductType],
+			matchAttachable: q.IsAttachable == nil || p.IsAttachable == *q.IsAttachable,
+			matchPrice:      inPriceRange(p.Price, q.MinPrice, q.MaxPrice),
+		})
+	}
+
+	typeCounts := make(map[string]int32)
+	attachableCounts := make(map[bool]int32)
+	priceCounts := make([]int32, len(searchPriceRanges))
+	var matched []searchCandidate
+	for _, c := range candidates {
+		if c.matchAttachable && c.matchPrice {
+			typeCounts[c.product.ProductType]++
+		}
+		if c.matchType && c.matchPrice {
+			attachableCounts[c.product.IsAttachable]++
+		}
+		if c.matchType && c.matchAttachable {
+			for i, r := range searchPriceRanges {
+				if inPriceRange(c.product.Price, r.Min, r.Max) {
+					priceCounts[i]++
+				}
+			}
+		}
+		if c.matchType && c.matchAttachable && c.matchPrice {
+			matched = append(matched, c)
+		}
+	}
+
+	result := &SearchResult{Total: int64(len(matched))}
+	for _, t := range searchProductTypes {
+		result.Facets.ProductTypes = append(result.Facets.ProductTypes, FacetCount{Value: t, Count: typeCounts[t]})
+	}
+	result.Facets.Attachable = []FacetCount{
+		{Value: "true", Count: attachableCounts[true]},
+		{Value: "false", Count: attachableCounts[false]},
+	}
+	for i, r := range searchPriceRanges {
+		result.Facets.PriceRanges = append(result.Facets.PriceRanges, FacetCount{Value: r.Label, Count: priceCounts[i]})
+	}
+
+	sortBy := q.SortBy
+	if sortBy == "" {
+		sortBy = SortRelevance
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i].product, matched[j].product
+		switch sortBy {
+		case SortPriceAsc:
+			return a.Price < b.Price
+		case SortPriceDesc:
+			return a.Price > b.Price
+		case SortNameAsc:
+			return foldVietnamese(a.Name) < foldVietnamese(b.Name)
+		case SortNameDesc:
+			return foldVietnamese(a.Name) > foldVietnamese(b.Name)
+		default:
+			return matched[i].score > matched[j].score
+		}
+	})
+
+	start := int((q.Page - 1) * q.PageSize)
+	if start >= len(matched) {
+		return result
+	}
+	end := start + int(q.PageSize)
+	if end > len(matched) {
+		end = len(matched)
+	}
+	for _, c := range matched[start:end] {
+		result.Products = append(result.Products, c.product)
+	}
+	return result
+}
@@ -3,8 +3,31 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quanbin27/commons/pricing"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidProductType = errors.New("invalid product type, must be food, accessory or medicine")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrInvalidState       = errors.New("invalid state")
 )
 
+// normalizeProductType đưa loại sản phẩm về dạng lưu trong DB ("food", "accessory", "medicine").
+// Gateway gửi dạng viết hoa (FOOD, ...) nên cần chuẩn hoá trước khi truy vấn.
+func normalizeProductType(productType string) (string, error) {
+	switch t := strings.ToLower(strings.TrimSpace(productType)); t {
+	case "food", "accessory", "medicine":
+		return t, nil
+	default:
+		return "", ErrInvalidProductType
+	}
+}
+
 type ProductServiceImpl struct {
 	store ProductStore
 }
@@ -22,11 +45,18 @@ func (s *ProductServiceImpl) ListFoods(ctx context.Context) ([]Food, error) {
 	return s.store.ListFoods(ctx)
 }
 
-func (s *ProductServiceImpl) CreateFood(ctx context.Context, name, description string, price float32) (string, error) {
+func (s *ProductServiceImpl) CreateFood(ctx context.Context, name, description string, price float32, imgURL, sku, barcode string) (string, error) {
+	sku, barcode = normalizeSKU(sku), normalizeBarcode(barcode)
+	if err := s.checkProductCodes(ctx, ProductCode{}, sku, barcode); err != nil {
+		return "Failed", err
+	}
 	food := &Food{
 		Name:        name,
 		Description: description,
 		Price:       price,
+		ImgUrl:      imgURL,
+		SKU:         ItemCode(sku),
+		Barcode:     ItemCode(barcode),
 	}
 	if err := s.store.CreateFood(ctx, food); err != nil {
 		return "Failed", err
@@ -34,17 +64,28 @@ func (s *ProductServiceImpl) CreateFood(ctx context.Context, name, description s
 	return "Success", nil
 }
 
-func (s *ProductServiceImpl) UpdateFood(ctx context.Context, id int32, name, description string, price float32) (string, error) {
+func (s *ProductServiceImpl) UpdateFood(ctx context.Context, id int32, name, description string, price float32, imgURL, sku, barcode string) (string, error) {
 	food, err := s.store.GetFoodByID(ctx, id)
 	if err != nil {
 		return "Failed", errors.New("food not found")
 	}
+	sku, barcode = normalizeSKU(sku), normalizeBarcode(barcode)
+	if err := s.checkProductCodes(ctx, ProductCode{ProductType: "food", ProductID: id}, sku, barcode); err != nil {
+		return "Failed", err
+	}
+	food.SKU = ItemCode(sku)
+	food.Barcode = ItemCode(barcode)
+	previousPrice := food.Price
 	food.Name = name
 	food.Description = description
 	food.Price = price
+	if imgURL != "" {
+		food.ImgUrl = imgURL
+	}
 	if err := s.store.UpdateFood(ctx, food); err != nil {
 		return "Failed", err
 	}
+	s.recordPriceChange(ctx, "food", id, 0, previousPrice, price)
 	return "Success", nil
 }
 
@@ -64,11 +105,18 @@ func (s *ProductServiceImpl) ListAccessories(ctx context.Context) ([]Accessory,
 	return s.store.ListAccessories(ctx)
 }
 
-func (s *ProductServiceImpl) CreateAccessory(ctx context.Context, name, description string, price float32) (string, error) {
+func (s *ProductServiceImpl) CreateAccessory(ctx context.Context, name, description string, price float32, imgURL, sku, barcode string) (string, error) {
+	sku, barcode = normalizeSKU(sku), normalizeBarcode(barcode)
+	if err := s.checkProductCodes(ctx, ProductCode{}, sku, barcode); err != nil {
+		return "Failed", err
+	}
 	accessory := &Accessory{
 		Name:        name,
 		Description: description,
 		Price:       price,
+		ImgUrl:      imgURL,
+		SKU:         ItemCode(sku),
+		Barcode:     ItemCode(barcode),
 	}
 	if err := s.store.CreateAccessory(ctx, accessory); err != nil {
 		return "Failed", err
@@ -76,17 +124,28 @@ func (s *ProductServiceImpl) CreateAccessory(ctx context.Context, name, descript
 	return "Success", nil
 }
 
-func (s *ProductServiceImpl) UpdateAccessory(ctx context.Context, id int32, name, description string, price float32) (string, error) {
+func (s *ProductServiceImpl) UpdateAccessory(ctx context.Context, id int32, name, description string, price float32, imgURL, sku, barcode string) (string, error) {
 	accessory, err := s.store.GetAccessoryByID(ctx, id)
 	if err != nil {
 		return "Failed", errors.New("accessory not found")
 	}
+	sku, barcode = normalizeSKU(sku), normalizeBarcode(barcode)
+	if err := s.checkProductCodes(ctx, ProductCode{ProductType: "accessory", ProductID: id}, sku, barcode); err != nil {
+		return "Failed", err
+	}
+	accessory.SKU = ItemCode(sku)
+	accessory.Barcode = ItemCode(barcode)
+	previousPrice := accessory.Price
 	accessory.Name = name
 	accessory.Description = description
 	accessory.Price = price
+	if imgURL != "" {
+		accessory.ImgUrl = imgURL
+	}
 	if err := s.store.UpdateAccessory(ctx, accessory); err != nil {
 		return "Failed", err
 	}
+	s.recordPriceChange(ctx, "accessory", id, 0, previousPrice, price)
 	return "Success", nil
 }
 
@@ -106,11 +165,18 @@ func (s *ProductServiceImpl) ListMedicines(ctx context.Context) ([]Medicine, err
 	return s.store.ListMedicines(ctx)
 }
 
-func (s *ProductServiceImpl) CreateMedicine(ctx context.Context, name, description string, price float32) (string, error) {
+func (s *ProductServiceImpl) CreateMedicine(ctx context.Context, name, description string, price float32, imgURL, sku, barcode string) (string, error) {
+	sku, barcode = normalizeSKU(sku), normalizeBarcode(barcode)
+	if err := s.checkProductCodes(ctx, ProductCode{}, sku, barcode); err != nil {
+		return "Failed", err
+	}
 	medicine := &Medicine{
 		Name:        name,
 		Description: description,
 		Price:       price,
+		ImgUrl:      imgURL,
+		SKU:         ItemCode(sku),
+		Barcode:     ItemCode(barcode),
 	}
 	if err := s.store.CreateMedicine(ctx, medicine); err != nil {
 		return "Failed", err
@@ -118,17 +184,28 @@ func (s *ProductServiceImpl) CreateMedicine(ctx context.Context, name, descripti
 	return "Success", nil
 }
 
-func (s *ProductServiceImpl) UpdateMedicine(ctx context.Context, id int32, name, description string, price float32) (string, error) {
+func (s *ProductServiceImpl) UpdateMedicine(ctx context.Context, id int32, name, description string, price float32, imgURL, sku, barcode string) (string, error) {
 	medicine, err := s.store.GetMedicineByID(ctx, id)
 	if err != nil {
 		return "Failed", errors.New("medicine not found")
 	}
+	sku, barcode = normalizeSKU(sku), normalizeBarcode(barcode)
+	if err := s.checkProductCodes(ctx, ProductCode{ProductType: "medicine", ProductID: id}, sku, barcode); err != nil {
+		return "Failed", err
+	}
+	medicine.SKU = ItemCode(sku)
+	medicine.Barcode = ItemCode(barcode)
+	previousPrice := medicine.Price
 	medicine.Name = name
 	medicine.Description = description
 	medicine.Price = price
+	if imgURL != "" {
+		medicine.ImgUrl = imgURL
+	}
 	if err := s.store.UpdateMedicine(ctx, medicine); err != nil {
 		return "Failed", err
 	}
+	s.recordPriceChange(ctx, "medicine", id, 0, previousPrice, price)
 	return "Success", nil
 }
 
@@ -144,23 +221,230 @@ func (s *ProductServiceImpl) GetBranchByID(ctx context.Context, id int32) (*Bran
 	return s.store.GetBranchByID(ctx, id)
 }
 
-func (s *ProductServiceImpl) ListBranches(ctx context.Context) ([]Branch, error) {
-	return s.store.ListBranches(ctx)
+func (s *ProductServiceImpl) ListBranches(ctx context.Context, includeInactive bool) ([]Branch, error) {
+	return s.store.ListBranches(ctx, includeInactive)
 }
 func (s *ProductServiceImpl) ListAttachableProducts(ctx context.Context) ([]GeneralProduct, error) {
-	return s.store.ListAttachableProducts(ctx)
+	products, err := s.store.ListAttachableProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachRatings(ctx, products); err != nil {
+		return nil, err
+	}
+	return products, nil
 }
 func (s *ProductServiceImpl) ListAllProducts(ctx context.Context) ([]GeneralProduct, error) {
-	return s.store.ListAllProducts(ctx)
+	products, err := s.store.ListAllProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachRatings(ctx, products); err != nil {
+		return nil, err
+	}
+	return products, nil
 }
 func (s *ProductServiceImpl) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStock, error) {
 	return s.store.ListAllProductsWithStock(ctx)
 }
+func (s *ProductServiceImpl) SearchProducts(ctx context.Context, query SearchQuery) (*SearchResult, error) {
+	for i, t := range query.ProductTypes {
+		productType, err := normalizeProductType(t)
+		if err != nil {
+			return nil, err
+		}
+		query.ProductTypes[i] = productType
+	}
+	switch query.SortBy {
+	case "", SortRelevance, SortPriceAsc, SortPriceDesc, SortNameAsc, SortNameDesc:
+	default:
+		return nil, fmt.Errorf("%w: unknown sort_by %q", ErrInvalidArgument, query.SortBy)
+	}
+	if query.MinPrice < 0 || query.MaxPrice < 0 || (query.MaxPrice > 0 && query.MinPrice > query.MaxPrice) {
+		return nil, fmt.Errorf("%w: invalid price range", ErrInvalidArgument)
+	}
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = defaultSearchPageSize
+	}
+	if query.PageSize > maxSearchPageSize {
+		query.PageSize = maxSearchPageSize
+	}
+
+	products, err := s.store.ListAllProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stock map[string]int32
+	if query.InStockBranchID > 0 {
+		inventory, err := s.store.GetBranchInventory(ctx, query.InStockBranchID)
+		if err != nil {
+			return nil, err
+		}
+		stock = make(map[string]int32, len(inventory))
+		for _, bp := range inventory {
+			stock[stockKey(bp.ProductType, bp.ProductID)] += bp.Available()
+		}
+	}
+	result := searchProducts(products, stock, query)
+	if err := s.attachRatings(ctx, result.Products); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Ảnh sản phẩm
+func (s *ProductServiceImpl) AddProductImages(ctx context.Context, images []ProductImage) ([]ProductImage, error) {
+	if len(images) == 0 {
+		return nil, fmt.Errorf("%w: at least one image is required", ErrInvalidArgument)
+	}
+	for i := range images {
+		productType, err := normalizeProductType(images[i].ProductType)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(images[i].URL) == "" {
+			return nil, fmt.Errorf("%w: image url is required", ErrInvalidArgument)
+		}
+		images[i].ProductType = productType
+		if images[i].ProductID != images[0].ProductID || productType != images[0].ProductType {
+			return nil, fmt.Errorf("%w: all images must belong to the same product", ErrInvalidArgument)
+		}
+	}
+	if err := s.productExists(ctx, images[0].ProductID, images[0].ProductType); err != nil {
+		return nil, err
+	}
+	if err := s.store.AddProductImages(ctx, images); err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (s *ProductServiceImpl) ListProductImages(ctx context.Context, productID int32, productType string) ([]ProductImage, error) {
+	productType, err := normalizeProductType(productType)
+	if err != nil {
+		return nil, err
+	}
+	return s.store.ListProductImages(ctx, productID, productType)
+}
+
+func (s *ProductServiceImpl) DeleteProductImage(ctx context.Context, id int32) (*ProductImage, error) {
+	return s.store.DeleteProductImage(ctx, id)
+}
+
+// productExists kiểm tra sản phẩm tồn tại theo loại, trả về gorm.ErrRecordNotFound nếu không có
+func (s *ProductServiceImpl) productExists(ctx context.Context, productID int32, productType string) error {
+	var err error
+	switch productType {
+	case "food":
+		_, err = s.store.GetFoodByID(ctx, productID)
+	case "accessory":
+		_, err = s.store.GetAccessoryByID(ctx, productID)
+	case "medicine":
+		_, err = s.store.GetMedicineByID(ctx, productID)
+	default:
+		err = ErrInvalidProductType
+	}
+	return err
+}
+
 func (s *ProductServiceImpl) ListAvailableAllProductsByBranch(ctx context.Context, branchID int32) ([]GeneralProduct, error) {
-	return s.store.ListAvailableAllProductsByBranch(ctx, branchID)
+	products, err := s.store.ListAvailableAllProductsByBranch(ctx, branchID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachRatings(ctx, products); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// GetProductByBarcode tra cứu mặt hàng theo mã vạch hoặc SKU cho nhân viên bán tại quầy của chi nhánh branchID
+func (s *ProductServiceImpl) GetProductByBarcode(ctx context.Context, code string, branchID int32) (*ProductLookup, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, fmt.Errorf("%w: code is required", ErrInvalidArgument)
+	}
+	matches, err := s.store.FindByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		// SKU lưu dạng chữ hoa, thử lại khi người dùng gõ chữ thường
+		if matches, err = s.store.FindByCode(ctx, normalizeSKU(code)); err != nil {
+			return nil, err
+		}
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("%w: code %s matches %d items", ErrInvalidState, code, len(matches))
+	}
+	match := matches[0]
+
+	product, err := s.store.GetGeneralProduct(ctx, match.ProductType, match.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product.IsArchived {
+		return nil, fmt.Errorf("%w: product %s is archived", ErrInvalidState, code)
+	}
+	lookup := &ProductLookup{Price: product.Price}
+	var variantID *int32
+	if match.VariantID != 0 {
+		variant, err := s.store.GetVariantByID(ctx, match.VariantID)
+		if err != nil {
+			return nil, err
+		}
+		lookup.Variant = variant
+		lookup.Price = variant.Price
+		lookup.SKU, lookup.Barcode = variant.SKU, string(variant.Barcode)
+		variantID = &variant.ID
+	} else {
+		lookup.SKU, lookup.Barcode = product.SKU, product.Barcode
+	}
+	if product.AvailableQuantity, err = s.store.GetAvailableQuantity(ctx, branchID, match.ProductType, match.ProductID, variantID); err != nil {
+		return nil, err
+	}
+	lookup.Product = *product
+	return lookup, nil
+}
+
+// RestoreProduct bỏ lưu trữ sản phẩm đã bị xoá (DeleteFood/DeleteAccessory/DeleteMedicine)
+func (s *ProductServiceImpl) RestoreProduct(ctx context.Context, productType string, productID int32) (string, error) {
+	productType, err := normalizeProductType(productType)
+	if err != nil {
+		return "Failed", err
+	}
+	if err := s.store.RestoreProduct(ctx, productType, productID); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) ListArchivedProducts(ctx context.Context) ([]GeneralProduct, error) {
+	return s.store.ListArchivedProducts(ctx)
 }
+
+// ListAvailableProductsByBranch - productType rỗng = mọi loại sản phẩm
 func (s *ProductServiceImpl) ListAvailableProductsByBranch(ctx context.Context, branchID int32, productType string) ([]GeneralProduct, error) {
-	return s.store.ListAvailableProductsByBranch(ctx, branchID, productType)
+	if productType != "" {
+		var err error
+		if productType, err = normalizeProductType(productType); err != nil {
+			return nil, err
+		}
+	}
+	products, err := s.store.ListAvailableProductsByBranch(ctx, branchID, productType)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachRatings(ctx, products); err != nil {
+		return nil, err
+	}
+	return products, nil
 }
 
 // Tồn kho
@@ -168,20 +452,937 @@ func (s *ProductServiceImpl) GetBranchInventory(ctx context.Context, branchID in
 	return s.store.GetBranchInventory(ctx, branchID)
 }
 
-func (s *ProductServiceImpl) UpdateBranchInventory(ctx context.Context, branchID, productID int32, productType string, stockQuantity int32) (string, error) {
-	if err := s.store.UpdateBranchInventory(ctx, branchID, productID, productType, stockQuantity); err != nil {
+func (s *ProductServiceImpl) UpdateBranchInventory(ctx context.Context, branchID, productID int32, productType string, variantID, stockQuantity int32) (string, error) {
+	productType, err := normalizeProductType(productType)
+	if err != nil {
+		return "Failed", err
+	}
+	if err := s.checkVariants(ctx, []ReserveItem{{ProductID: productID, ProductType: productType, VariantID: variantID}}); err != nil {
+		return "Failed", err
+	}
+	if err := s.store.UpdateBranchInventory(ctx, branchID, productID, productType, variantID, stockQuantity); err != nil {
 		return "Failed", err
 	}
 	return "Success", nil
 }
-func (s *ProductServiceImpl) ReserveProduct(ctx context.Context, branchID, productID int32, productType string, quantity int32) error {
-	return s.store.ReserveProduct(ctx, branchID, productID, productType, quantity)
+
+// Biến thể sản phẩm
+// checkProductCodes kiểm tra SKU / mã vạch chưa thuộc sản phẩm hoặc biến thể nào khác owner,
+// để tra cứu tại quầy luôn ra đúng một mặt hàng. owner rỗng khi tạo mới.
+func (s *ProductServiceImpl) checkProductCodes(ctx context.Context, owner ProductCode, codes ...string) error {
+	for _, code := range codes {
+		if code == "" {
+			continue
+		}
+		matches, err := s.store.FindByCode(ctx, code)
+		if err != nil {
+			return err
+		}
+		for _, m := range matches {
+			if m == owner {
+				continue
+			}
+			if m.VariantID != 0 {
+				return fmt.Errorf("%w: code %s is already used by variant %d", ErrInvalidArgument, code, m.VariantID)
+			}
+			return fmt.Errorf("%w: code %s is already used by %s %d", ErrInvalidArgument, code, m.ProductType, m.ProductID)
+		}
+	}
+	return nil
 }
 
-func (s *ProductServiceImpl) ConfirmPickup(ctx context.Context, branchID, productID int32, productType string, quantity int32) error {
-	return s.store.ConfirmPickup(ctx, branchID, productID, productType, quantity)
+func (s *ProductServiceImpl) CreateVariant(ctx context.Context, variant *ProductVariant) (int32, string, error) {
+	productType, err := normalizeProductType(variant.ProductType)
+	if err != nil {
+		return 0, "Failed", err
+	}
+	variant.ProductType = productType
+	if err := validateVariant(variant); err != nil {
+		return 0, "Failed", err
+	}
+	if err := s.productExists(ctx, variant.ProductID, productType); err != nil {
+		return 0, "Failed", err
+	}
+	if err := s.checkProductCodes(ctx, ProductCode{}, variant.SKU, string(variant.Barcode)); err != nil {
+		return 0, "Failed", err
+	}
+	variant.ID = 0
+	variant.IsActive = true
+	if err := s.store.CreateVariant(ctx, variant); err != nil {
+		return 0, "Failed", err
+	}
+	return variant.ID, "Success", nil
 }
 
-func (s *ProductServiceImpl) ReleaseReservation(ctx context.Context, branchID, productID int32, productType string, quantity int32) error {
-	return s.store.ReleaseReservation(ctx, branchID, productID, productType, quantity)
+func (s *ProductServiceImpl) UpdateVariant(ctx context.Context, variant *ProductVariant) (string, error) {
+	if err := validateVariant(variant); err != nil {
+		return "Failed", err
+	}
+	existing, err := s.store.GetVariantByID(ctx, variant.ID)
+	if err != nil {
+		return "Failed", err
+	}
+	owner := ProductCode{ProductType: existing.ProductType, ProductID: existing.ProductID, VariantID: existing.ID}
+	if err := s.checkProductCodes(ctx, owner, variant.SKU, string(variant.Barcode)); err != nil {
+		return "Failed", err
+	}
+	if err := s.store.UpdateVariant(ctx, variant); err != nil {
+		return "Failed", err
+	}
+	s.recordPriceChange(ctx, existing.ProductType, existing.ProductID, existing.ID, existing.Price, variant.Price)
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) GetVariant(ctx context.Context, id int32) (*ProductVariant, error) {
+	return s.store.GetVariantByID(ctx, id)
+}
+
+func (s *ProductServiceImpl) ListVariants(ctx context.Context, productID int32, productType string, includeInactive bool) ([]ProductVariant, error) {
+	productType, err := normalizeProductType(productType)
+	if err != nil {
+		return nil, err
+	}
+	return s.store.ListVariants(ctx, productID, productType, includeInactive)
+}
+
+// DeactivateVariant ngừng bán biến thể; hàng đang giữ của biến thể vẫn được xác nhận hoặc trả lại bình thường
+func (s *ProductServiceImpl) DeactivateVariant(ctx context.Context, id int32) (string, error) {
+	if err := s.store.DeactivateVariant(ctx, id); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+// checkVariants tải các biến thể được tham chiếu và kiểm tra bằng checkItemVariants. ProductType phải đã chuẩn hoá.
+func (s *ProductServiceImpl) checkVariants(ctx context.Context, items []ReserveItem) error {
+	var variantIDs []int32
+	idsByType := make(map[string][]int32)
+	for _, item := range items {
+		if item.VariantID != 0 {
+			variantIDs = append(variantIDs, item.VariantID)
+		} else {
+			idsByType[item.ProductType] = append(idsByType[item.ProductType], item.ProductID)
+		}
+	}
+
+	variants := make(map[int32]ProductVariant, len(variantIDs))
+	if len(variantIDs) > 0 {
+		list, err := s.store.ListVariantsByIDs(ctx, variantIDs)
+		if err != nil {
+			return err
+		}
+		for _, v := range list {
+			variants[v.ID] = v
+		}
+	}
+	hasVariants := make(map[string]int32)
+	for productType, ids := range idsByType {
+		counts, err := s.store.CountActiveVariants(ctx, productType, ids)
+		if err != nil {
+			return err
+		}
+		for key, n := range counts {
+			hasVariants[key] = n
+		}
+	}
+	return checkItemVariants(items, variants, hasVariants)
+}
+
+// checkNotArchived không cho giữ hàng (đặt mua) sản phẩm đã lưu trữ. ProductType phải đã chuẩn hoá.
+func (s *ProductServiceImpl) checkNotArchived(ctx context.Context, items []ReserveItem) error {
+	checked := make(map[string]bool, len(items))
+	for _, item := range items {
+		key := stockKey(item.ProductType, item.ProductID)
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+		product, err := s.store.GetGeneralProduct(ctx, item.ProductType, item.ProductID)
+		if err != nil {
+			return err
+		}
+		if product.IsArchived {
+			return fmt.Errorf("%w: %s %d is archived", ErrInvalidState, item.ProductType, item.ProductID)
+		}
+	}
+	return nil
+}
+
+// Giữ hàng
+func (s *ProductServiceImpl) ReserveProduct(ctx context.Context, branchID, productID int32, productType string, variantID, quantity, orderID int32, ttl time.Duration) (*Reservation, error) {
+	items := []ReserveItem{{ProductID: productID, ProductType: productType, VariantID: variantID, Quantity: quantity}}
+	reservations, err := newReservations(branchID, items, orderID, ttl)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNotArchived(ctx, items); err != nil {
+		return nil, err
+	}
+	if err := s.checkVariants(ctx, items); err != nil {
+		return nil, err
+	}
+	if err := s.store.ReserveProduct(ctx, &reservations[0]); err != nil {
+		return nil, err
+	}
+	return &reservations[0], nil
+}
+
+// ReserveProducts giữ cả giỏ hàng. Thiếu hàng thì không giữ gì, trả về shortfalls và error nil để client hiển thị từng dòng thiếu.
+func (s *ProductServiceImpl) ReserveProducts(ctx context.Context, branchID int32, items []ReserveItem, orderID int32, ttl time.Duration) ([]Reservation, []ReservationShortfall, error) {
+	if len(items) == 0 {
+		return nil, nil, fmt.Errorf("%w: items are required", ErrInvalidArgument)
+	}
+	reservations, err := newReservations(branchID, items, orderID, ttl)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkNotArchived(ctx, items); err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkVariants(ctx, items); err != nil {
+		return nil, nil, err
+	}
+	shortfalls, err := s.store.ReserveProducts(ctx, reservations)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(shortfalls) > 0 {
+		return nil, shortfalls, nil
+	}
+	return reservations, nil, nil
+}
+
+func (s *ProductServiceImpl) GetReservation(ctx context.Context, id int32) (*Reservation, error) {
+	return s.store.GetReservation(ctx, id)
+}
+
+func (s *ProductServiceImpl) ConfirmPickup(ctx context.Context, reservationID int32) (*Reservation, error) {
+	return s.store.ConfirmReservation(ctx, reservationID)
+}
+
+func (s *ProductServiceImpl) ReleaseReservation(ctx context.Context, reservationID int32) (*Reservation, error) {
+	return s.store.ReleaseReservation(ctx, reservationID)
+}
+
+// AssignReservations gắn các lần giữ vào đơn hàng và đặt lại hạn giữ (nil = giữ đến khi xác nhận hoặc huỷ đơn)
+func (s *ProductServiceImpl) AssignReservations(ctx context.Context, reservationIDs []int32, orderID int32, expiresAt *time.Time) (string, error) {
+	if len(reservationIDs) == 0 || orderID <= 0 {
+		return "Failed", fmt.Errorf("%w: reservation_ids and order_id are required", ErrInvalidArgument)
+	}
+	if err := s.store.AssignReservations(ctx, reservationIDs, orderID, expiresAt); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+// ReleaseExpiredReservations trả lại kho các lần giữ quá hạn, xử lý theo từng lô để không khoá bảng quá lâu
+func (s *ProductServiceImpl) ReleaseExpiredReservations(ctx context.Context) (int, error) {
+	now := time.Now()
+	total := 0
+	for {
+		released, err := s.store.ExpireReservations(ctx, now, reservationSweepBatch)
+		total += released
+		if err != nil || released < reservationSweepBatch {
+			return total, err
+		}
+	}
+}
+
+// Nhà cung cấp
+func (s *ProductServiceImpl) CreateSupplier(ctx context.Context, supplier *Supplier) (int32, string, error) {
+	if strings.TrimSpace(supplier.Name) == "" {
+		return 0, "Failed", fmt.Errorf("%w: supplier name is required", ErrInvalidArgument)
+	}
+	if err := s.store.CreateSupplier(ctx, supplier); err != nil {
+		return 0, "Failed", err
+	}
+	return supplier.ID, "Success", nil
+}
+
+func (s *ProductServiceImpl) UpdateSupplier(ctx context.Context, supplier *Supplier) (string, error) {
+	existing, err := s.store.GetSupplierByID(ctx, supplier.ID)
+	if err != nil {
+		return "Failed", fmt.Errorf("supplier %d: %w", supplier.ID, err)
+	}
+	if strings.TrimSpace(supplier.Name) == "" {
+		return "Failed", fmt.Errorf("%w: supplier name is required", ErrInvalidArgument)
+	}
+	existing.Name = supplier.Name
+	existing.ContactName = supplier.ContactName
+	existing.Phone = supplier.Phone
+	existing.Email = supplier.Email
+	existing.Address = supplier.Address
+	if err := s.store.UpdateSupplier(ctx, existing); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) ListSuppliers(ctx context.Context) ([]Supplier, error) {
+	return s.store.ListSuppliers(ctx)
+}
+
+// Đơn nhập hàng
+func (s *ProductServiceImpl) CreatePurchaseOrder(ctx context.Context, supplierID, branchID int32, expectedDate *time.Time, note string, lines []PurchaseOrderLine) (int32, string, error) {
+	if len(lines) == 0 {
+		return 0, "Failed", fmt.Errorf("%w: purchase order must have at least one line", ErrInvalidArgument)
+	}
+	if _, err := s.store.GetSupplierByID(ctx, supplierID); err != nil {
+		return 0, "Failed", fmt.Errorf("%w: supplier %d not found", ErrInvalidArgument, supplierID)
+	}
+	if _, err := s.store.GetBranchByID(ctx, branchID); err != nil {
+		return 0, "Failed", fmt.Errorf("%w: branch %d not found", ErrInvalidArgument, branchID)
+	}
+
+	var total float32
+	for i := range lines {
+		productType, err := normalizeProductType(lines[i].ProductType)
+		if err != nil {
+			return 0, "Failed", err
+		}
+		if lines[i].QuantityOrdered <= 0 || lines[i].UnitCost < 0 {
+			return 0, "Failed", fmt.Errorf("%w: line %d must have positive quantity and non-negative unit cost", ErrInvalidArgument, i+1)
+		}
+		if err := s.productExists(ctx, lines[i].ProductID, productType); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, "Failed", fmt.Errorf("%w: line %d: %s %d not found", ErrInvalidArgument, i+1, productType, lines[i].ProductID)
+			}
+			return 0, "Failed", err
+		}
+		lines[i].ProductType = productType
+		lines[i].QuantityReceived = 0
+		total += float32(lines[i].QuantityOrdered) * lines[i].UnitCost
+	}
+	items := make([]ReserveItem, len(lines))
+	for i, l := range lines {
+		items[i] = ReserveItem{ProductID: l.ProductID, ProductType: l.ProductType, VariantID: l.VariantID}
+	}
+	if err := s.checkVariants(ctx, items); err != nil {
+		return 0, "Failed", err
+	}
+
+	order := &PurchaseOrder{
+		SupplierID:   supplierID,
+		BranchID:     branchID,
+		Status:       PurchaseOrderOrdered,
+		ExpectedDate: expectedDate,
+		Note:         note,
+		TotalCost:    total,
+		Lines:        lines,
+	}
+	if err := s.store.CreatePurchaseOrder(ctx, order); err != nil {
+		return 0, "Failed", err
+	}
+	return order.ID, "Success", nil
+}
+
+func (s *ProductServiceImpl) GetPurchaseOrder(ctx context.Context, id int32) (*PurchaseOrder, error) {
+	return s.store.GetPurchaseOrderByID(ctx, id)
+}
+
+func (s *ProductServiceImpl) ListPurchaseOrders(ctx context.Context, filter PurchaseOrderFilter) ([]PurchaseOrder, error) {
+	return s.store.ListPurchaseOrders(ctx, filter)
+}
+
+func (s *ProductServiceImpl) CancelPurchaseOrder(ctx context.Context, id int32) (string, error) {
+	if err := s.store.CancelPurchaseOrder(ctx, id); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) ReceivePurchaseOrder(ctx context.Context, purchaseOrderID int32, lines []GoodsReceiptLine, note string) (int32, PurchaseOrderStatus, error) {
+	if len(lines) == 0 {
+		return 0, "", fmt.Errorf("%w: nothing to receive", ErrInvalidArgument)
+	}
+	seen := make(map[int32]bool, len(lines))
+	for _, l := range lines {
+		if l.Quantity <= 0 {
+			return 0, "", fmt.Errorf("%w: received quantity must be positive", ErrInvalidArgument)
+		}
+		if seen[l.PurchaseOrderLineID] {
+			return 0, "", fmt.Errorf("%w: line %d listed more than once", ErrInvalidArgument, l.PurchaseOrderLineID)
+		}
+		seen[l.PurchaseOrderLineID] = true
+	}
+	receipt, status, err := s.store.ReceivePurchaseOrder(ctx, purchaseOrderID, lines, note)
+	if err != nil {
+		return 0, "", err
+	}
+	return receipt.ID, status, nil
+}
+
+// Nhập hàng loạt
+func (s *ProductServiceImpl) existingProductKeys(ctx context.Context) (map[string]bool, error) {
+	products, err := s.store.ListAllProducts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string]bool, len(products))
+	for _, p := range products {
+		keys[stockKey(p.ProductType, p.ProductID)] = true
+	}
+	return keys, nil
+}
+
+func (s *ProductServiceImpl) ImportProducts(ctx context.Context, rows []ProductImportRow, dryRun bool) (*ImportResult, error) {
+	if len(rows) == 0 || len(rows) > maxImportRows {
+		return nil, fmt.Errorf("%w: import must contain between 1 and %d rows", ErrInvalidArgument, maxImportRows)
+	}
+	existing, err := s.existingProductKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := validateProductImportRows(rows, existing)
+	if dryRun || len(result.Errors) > 0 {
+		return result, nil
+	}
+	if err := s.store.ImportProducts(ctx, rows); err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	return result, nil
+}
+
+func (s *ProductServiceImpl) ImportInventory(ctx context.Context, rows []InventoryImportRow, dryRun bool) (*ImportResult, error) {
+	if len(rows) == 0 || len(rows) > maxImportRows {
+		return nil, fmt.Errorf("%w: import must contain between 1 and %d rows", ErrInvalidArgument, maxImportRows)
+	}
+	products, err := s.existingProductKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	branchList, err := s.store.ListBranches(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	branches := make(map[int32]bool, len(branchList))
+	for _, b := range branchList {
+		branches[b.ID] = true
+	}
+	stocked := make(map[string]bool)
+	loaded := make(map[int32]bool)
+	for _, r := range rows {
+		if !branches[r.BranchID] || loaded[r.BranchID] {
+			continue
+		}
+		loaded[r.BranchID] = true
+		inventory, err := s.store.GetBranchInventory(ctx, r.BranchID)
+		if err != nil {
+			return nil, err
+		}
+		for _, bp := range inventory {
+			stocked[branchStockKey(bp.BranchID, bp.ProductType, bp.ProductID, bp.VariantID)] = true
+		}
+	}
+
+	// Biến thể được nêu trong file và số biến thể đang bán của các sản phẩm có dòng variant_id = 0
+	var variantIDs []int32
+	idsByType := make(map[string][]int32)
+	for _, r := range rows {
+		productType, err := normalizeProductType(r.ProductType)
+		if err != nil {
+			continue // validateInventoryImportRows báo lỗi
+		}
+		if r.VariantID > 0 {
+			variantIDs = append(variantIDs, r.VariantID)
+		} else if r.VariantID == 0 {
+			idsByType[productType] = append(idsByType[productType], r.ProductID)
+		}
+	}
+	variants := make(map[int32]ProductVariant, len(variantIDs))
+	if len(variantIDs) > 0 {
+		list, err := s.store.ListVariantsByIDs(ctx, variantIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range list {
+			variants[v.ID] = v
+		}
+	}
+	hasVariants := make(map[string]int32)
+	for productType, ids := range idsByType {
+		counts, err := s.store.CountActiveVariants(ctx, productType, ids)
+		if err != nil {
+			return nil, err
+		}
+		for key, n := range counts {
+			hasVariants[key] = n
+		}
+	}
+
+	result := validateInventoryImportRows(rows, branches, products, stocked, variants, hasVariants)
+	if dryRun || len(result.Errors) > 0 {
+		return result, nil
+	}
+	if err := s.store.ImportInventory(ctx, rows); err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	return result, nil
+}
+
+// Quản lý chi nhánh
+func validateBranch(branch *Branch) error {
+	branch.Name = strings.TrimSpace(branch.Name)
+	if branch.Name == "" {
+		return fmt.Errorf("%w: branch name is required", ErrInvalidArgument)
+	}
+	if branch.Email != "" && !strings.Contains(branch.Email, "@") {
+		return fmt.Errorf("%w: invalid email", ErrInvalidArgument)
+	}
+	if branch.Latitude < -90 || branch.Latitude > 90 || branch.Longitude < -180 || branch.Longitude > 180 {
+		return fmt.Errorf("%w: invalid coordinates", ErrInvalidArgument)
+	}
+	if branch.AppointmentCapacity < 0 {
+		return fmt.Errorf("%w: appointment capacity must not be negative", ErrInvalidArgument)
+	}
+	if branch.Timezone == "" {
+		branch.Timezone = defaultBranchTimezone
+	}
+	_, err := branchLocation(branch.Timezone)
+	return err
+}
+
+func (s *ProductServiceImpl) CreateBranch(ctx context.Context, branch *Branch) (int32, string, error) {
+	if err := validateBranch(branch); err != nil {
+		return 0, "Failed", err
+	}
+	branch.IsActive = true
+	if err := s.store.CreateBranch(ctx, branch); err != nil {
+		return 0, "Failed", err
+	}
+	return branch.ID, "Success", nil
+}
+
+func (s *ProductServiceImpl) UpdateBranch(ctx context.Context, branch *Branch) (string, error) {
+	if err := validateBranch(branch); err != nil {
+		return "Failed", err
+	}
+	if err := s.store.UpdateBranch(ctx, branch); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) DeactivateBranch(ctx context.Context, id int32) (string, error) {
+	if err := s.store.DeactivateBranch(ctx, id); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) SetBranchOpeningHours(ctx context.Context, branchID int32, hours []BranchOpeningHour) (string, error) {
+	if _, err := s.store.GetBranchByID(ctx, branchID); err != nil {
+		return "Failed", err
+	}
+	if err := validateOpeningHours(hours); err != nil {
+		return "Failed", err
+	}
+	if err := s.store.SetBranchOpeningHours(ctx, branchID, hours); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+// GetBranchSchedule trả về chi nhánh, lịch mở cửa trong tuần và các ngày nghỉ chưa kết thúc
+func (s *ProductServiceImpl) GetBranchSchedule(ctx context.Context, branchID int32) (*Branch, []BranchOpeningHour, []BranchClosure, error) {
+	branch, err := s.store.GetBranchByID(ctx, branchID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	loc, err := branchLocation(branch.Timezone)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	hours, err := s.store.GetBranchOpeningHours(ctx, branchID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	closures, err := s.store.ListBranchClosures(ctx, branchID, time.Now().In(loc).Format(dateLayout))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return branch, hours, closures, nil
+}
+
+func (s *ProductServiceImpl) AddBranchClosure(ctx context.Context, closure *BranchClosure) (*BranchClosure, error) {
+	if _, err := s.store.GetBranchByID(ctx, closure.BranchID); err != nil {
+		return nil, err
+	}
+	if closure.EndDate == "" {
+		closure.EndDate = closure.StartDate
+	}
+	start, err := time.Parse(dateLayout, closure.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid start_date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	end, err := time.Parse(dateLayout, closure.EndDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid end_date, must be YYYY-MM-DD", ErrInvalidArgument)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("%w: end_date must not be before start_date", ErrInvalidArgument)
+	}
+	if err := s.store.AddBranchClosure(ctx, closure); err != nil {
+		return nil, err
+	}
+	return closure, nil
+}
+
+func (s *ProductServiceImpl) DeleteBranchClosure(ctx context.Context, id int32) (string, error) {
+	if err := s.store.DeleteBranchClosure(ctx, id); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+// CheckBranchOpen dùng cho Orders (giờ lấy hàng) và Appointments (giờ hẹn)
+func (s *ProductServiceImpl) CheckBranchOpen(ctx context.Context, branchID int32, at time.Time, duration time.Duration) (bool, string, error) {
+	if duration < 0 {
+		return false, "", fmt.Errorf("%w: duration must not be negative", ErrInvalidArgument)
+	}
+	branch, err := s.store.GetBranchByID(ctx, branchID)
+	if err != nil {
+		return false, "", err
+	}
+	hours, err := s.store.GetBranchOpeningHours(ctx, branchID)
+	if err != nil {
+		return false, "", err
+	}
+	// Lấy ngày nghỉ từ ngày trước đó để không lệch múi giờ giữa at và giờ địa phương chi nhánh
+	closures, err := s.store.ListBranchClosures(ctx, branchID, at.Add(-24*time.Hour).Format(dateLayout))
+	if err != nil {
+		return false, "", err
+	}
+	return isBranchOpen(branch, hours, closures, at, duration)
+}
+
+// Khuyến mãi
+func (s *ProductServiceImpl) checkCouponCodeAvailable(ctx context.Context, code string, promotionID int32) error {
+	if code == "" {
+		return nil
+	}
+	existing, err := s.store.GetPromotionByCode(ctx, code)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.ID != promotionID {
+		return fmt.Errorf("%w: coupon code %s is already used by promotion %d", ErrInvalidArgument, code, existing.ID)
+	}
+	return nil
+}
+
+func (s *ProductServiceImpl) CreatePromotion(ctx context.Context, promotion *Promotion) (int32, string, error) {
+	if err := validatePromotion(promotion); err != nil {
+		return 0, "Failed", err
+	}
+	if err := s.checkCouponCodeAvailable(ctx, string(promotion.Code), 0); err != nil {
+		return 0, "Failed", err
+	}
+	promotion.ID = 0
+	promotion.UsedCount = 0
+	promotion.IsActive = true
+	if err := s.store.CreatePromotion(ctx, promotion); err != nil {
+		return 0, "Failed", err
+	}
+	return promotion.ID, "Success", nil
+}
+
+// UpdatePromotion cập nhật điều kiện khuyến mãi, chỉ ghi các cột điều kiện nên số lượt đã dùng và trạng thái giữ nguyên
+func (s *ProductServiceImpl) UpdatePromotion(ctx context.Context, promotion *Promotion) (string, error) {
+	if _, err := s.store.GetPromotionByID(ctx, promotion.ID); err != nil {
+		return "Failed", err
+	}
+	if err := validatePromotion(promotion); err != nil {
+		return "Failed", err
+	}
+	if err := s.checkCouponCodeAvailable(ctx, string(promotion.Code), promotion.ID); err != nil {
+		return "Failed", err
+	}
+	if err := s.store.UpdatePromotion(ctx, promotion); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) DeactivatePromotion(ctx context.Context, id int32) (string, error) {
+	if err := s.store.DeactivatePromotion(ctx, id); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+func (s *ProductServiceImpl) ListPromotions(ctx context.Context, includeInactive bool) ([]Promotion, error) {
+	return s.store.ListPromotions(ctx, includeInactive)
+}
+
+// quoteCart tính giá giỏ hàng với các khuyến mãi tự động đang bật và các mã khách nhập
+func (s *ProductServiceImpl) quoteCart(ctx context.Context, customerID int32, items []CartItem, couponCodes []string) (*CartPricing, error) {
+	if err := validateCartItems(items); err != nil {
+		return nil, err
+	}
+	active, err := s.store.ListPromotions(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	var automatic []Promotion
+	var ids []int32
+	for _, p := range active {
+		if p.Code == "" {
+			automatic = append(automatic, p)
+			ids = append(ids, p.ID)
+		}
+	}
+
+	var coupons []Promotion
+	seen := make(map[string]bool, len(couponCodes))
+	for _, code := range couponCodes {
+		code = normalizeCouponCode(code)
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		p, err := s.store.GetPromotionByCode(ctx, code)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: coupon %s not found", ErrInvalidArgument, code)
+		}
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, *p)
+		ids = append(ids, p.ID)
+	}
+
+	usage := map[int32]int32{}
+	if customerID > 0 && len(ids) > 0 {
+		if usage, err = s.store.CountCustomerRedemptions(ctx, customerID, ids); err != nil {
+			return nil, err
+		}
+	}
+	return priceCart(items, automatic, coupons, usage, time.Now())
+}
+
+func (s *ProductServiceImpl) PriceCart(ctx context.Context, customerID int32, items []CartItem, couponCodes []string) (*CartPricing, error) {
+	return s.quoteCart(ctx, customerID, items, couponCodes)
+}
+
+// RedeemPromotions tính giá rồi ghi nhận lượt dùng; giới hạn lượt dùng được kiểm tra lại trong transaction của store
+// để hai đơn đặt cùng lúc không vượt quá giới hạn
+func (s *ProductServiceImpl) RedeemPromotions(ctx context.Context, customerID int32, items []CartItem, couponCodes []string) (*CartPricing, error) {
+	if customerID <= 0 {
+		return nil, fmt.Errorf("%w: customer_id is required", ErrInvalidArgument)
+	}
+	pricing, err := s.quoteCart(ctx, customerID, items, couponCodes)
+	if err != nil {
+		return nil, err
+	}
+	if len(pricing.Applied) > 0 {
+		if err := s.store.RedeemPromotions(ctx, customerID, pricing.Applied); err != nil {
+			return nil, err
+		}
+	}
+	return pricing, nil
+}
+
+func (s *ProductServiceImpl) ReleasePromotionRedemptions(ctx context.Context, redemptionIDs []int32) (string, error) {
+	if len(redemptionIDs) == 0 {
+		return "Success", nil
+	}
+	if err := s.store.ReleasePromotionRedemptions(ctx, redemptionIDs); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+// Đánh giá sản phẩm
+func (s *ProductServiceImpl) CreateReview(ctx context.Context, review *Review, orderID int32) (*Review, error) {
+	productType, err := normalizeProductType(review.ProductType)
+	if err != nil {
+		return nil, err
+	}
+	review.ProductType = productType
+	if err := validateReview(review); err != nil {
+		return nil, err
+	}
+	if orderID <= 0 {
+		return nil, fmt.Errorf("%w: only customers with a completed order containing this product can review it", ErrInvalidState)
+	}
+	if err := s.productExists(ctx, review.ProductID, productType); err != nil {
+		return nil, err
+	}
+	existing, err := s.store.FindReview(ctx, review.CustomerID, review.ProductID, productType)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("%w: customer %d already reviewed this product (review %d)", ErrInvalidState, review.CustomerID, existing.ID)
+	}
+	review.ID = 0
+	review.OrderID = orderID
+	review.Status = ReviewPending
+	review.ModeratedBy, review.ModerationNote, review.ModeratedAt = 0, "", nil
+	if err := s.store.CreateReview(ctx, review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// ListProductReviews trả về một trang đánh giá đã duyệt của sản phẩm; điểm trung bình và số đánh giá
+// tính trên mọi đánh giá đã duyệt của sản phẩm
+func (s *ProductServiceImpl) ListProductReviews(ctx context.Context, productID int32, productType string, page, pageSize int32) ([]Review, ReviewSummary, error) {
+	productType, err := normalizeProductType(productType)
+	if err != nil {
+		return nil, ReviewSummary{}, err
+	}
+	page, pageSize = reviewPage(page, pageSize)
+	reviews, err := s.store.ListReviews(ctx, ReviewFilter{
+		Status:      ReviewApproved,
+		ProductID:   productID,
+		ProductType: productType,
+		Page:        page,
+		PageSize:    pageSize,
+	})
+	if err != nil {
+		return nil, ReviewSummary{}, err
+	}
+	summaries, err := s.store.ReviewSummaries(ctx)
+	if err != nil {
+		return nil, ReviewSummary{}, err
+	}
+	return reviews, summaries[stockKey(productType, productID)], nil
+}
+
+func (s *ProductServiceImpl) ListReviews(ctx context.Context, filter ReviewFilter) ([]Review, error) {
+	switch filter.Status {
+	case "", ReviewPending, ReviewApproved, ReviewRejected:
+	default:
+		return nil, fmt.Errorf("%w: unknown review status %q", ErrInvalidArgument, filter.Status)
+	}
+	if filter.ProductID > 0 {
+		productType, err := normalizeProductType(filter.ProductType)
+		if err != nil {
+			return nil, err
+		}
+		filter.ProductType = productType
+	}
+	filter.Page, filter.PageSize = reviewPage(filter.Page, filter.PageSize)
+	return s.store.ListReviews(ctx, filter)
+}
+
+// ModerateReview duyệt hoặc từ chối đánh giá; đánh giá đã duyệt vẫn có thể bị từ chối sau đó và ngược lại
+func (s *ProductServiceImpl) ModerateReview(ctx context.Context, id int32, status ReviewStatus, moderatorID int32, note string) (*Review, error) {
+	if status != ReviewApproved && status != ReviewRejected {
+		return nil, fmt.Errorf("%w: status must be approved or rejected", ErrInvalidArgument)
+	}
+	review, err := s.store.GetReviewByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	review.Status = status
+	review.ModeratedBy = moderatorID
+	review.ModerationNote = strings.TrimSpace(note)
+	review.ModeratedAt = &now
+	if err := s.store.UpdateReviewModeration(ctx, review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// attachRatings gắn điểm trung bình và số đánh giá đã duyệt vào danh sách sản phẩm
+func (s *ProductServiceImpl) attachRatings(ctx context.Context, products []GeneralProduct) error {
+	if len(products) == 0 {
+		return nil
+	}
+	summaries, err := s.store.ReviewSummaries(ctx)
+	if err != nil {
+		return err
+	}
+	applyReviewSummaries(products, summaries)
+	return nil
+}
+
+// Lịch sử giá
+// SchedulePriceChange hẹn giá mới cho sản phẩm (VariantID = 0) hoặc biến thể, tự áp dụng khi đến EffectiveAt
+func (s *ProductServiceImpl) SchedulePriceChange(ctx context.Context, change *PriceChange) (*PriceChange, error) {
+	productType, err := normalizeProductType(change.ProductType)
+	if err != nil {
+		return nil, err
+	}
+	if change.Price < 0 {
+		return nil, fmt.Errorf("%w: price must not be negative", ErrInvalidArgument)
+	}
+	if !change.EffectiveAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: effective_at must be in the future", ErrInvalidArgument)
+	}
+	if _, err := s.store.CurrentPrice(ctx, productType, change.ProductID, change.VariantID); err != nil {
+		return nil, err
+	}
+	change.ID = 0
+	change.ProductType = productType
+	change.PreviousPrice = 0
+	change.AppliedAt = nil
+	change.Note = strings.TrimSpace(change.Note)
+	if err := s.store.CreatePriceChange(ctx, change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// CancelPriceChange huỷ giá hẹn trước chưa áp dụng; lịch sử đã áp dụng không xoá được
+func (s *ProductServiceImpl) CancelPriceChange(ctx context.Context, id int32) (string, error) {
+	change, err := s.store.GetPriceChangeByID(ctx, id)
+	if err != nil {
+		return "Failed", err
+	}
+	if change.AppliedAt != nil {
+		return "Failed", fmt.Errorf("%w: price change %d was already applied", ErrInvalidState, id)
+	}
+	if err := s.store.DeletePriceChange(ctx, id); err != nil {
+		return "Failed", err
+	}
+	return "Success", nil
+}
+
+// ListPriceHistory trả về các thay đổi giá (cả giá hẹn trước) và giá đang lưu
+func (s *ProductServiceImpl) ListPriceHistory(ctx context.Context, productType string, productID, variantID int32) ([]PriceChange, float32, error) {
+	productType, err := normalizeProductType(productType)
+	if err != nil {
+		return nil, 0, err
+	}
+	current, err := s.store.CurrentPrice(ctx, productType, productID, variantID)
+	if err != nil {
+		return nil, 0, err
+	}
+	changes, err := s.store.ListPriceChanges(ctx, productType, productID, variantID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return changes, current, nil
+}
+
+// GetPriceAt trả về giá bán của sản phẩm / biến thể tại thời điểm at (quá khứ hoặc tương lai)
+func (s *ProductServiceImpl) GetPriceAt(ctx context.Context, productType string, productID, variantID int32, at time.Time) (float32, error) {
+	changes, current, err := s.ListPriceHistory(ctx, productType, productID, variantID)
+	if err != nil {
+		return 0, err
+	}
+	return pricing.PriceAt(current, changes, at), nil
+}
+
+// ApplyScheduledPrices áp dụng các giá hẹn trước đã đến hạn theo từng lô
+func (s *ProductServiceImpl) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	now := time.Now()
+	total := 0
+	for {
+		applied, err := s.store.ApplyDuePriceChanges(ctx, now, priceSweepBatch)
+		total += len(applied)
+		if err != nil || len(applied) < priceSweepBatch {
+			return total, err
+		}
+	}
 }
@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"sort"
+	"time"
+
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
-	"log"
 )
 
 // Store - Triển khai ProductStore
@@ -29,22 +33,31 @@ func (s *Store) GetFoodByID(ctx context.Context, id int32) (*Food, error) {
 
 func (s *Store) ListFoods(ctx context.Context) ([]Food, error) {
 	var foods []Food
-	if err := s.db.WithContext(ctx).Find(&foods).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("is_archived = ?", false).Find(&foods).Error; err != nil {
 		return nil, err
 	}
 	return foods, nil
 }
 
 func (s *Store) CreateFood(ctx context.Context, food *Food) error {
-	return s.db.WithContext(ctx).Create(food).Error
+	return duplicateProductCode(s.db.WithContext(ctx).Create(food).Error)
 }
 
 func (s *Store) UpdateFood(ctx context.Context, food *Food) error {
-	return s.db.WithContext(ctx).Save(food).Error
+	return duplicateProductCode(s.db.WithContext(ctx).Save(food).Error)
 }
 
 func (s *Store) DeleteFood(ctx context.Context, id int32) error {
-	return s.db.WithContext(ctx).Delete(&Food{}, id).Error
+	return s.setArchived(ctx, &Food{}, id, true)
+}
+
+// duplicateProductCode đổi lỗi trùng unique index SKU / mã vạch (khi hai request cùng ghi một mã
+// sau bước checkProductCodes) thành lỗi nghiệp vụ
+func duplicateProductCode(err error) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("%w: sku or barcode is already used", ErrInvalidArgument)
+	}
+	return err
 }
 
 // ------------------ Accessories ------------------
@@ -58,22 +71,22 @@ func (s *Store) GetAccessoryByID(ctx context.Context, id int32) (*Accessory, err
 
 func (s *Store) ListAccessories(ctx context.Context) ([]Accessory, error) {
 	var accessories []Accessory
-	if err := s.db.WithContext(ctx).Find(&accessories).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("is_archived = ?", false).Find(&accessories).Error; err != nil {
 		return nil, err
 	}
 	return accessories, nil
 }
 
 func (s *Store) CreateAccessory(ctx context.Context, accessory *Accessory) error {
-	return s.db.WithContext(ctx).Create(accessory).Error
+	return duplicateProductCode(s.db.WithContext(ctx).Create(accessory).Error)
 }
 
 func (s *Store) UpdateAccessory(ctx context.Context, accessory *Accessory) error {
-	return s.db.WithContext(ctx).Save(accessory).Error
+	return duplicateProductCode(s.db.WithContext(ctx).Save(accessory).Error)
 }
 
 func (s *Store) DeleteAccessory(ctx context.Context, id int32) error {
-	return s.db.WithContext(ctx).Delete(&Accessory{}, id).Error
+	return s.setArchived(ctx, &Accessory{}, id, true)
 }
 
 // ------------------ Medicines ------------------
@@ -87,22 +100,22 @@ func (s *Store) GetMedicineByID(ctx context.Context, id int32) (*Medicine, error
 
 func (s *Store) ListMedicines(ctx context.Context) ([]Medicine, error) {
 	var medicines []Medicine
-	if err := s.db.WithContext(ctx).Find(&medicines).Error; err != nil {
+	if err := s.db.WithContext(ctx).Where("is_archived = ?", false).Find(&medicines).Error; err != nil {
 		return nil, err
 	}
 	return medicines, nil
 }
 
 func (s *Store) CreateMedicine(ctx context.Context, medicine *Medicine) error {
-	return s.db.WithContext(ctx).Create(medicine).Error
+	return duplicateProductCode(s.db.WithContext(ctx).Create(medicine).Error)
 }
 
 func (s *Store) UpdateMedicine(ctx context.Context, medicine *Medicine) error {
-	return s.db.WithContext(ctx).Save(medicine).Error
+	return duplicateProductCode(s.db.WithContext(ctx).Save(medicine).Error)
 }
 
 func (s *Store) DeleteMedicine(ctx context.Context, id int32) error {
-	return s.db.WithContext(ctx).Delete(&Medicine{}, id).Error
+	return s.setArchived(ctx, &Medicine{}, id, true)
 }
 
 // ------------------ Branches ------------------
@@ -114,14 +127,92 @@ func (s *Store) GetBranchByID(ctx context.Context, id int32) (*Branch, error) {
 	return &branch, nil
 }
 
-func (s *Store) ListBranches(ctx context.Context) ([]Branch, error) {
+func (s *Store) ListBranches(ctx context.Context, includeInactive bool) ([]Branch, error) {
 	var branches []Branch
-	if err := s.db.WithContext(ctx).Find(&branches).Error; err != nil {
+	query := s.db.WithContext(ctx)
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+	if err := query.Find(&branches).Error; err != nil {
 		return nil, err
 	}
 	return branches, nil
 }
 
+func (s *Store) CreateBranch(ctx context.Context, branch *Branch) error {
+	return s.db.WithContext(ctx).Create(branch).Error
+}
+
+// UpdateBranch ghi đè thông tin liên hệ, toạ độ và sức chứa lịch hẹn, không đổi trạng thái hoạt động
+func (s *Store) UpdateBranch(ctx context.Context, branch *Branch) error {
+	result := s.db.WithContext(ctx).Model(&Branch{}).Where("id = ?", branch.ID).
+		Select("name", "location", "phone", "email", "latitude", "longitude", "timezone", "appointment_capacity").
+		Updates(branch)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// MySQL không tính dòng không đổi giá trị, kiểm tra lại chi nhánh có tồn tại không
+		_, err := s.GetBranchByID(ctx, branch.ID)
+		return err
+	}
+	return nil
+}
+
+func (s *Store) DeactivateBranch(ctx context.Context, id int32) error {
+	if _, err := s.GetBranchByID(ctx, id); err != nil {
+		return err
+	}
+	return s.db.WithContext(ctx).Model(&Branch{}).Where("id = ?", id).Update("is_active", false).Error
+}
+
+// SetBranchOpeningHours thay toàn bộ lịch mở cửa trong tuần của chi nhánh
+func (s *Store) SetBranchOpeningHours(ctx context.Context, branchID int32, hours []BranchOpeningHour) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("branch_id = ?", branchID).Delete(&BranchOpeningHour{}).Error; err != nil {
+			return err
+		}
+		if len(hours) == 0 {
+			return nil
+		}
+		for i := range hours {
+			hours[i].BranchID = branchID
+		}
+		return tx.Create(&hours).Error
+	})
+}
+
+func (s *Store) GetBranchOpeningHours(ctx context.Context, branchID int32) ([]BranchOpeningHour, error) {
+	var hours []BranchOpeningHour
+	err := s.db.WithContext(ctx).Where("branch_id = ?", branchID).Order("weekday, open_time").Find(&hours).Error
+	return hours, err
+}
+
+func (s *Store) AddBranchClosure(ctx context.Context, closure *BranchClosure) error {
+	return s.db.WithContext(ctx).Create(closure).Error
+}
+
+// ListBranchClosures lấy các ngày nghỉ kết thúc từ fromDate ("YYYY-MM-DD") trở đi
+func (s *Store) ListBranchClosures(ctx context.Context, branchID int32, fromDate string) ([]BranchClosure, error) {
+	var closures []BranchClosure
+	err := s.db.WithContext(ctx).
+		Where("branch_id = ? AND end_date >= ?", branchID, fromDate).
+		Order("start_date").
+		Find(&closures).Error
+	return closures, err
+}
+
+func (s *Store) DeleteBranchClosure(ctx context.Context, id int32) error {
+	result := s.db.WithContext(ctx).Delete(&BranchClosure{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 // ------------------ Branch Inventory ------------------
 func (s *Store) GetBranchInventory(ctx context.Context, branchID int32) ([]BranchProduct, error) {
 	var inventory []BranchProduct
@@ -131,9 +222,9 @@ func (s *Store) GetBranchInventory(ctx context.Context, branchID int32) ([]Branc
 	return inventory, nil
 }
 
-func (s *Store) UpdateBranchInventory(ctx context.Context, branchID int32, productID int32, productType string, stockQuantity int32) error {
+func (s *Store) UpdateBranchInventory(ctx context.Context, branchID int32, productID int32, productType string, variantID int32, stockQuantity int32) error {
 	return s.db.WithContext(ctx).Model(&BranchProduct{}).
-		Where("branch_id = ? AND product_id = ? AND product_type = ?", branchID, productID, productType).
+		Where("branch_id = ? AND product_id = ? AND product_type = ? AND variant_id = ?", branchID, productID, productType, variantID).
 		Update("stock_quantity", stockQuantity).Error
 }
 func (s *Store) ListAttachableProducts(ctx context.Context) ([]GeneralProduct, error) {
@@ -141,7 +232,7 @@ func (s *Store) ListAttachableProducts(ctx context.Context) ([]GeneralProduct, e
 
 	// Query Food
 	var foods []Food
-	if err := s.db.Where("is_attachable = ?", true).Find(&foods).Error; err != nil {
+	if err := s.db.Where("is_attachable = ? AND is_archived = ?", true, false).Find(&foods).Error; err != nil {
 		return nil, err
 	}
 	for _, food := range foods {
@@ -157,7 +248,7 @@ func (s *Store) ListAttachableProducts(ctx context.Context) ([]GeneralProduct, e
 
 	// Query Accessory
 	var accessories []Accessory
-	if err := s.db.Where("is_attachable = ?", true).Find(&accessories).Error; err != nil {
+	if err := s.db.Where("is_attachable = ? AND is_archived = ?", true, false).Find(&accessories).Error; err != nil {
 		return nil, err
 	}
 	for _, accessory := range accessories {
@@ -173,7 +264,7 @@ func (s *Store) ListAttachableProducts(ctx context.Context) ([]GeneralProduct, e
 
 	// Query Medicine
 	var medicines []Medicine
-	if err := s.db.Where("is_attachable = ?", true).Find(&medicines).Error; err != nil {
+	if err := s.db.Where("is_attachable = ? AND is_archived = ?", true, false).Find(&medicines).Error; err != nil {
 		return nil, err
 	}
 	for _, medicine := range medicines {
@@ -194,7 +285,7 @@ func (s *Store) ListAllProducts(ctx context.Context) ([]GeneralProduct, error) {
 
 	// Query Food
 	var foods []Food
-	if err := s.db.Find(&foods).Error; err != nil {
+	if err := s.db.Where("is_archived = ?", false).Find(&foods).Error; err != nil {
 		return nil, err
 	}
 	for _, food := range foods {
@@ -206,12 +297,14 @@ func (s *Store) ListAllProducts(ctx context.Context) ([]GeneralProduct, error) {
 			ProductID:    food.ID,
 			ProductType:  "food",
 			IsAttachable: food.IsAttachable,
+			SKU:          string(food.SKU),
+			Barcode:      string(food.Barcode),
 		})
 	}
 
 	// Query Accessory
 	var accessories []Accessory
-	if err := s.db.Find(&accessories).Error; err != nil {
+	if err := s.db.Where("is_archived = ?", false).Find(&accessories).Error; err != nil {
 		return nil, err
 	}
 	for _, accessory := range accessories {
@@ -223,12 +316,14 @@ func (s *Store) ListAllProducts(ctx context.Context) ([]GeneralProduct, error) {
 			ProductID:    accessory.ID,
 			ProductType:  "accessory",
 			IsAttachable: accessory.IsAttachable,
+			SKU:          string(accessory.SKU),
+			Barcode:      string(accessory.Barcode),
 		})
 	}
 
 	// Query Medicine
 	var medicines []Medicine
-	if err := s.db.Find(&medicines).Error; err != nil {
+	if err := s.db.Where("is_archived = ?", false).Find(&medicines).Error; err != nil {
 		return nil, err
 	}
 	for _, medicine := range medicines {
@@ -240,6 +335,8 @@ func (s *Store) ListAllProducts(ctx context.Context) ([]GeneralProduct, error) {
 			ProductID:    medicine.ID,
 			ProductType:  "medicine",
 			IsAttachable: medicine.IsAttachable,
+			SKU:          string(medicine.SKU),
+			Barcode:      string(medicine.Barcode),
 		})
 	}
 
@@ -250,7 +347,7 @@ func (s *Store) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStoc
 
 	// Lấy toàn bộ tồn kho từ BranchProduct
 	var branchProducts []BranchProduct
-	if err := s.db.Find(&branchProducts).Error; err != nil {
+	if err := s.db.Order("product_type, product_id, branch_id").Find(&branchProducts).Error; err != nil {
 		return nil, err
 	}
 
@@ -260,11 +357,17 @@ func (s *Store) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStoc
 	}
 	inventoryMap := make(map[string][]Inventory)
 
+	// Sản phẩm có biến thể có nhiều dòng tồn kho tại một chi nhánh, cộng dồn thành một dòng
 	for _, bp := range branchProducts {
 		key := typeKey(bp.ProductType, bp.ProductID)
-		inventoryMap[key] = append(inventoryMap[key], Inventory{
+		inv := inventoryMap[key]
+		if n := len(inv); n > 0 && inv[n-1].BranchID == bp.BranchID {
+			inv[n-1].Quantity += bp.Available()
+			continue
+		}
+		inventoryMap[key] = append(inv, Inventory{
 			BranchID: bp.BranchID,
-			Quantity: bp.StockQuantity,
+			Quantity: bp.Available(),
 		})
 	}
 
@@ -286,7 +389,7 @@ func (s *Store) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStoc
 
 	// Truy vấn và xử lý Food
 	var foods []Food
-	if err := s.db.Find(&foods).Error; err != nil {
+	if err := s.db.Where("is_archived = ?", false).Find(&foods).Error; err != nil {
 		return nil, err
 	}
 	for _, food := range foods {
@@ -295,7 +398,7 @@ func (s *Store) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStoc
 
 	// Truy vấn và xử lý Accessory
 	var accessories []Accessory
-	if err := s.db.Find(&accessories).Error; err != nil {
+	if err := s.db.Where("is_archived = ?", false).Find(&accessories).Error; err != nil {
 		return nil, err
 	}
 	for _, accessory := range accessories {
@@ -304,7 +407,7 @@ func (s *Store) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStoc
 
 	// Truy vấn và xử lý Medicine
 	var medicines []Medicine
-	if err := s.db.Find(&medicines).Error; err != nil {
+	if err := s.db.Where("is_archived = ?", false).Find(&medicines).Error; err != nil {
 		return nil, err
 	}
 	for _, medicine := range medicines {
@@ -314,256 +417,1200 @@ func (s *Store) ListAllProductsWithStock(ctx context.Context) ([]ProductWithStoc
 	return result, nil
 }
 
+// ListAvailableProductsByBranch và ListAvailableAllProductsByBranch dùng chung listAvailableByBranch
+// để số lượng có thể bán (BranchProduct.Available) và điều kiện lọc giống nhau ở mọi danh sách.
 func (s *Store) ListAvailableProductsByBranch(ctx context.Context, branchID int32, productType string) ([]GeneralProduct, error) {
-	var results []GeneralProduct
-	log.Printf("Listing available products for branch %d with type %s", branchID, productType)
-	// Helper xử lý 1 loại sản phẩm
-	handle := func(tableName string, productTypeVal string, dest interface{}, getProductInfo func(interface{}) GeneralProduct) error {
-		// Query tồn kho còn hàng
-		var inventories []BranchProduct
-		log.Printf("Querying inventory for branch %d and product type %s", branchID, productTypeVal)
-		if err := s.db.
-			Where("branch_id = ? AND product_type = ? AND stock_quantity > 0", branchID, productTypeVal).
-			Find(&inventories).Error; err != nil {
-			return err
-		}
+	return s.listAvailableByBranch(ctx, branchID, productType)
+}
 
-		// Lấy danh sách product_id
-		productIDs := make([]int32, 0, len(inventories))
-		log.Println("Found inventories:", len(inventories))
-		log.Println("PRODUCTS:", inventories)
-		stockMap := make(map[int32]int32)
-		for _, inv := range inventories {
-			productIDs = append(productIDs, inv.ProductID)
-			stockMap[inv.ProductID] = inv.StockQuantity - inv.ReservedQuantity
-		}
+func (s *Store) ListAvailableAllProductsByBranch(ctx context.Context, branchID int32) ([]GeneralProduct, error) {
+	return s.listAvailableByBranch(ctx, branchID, "")
+}
 
-		if len(productIDs) == 0 {
-			return nil // không có sản phẩm nào
-		}
+// listAvailableByBranch trả về các sản phẩm còn hàng có thể bán tại chi nhánh, productType rỗng = mọi loại.
+// Thứ tự: thực phẩm, phụ kiện, thuốc; trong mỗi loại theo id.
+func (s *Store) listAvailableByBranch(ctx context.Context, branchID int32, productType string) ([]GeneralProduct, error) {
+	query := s.db.WithContext(ctx).Where("branch_id = ? AND "+availableQuantitySQL+" > 0", branchID)
+	if productType != "" {
+		query = query.Where("product_type = ?", productType)
+	}
+	var inventories []BranchProduct
+	if err := query.Find(&inventories).Error; err != nil {
+		return nil, err
+	}
 
-		// Lấy chi tiết sản phẩm
-		if err := s.db.
-			Where("id IN (?)", productIDs).
-			Find(dest).Error; err != nil {
-			return err
+	available := make(map[string]int32, len(inventories))
+	idsByType := make(map[string][]int32)
+	for _, inv := range inventories {
+		// Số lượng của sản phẩm có biến thể là tổng các biến thể còn hàng
+		key := stockKey(inv.ProductType, inv.ProductID)
+		if _, seen := available[key]; !seen {
+			idsByType[inv.ProductType] = append(idsByType[inv.ProductType], inv.ProductID)
 		}
+		available[key] += inv.Available()
+	}
 
-		// Chuyển đổi kết quả
-		switch list := dest.(type) {
-		case *[]Food:
-			for _, p := range *list {
-				res := getProductInfo(p)
-				res.AvailableQuantity = stockMap[p.ID]
-				results = append(results, res)
-			}
-		case *[]Accessory:
-			for _, p := range *list {
-				res := getProductInfo(p)
-				res.AvailableQuantity = stockMap[p.ID]
-				results = append(results, res)
-			}
-		case *[]Medicine:
-			for _, p := range *list {
-				res := getProductInfo(p)
-				res.AvailableQuantity = stockMap[p.ID]
-				results = append(results, res)
+	var products []GeneralProduct
+	for _, ptype := range searchProductTypes {
+		ids := idsByType[ptype]
+		if len(ids) == 0 {
+			continue
+		}
+		list, err := s.generalProductsByIDs(ctx, ptype, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range list {
+			// Sản phẩm đã lưu trữ vẫn còn dòng tồn kho nhưng không được bán nữa
+			if p.IsArchived {
+				continue
 			}
+			p.AvailableQuantity = available[stockKey(p.ProductType, p.ProductID)]
+			products = append(products, p)
 		}
-
-		return nil
 	}
+	return products, nil
+}
 
-	// Tùy theo productType lọc
+// generalProductsByIDs lấy thông tin chung của các sản phẩm cùng loại theo id
+func (s *Store) generalProductsByIDs(ctx context.Context, productType string, ids []int32) ([]GeneralProduct, error) {
+	db := s.db.WithContext(ctx).Where("id IN ?", ids).Order("id")
+	var products []GeneralProduct
 	switch productType {
-	case "food", "FOOD":
-		if err := handle("foods", "food", &[]Food{}, func(p interface{}) GeneralProduct {
-			f := p.(Food)
-			return GeneralProduct{
-				ProductID:   f.ID,
-				ProductType: "food",
-				Name:        f.Name,
-				Description: f.Description,
-				Price:       f.Price,
-				ImgUrl:      f.ImgUrl,
-			}
-		}); err != nil {
+	case "food":
+		var foods []Food
+		if err := db.Find(&foods).Error; err != nil {
 			return nil, err
 		}
-	case "accessory", "ACCESSORY":
-		if err := handle("accessories", "accessory", &[]Accessory{}, func(p interface{}) GeneralProduct {
-			a := p.(Accessory)
-			return GeneralProduct{
-				ProductID:   a.ID,
-				ProductType: "accessory",
-				Name:        a.Name,
-				Description: a.Description,
-				Price:       a.Price,
-				ImgUrl:      a.ImgUrl,
-			}
-		}); err != nil {
+		for _, f := range foods {
+			products = append(products, GeneralProduct{Name: f.Name, Description: f.Description, Price: f.Price, ImgUrl: f.ImgUrl, ProductID: f.ID, ProductType: "food", IsAttachable: f.IsAttachable, SKU: string(f.SKU), Barcode: string(f.Barcode), IsArchived: f.IsArchived})
+		}
+	case "accessory":
+		var accessories []Accessory
+		if err := db.Find(&accessories).Error; err != nil {
 			return nil, err
 		}
-	case "medicine", "MEDICINE":
-		if err := handle("medicines", "medicine", &[]Medicine{}, func(p interface{}) GeneralProduct {
-			m := p.(Medicine)
-			return GeneralProduct{
-				ProductID:   m.ID,
-				ProductType: "medicine",
-				Name:        m.Name,
-				Description: m.Description,
-				Price:       m.Price,
-				ImgUrl:      m.ImgUrl,
-			}
-		}); err != nil {
+		for _, a := range accessories {
+			products = append(products, GeneralProduct{Name: a.Name, Description: a.Description, Price: a.Price, ImgUrl: a.ImgUrl, ProductID: a.ID, ProductType: "accessory", IsAttachable: a.IsAttachable, SKU: string(a.SKU), Barcode: string(a.Barcode), IsArchived: a.IsArchived})
+		}
+	case "medicine":
+		var medicines []Medicine
+		if err := db.Find(&medicines).Error; err != nil {
 			return nil, err
 		}
+		for _, m := range medicines {
+			products = append(products, GeneralProduct{Name: m.Name, Description: m.Description, Price: m.Price, ImgUrl: m.ImgUrl, ProductID: m.ID, ProductType: "medicine", IsAttachable: m.IsAttachable, SKU: string(m.SKU), Barcode: string(m.Barcode), IsArchived: m.IsArchived})
+		}
+	default:
+		return nil, ErrInvalidProductType
 	}
+	return products, nil
+}
 
-	return results, nil
+// ------------------ Product Variants ------------------
+func (s *Store) CreateVariant(ctx context.Context, variant *ProductVariant) error {
+	return duplicateProductCode(s.db.WithContext(ctx).Create(variant).Error)
 }
-func (s *Store) ListAvailableAllProductsByBranch(ctx context.Context, branchID int32) ([]GeneralProduct, error) {
-	var products []GeneralProduct
 
-	// 1. Query Food
-	var foods []struct {
-		Food
-		StockQty    int32
-		ReservedQty int32
+// UpdateVariant ghi đè thông tin bán hàng của biến thể, không đổi sản phẩm gốc và trạng thái
+func (s *Store) UpdateVariant(ctx context.Context, variant *ProductVariant) error {
+	result := s.db.WithContext(ctx).Model(&ProductVariant{}).Where("id = ?", variant.ID).
+		Select("name", "sku", "barcode", "size", "weight", "flavour", "price").
+		Updates(variant)
+	if result.Error != nil {
+		return duplicateProductCode(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		_, err := s.GetVariantByID(ctx, variant.ID)
+		return err
 	}
-	if err := s.db.
-		Table("foods").
-		Select("foods.*, bp.stock_quantity AS stock_qty, bp.reserved_quantity AS reserved_qty").
-		Joins("JOIN branch_products bp ON bp.product_id = foods.id AND bp.product_type = ?", "food").
-		Where("bp.branch_id = ? AND bp.stock_quantity > 0", branchID).
-		Scan(&foods).Error; err != nil {
+	return nil
+}
+
+func (s *Store) GetVariantByID(ctx context.Context, id int32) (*ProductVariant, error) {
+	var variant ProductVariant
+	if err := s.db.WithContext(ctx).First(&variant, id).Error; err != nil {
 		return nil, err
 	}
-	for _, food := range foods {
-		products = append(products, GeneralProduct{
-			Name:              food.Name,
-			Description:       food.Description,
-			Price:             food.Price,
-			ImgUrl:            food.ImgUrl,
-			ProductID:         food.ID,
-			ProductType:       "food",
-			IsAttachable:      food.IsAttachable,
-			AvailableQuantity: food.StockQty, // Tính số lượng có sẵn
-		})
+	return &variant, nil
+}
+
+func (s *Store) ListVariants(ctx context.Context, productID int32, productType string, includeInactive bool) ([]ProductVariant, error) {
+	query := s.db.WithContext(ctx).Where("product_id = ? AND product_type = ?", productID, productType)
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
 	}
+	var variants []ProductVariant
+	err := query.Order("price, id").Find(&variants).Error
+	return variants, err
+}
 
-	// 2. Query Accessory
-	var accessories []struct {
-		Accessory
-		StockQty    int32
-		ReservedQty int32
+func (s *Store) ListVariantsByIDs(ctx context.Context, ids []int32) ([]ProductVariant, error) {
+	var variants []ProductVariant
+	err := s.db.WithContext(ctx).Where("id IN ?", ids).Find(&variants).Error
+	return variants, err
+}
+
+func (s *Store) DeactivateVariant(ctx context.Context, id int32) error {
+	if _, err := s.GetVariantByID(ctx, id); err != nil {
+		return err
 	}
-	if err := s.db.
-		Table("accessories").
-		Select("accessories.*, bp.stock_quantity AS stock_qty, bp.reserved_quantity AS reserved_qty").
-		Joins("JOIN branch_products bp ON bp.product_id = accessories.id AND bp.product_type = ?", "accessory").
-		Where("bp.branch_id = ? AND bp.stock_quantity - bp.reserved_quantity > 0", branchID).
-		Scan(&accessories).Error; err != nil {
+	return s.db.WithContext(ctx).Model(&ProductVariant{}).Where("id = ?", id).Update("is_active", false).Error
+}
+
+func (s *Store) CountActiveVariants(ctx context.Context, productType string, productIDs []int32) (map[string]int32, error) {
+	var rows []struct {
+		ProductID int32
+		Count     int32
+	}
+	err := s.db.WithContext(ctx).Model(&ProductVariant{}).
+		Select("product_id, COUNT(*) AS count").
+		Where("product_type = ? AND product_id IN ? AND is_active = ?", productType, productIDs, true).
+		Group("product_id").
+		Scan(&rows).Error
+	if err != nil {
 		return nil, err
 	}
-	for _, acc := range accessories {
-		products = append(products, GeneralProduct{
-			Name:              acc.Name,
-			Description:       acc.Description,
-			Price:             acc.Price,
-			ImgUrl:            acc.ImgUrl,
-			ProductID:         acc.ID,
-			ProductType:       "accessory",
-			IsAttachable:      acc.IsAttachable,
-			AvailableQuantity: acc.StockQty,
-		})
+	counts := make(map[string]int32, len(rows))
+	for _, r := range rows {
+		counts[stockKey(productType, r.ProductID)] = r.Count
 	}
+	return counts, nil
+}
 
-	// 3. Query Medicine
-	var medicines []struct {
-		Medicine
-		StockQty    int32
-		ReservedQty int32
+// FindByCode tìm SKU / mã vạch trong cả ba bảng sản phẩm và bảng biến thể
+func (s *Store) FindByCode(ctx context.Context, code string) ([]ProductCode, error) {
+	var matches []ProductCode
+	for _, productType := range searchProductTypes {
+		model, err := productModel(productType)
+		if err != nil {
+			return nil, err
+		}
+		var ids []int32
+		if err := s.db.WithContext(ctx).Model(model).Where("sku = ? OR barcode = ?", code, code).Pluck("id", &ids).Error; err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			matches = append(matches, ProductCode{ProductType: productType, ProductID: id})
+		}
 	}
-	if err := s.db.
-		Table("medicines").
-		Select("medicines.*, bp.stock_quantity AS stock_qty, bp.reserved_quantity AS reserved_qty").
-		Joins("JOIN branch_products bp ON bp.product_id = medicines.id AND bp.product_type = ?", "medicine").
-		Where("bp.branch_id = ? AND bp.stock_quantity - bp.reserved_quantity > 0", branchID).
-		Scan(&medicines).Error; err != nil {
+
+	var variants []ProductVariant
+	if err := s.db.WithContext(ctx).Where("sku = ? OR barcode = ?", code, code).Find(&variants).Error; err != nil {
 		return nil, err
 	}
-	for _, med := range medicines {
-		products = append(products, GeneralProduct{
-			Name:              med.Name,
-			Description:       med.Description,
-			Price:             med.Price,
-			ImgUrl:            med.ImgUrl,
-			ProductID:         med.ID,
-			ProductType:       "medicine",
-			IsAttachable:      med.IsAttachable,
-			AvailableQuantity: med.StockQty, // Tính số lượng có sẵn
-		})
+	for _, v := range variants {
+		matches = append(matches, ProductCode{ProductType: v.ProductType, ProductID: v.ProductID, VariantID: v.ID})
+	}
+	return matches, nil
+}
+
+func (s *Store) GetGeneralProduct(ctx context.Context, productType string, productID int32) (*GeneralProduct, error) {
+	products, err := s.generalProductsByIDs(ctx, productType, []int32{productID})
+	if err != nil {
+		return nil, err
+	}
+	if len(products) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &products[0], nil
+}
+
+// setArchived lưu trữ / bỏ lưu trữ sản phẩm. Sản phẩm không bị xoá hẳn để đơn hàng và đánh giá cũ
+// vẫn tra được theo id; trả về gorm.ErrRecordNotFound nếu không có sản phẩm.
+func (s *Store) setArchived(ctx context.Context, model interface{}, id int32, archived bool) error {
+	var archivedAt *time.Time
+	if archived {
+		now := time.Now()
+		archivedAt = &now
+	}
+	db := s.db.WithContext(ctx)
+	if err := db.First(model, id).Error; err != nil {
+		return err
+	}
+	return db.Model(model).Updates(map[string]interface{}{"is_archived": archived, "archived_at": archivedAt}).Error
+}
+
+func (s *Store) RestoreProduct(ctx context.Context, productType string, productID int32) error {
+	model, err := productModel(productType)
+	if err != nil {
+		return err
 	}
+	return s.setArchived(ctx, model, productID, false)
+}
 
+// ListArchivedProducts - Thứ tự: thực phẩm, phụ kiện, thuốc; trong mỗi loại theo id
+func (s *Store) ListArchivedProducts(ctx context.Context) ([]GeneralProduct, error) {
+	var products []GeneralProduct
+	for _, productType := range searchProductTypes {
+		model, err := productModel(productType)
+		if err != nil {
+			return nil, err
+		}
+		var ids []int32
+		if err := s.db.WithContext(ctx).Model(model).Where("is_archived = ?", true).Pluck("id", &ids).Error; err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		list, err := s.generalProductsByIDs(ctx, productType, ids)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, list...)
+	}
 	return products, nil
 }
-func (s *Store) ReserveProduct(ctx context.Context, branchID, productID int32, productType string, quantity int32) error {
+
+func (s *Store) GetAvailableQuantity(ctx context.Context, branchID int32, productType string, productID int32, variantID *int32) (int32, error) {
+	query := s.db.WithContext(ctx).Model(&BranchProduct{}).
+		Where("branch_id = ? AND product_id = ? AND product_type = ?", branchID, productID, productType)
+	if variantID != nil {
+		query = query.Where("variant_id = ?", *variantID)
+	}
+	var available int32
+	err := query.Select("COALESCE(SUM(" + availableQuantitySQL + "), 0)").Scan(&available).Error
+	return available, err
+}
+
+// ------------------ Reservations ------------------
+func (s *Store) ReserveProduct(ctx context.Context, reservation *Reservation) error {
+	reservations := []Reservation{*reservation}
+	shortfalls, err := s.ReserveProducts(ctx, reservations)
+	if err != nil {
+		return err
+	}
+	if len(shortfalls) > 0 {
+		return fmt.Errorf("%w: not enough stock available", ErrInvalidState)
+	}
+	*reservation = reservations[0]
+	return nil
+}
+
+// errReservationShortfall - Dùng nội bộ để rollback transaction khi thiếu hàng
+var errReservationShortfall = errors.New("reservation shortfall")
+
+func (s *Store) ReserveProducts(ctx context.Context, reservations []Reservation) ([]ReservationShortfall, error) {
+	// Gộp số lượng theo sản phẩm và khoá các dòng tồn kho theo thứ tự (product_type, product_id, variant_id)
+	// để hai giỏ hàng có chung sản phẩm không khoá chéo nhau
+	type productKey struct {
+		ProductType string
+		ProductID   int32
+		VariantID   int32
+	}
+	requested := make(map[productKey]int32)
+	var keys []productKey
+	for _, r := range reservations {
+		key := productKey{ProductType: r.ProductType, ProductID: r.ProductID, VariantID: r.VariantID}
+		if _, ok := requested[key]; !ok {
+			keys = append(keys, key)
+		}
+		requested[key] += r.Quantity
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ProductType != keys[j].ProductType {
+			return keys[i].ProductType < keys[j].ProductType
+		}
+		if keys[i].ProductID != keys[j].ProductID {
+			return keys[i].ProductID < keys[j].ProductID
+		}
+		return keys[i].VariantID < keys[j].VariantID
+	})
+
+	var shortfalls []ReservationShortfall
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		stocks := make(map[productKey]*BranchProduct, len(keys))
+		for _, key := range keys {
+			var stock BranchProduct
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("branch_id = ? AND product_id = ? AND product_type = ? AND variant_id = ?", reservations[0].BranchID, key.ProductID, key.ProductType, key.VariantID).
+				First(&stock).Error
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			// Chi nhánh không bán sản phẩm này thì coi như còn 0
+			if stock.StockQuantity < requested[key] {
+				shortfalls = append(shortfalls, ReservationShortfall{
+					ProductID:   key.ProductID,
+					ProductType: key.ProductType,
+					VariantID:   key.VariantID,
+					Requested:   requested[key],
+					Available:   stock.StockQuantity,
+				})
+				continue
+			}
+			stocks[key] = &stock
+		}
+		if len(shortfalls) > 0 {
+			return errReservationShortfall
+		}
+
+		for _, key := range keys {
+			stock := stocks[key]
+			stock.StockQuantity -= requested[key]
+			stock.ReservedQuantity += requested[key]
+			if err := tx.Save(stock).Error; err != nil {
+				return err
+			}
+		}
+		for i := range reservations {
+			reservations[i].Status = ReservationHeld
+		}
+		return tx.Create(&reservations).Error
+	})
+	if errors.Is(err, errReservationShortfall) {
+		return shortfalls, nil
+	}
+	return nil, err
+}
+
+func (s *Store) GetReservation(ctx context.Context, id int32) (*Reservation, error) {
+	var reservation Reservation
+	if err := s.db.WithContext(ctx).First(&reservation, id).Error; err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+// finishReservation khoá lần giữ hàng, kiểm tra còn ở trạng thái held (và nếu cần, đã quá hạn) rồi chuyển sang trạng thái mới.
+// Hàng đang giữ được xuất khỏi kho khi xác nhận, hoặc trả lại StockQuantity khi huỷ / hết hạn.
+func finishReservation(tx *gorm.DB, id int32, newStatus ReservationStatus, expiredBefore *time.Time) (*Reservation, error) {
+	var reservation Reservation
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&reservation, id).Error; err != nil {
+		return nil, err
+	}
+	if reservation.Status != ReservationHeld {
+		return nil, fmt.Errorf("%w: reservation %d is already %s", ErrInvalidState, id, reservation.Status)
+	}
+	if expiredBefore != nil && (reservation.ExpiresAt == nil || !reservation.ExpiresAt.Before(*expiredBefore)) {
+		return nil, fmt.Errorf("%w: reservation %d has not expired", ErrInvalidState, id)
+	}
+
+	var stock BranchProduct
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("branch_id = ? AND product_id = ? AND product_type = ? AND variant_id = ?",
+			reservation.BranchID, reservation.ProductID, reservation.ProductType, reservation.VariantID).
+		First(&stock).Error; err != nil {
+		return nil, err
+	}
+	if stock.ReservedQuantity < reservation.Quantity {
+		return nil, fmt.Errorf("reserved stock of %s %d at branch %d is lower than reservation %d",
+			reservation.ProductType, reservation.ProductID, reservation.BranchID, id)
+	}
+	stock.ReservedQuantity -= reservation.Quantity
+	if newStatus != ReservationConfirmed {
+		// Xác nhận lấy hàng thì không cộng lại vào available vì hàng đã xuất
+		stock.StockQuantity += reservation.Quantity
+	}
+	if err := tx.Save(&stock).Error; err != nil {
+		return nil, err
+	}
+
+	reservation.Status = newStatus
+	if err := tx.Model(&reservation).Update("status", newStatus).Error; err != nil {
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+func (s *Store) ConfirmReservation(ctx context.Context, id int32) (*Reservation, error) {
+	var reservation *Reservation
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = finishReservation(tx, id, ReservationConfirmed, nil)
+		return err
+	})
+	return reservation, err
+}
+
+func (s *Store) ReleaseReservation(ctx context.Context, id int32) (*Reservation, error) {
+	var reservation *Reservation
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = finishReservation(tx, id, ReservationReleased, nil)
+		return err
+	})
+	return reservation, err
+}
+
+func (s *Store) AssignReservations(ctx context.Context, ids []int32, orderID int32, expiresAt *time.Time) error {
 	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var stock BranchProduct
-		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("branch_id = ? AND product_id = ? AND product_type = ?", branchID, productID, productType).
-			First(&stock).Error; err != nil {
+		result := tx.Model(&Reservation{}).
+			Where("id IN ? AND status = ?", ids, ReservationHeld).
+			Updates(map[string]interface{}{"order_id": orderID, "expires_at": expiresAt})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(ids)) {
+			return fmt.Errorf("%w: some reservations are not held anymore", ErrInvalidState)
+		}
+		return nil
+	})
+}
+
+func (s *Store) ExpireReservations(ctx context.Context, now time.Time, limit int) (int, error) {
+	var ids []int32
+	if err := s.db.WithContext(ctx).Model(&Reservation{}).
+		Where("status = ? AND expires_at IS NOT NULL AND expires_at < ?", ReservationHeld, now).
+		Order("expires_at").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return 0, err
+	}
+
+	released := 0
+	for _, id := range ids {
+		// Mỗi lần giữ một transaction; lần giữ vừa được xác nhận hoặc gia hạn sau khi lấy danh sách thì bỏ qua
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			_, err := finishReservation(tx, id, ReservationExpired, &now)
 			return err
+		})
+		if errors.Is(err, ErrInvalidState) {
+			continue
 		}
+		if err != nil {
+			return released, err
+		}
+		released++
+	}
+	return released, nil
+}
 
-		if stock.StockQuantity < quantity {
-			return fmt.Errorf("not enough stock available")
+// ------------------ Product Images ------------------
+
+// productModel trả về model GORM tương ứng với loại sản phẩm
+func productModel(productType string) (interface{}, error) {
+	switch productType {
+	case "food":
+		return &Food{}, nil
+	case "accessory":
+		return &Accessory{}, nil
+	case "medicine":
+		return &Medicine{}, nil
+	default:
+		return nil, ErrInvalidProductType
+	}
+}
+
+// setPrimaryProductImage đánh dấu ảnh chính và đồng bộ ImgUrl của sản phẩm (url rỗng = xoá ảnh)
+func setPrimaryProductImage(tx *gorm.DB, productID int32, productType string, imageID int32, url string) error {
+	if err := tx.Model(&ProductImage{}).
+		Where("product_id = ? AND product_type = ?", productID, productType).
+		Update("is_primary", gorm.Expr("id = ?", imageID)).Error; err != nil {
+		return err
+	}
+	model, err := productModel(productType)
+	if err != nil {
+		return err
+	}
+	return tx.Model(model).Where("id = ?", productID).Update("img_url", url).Error
+}
+
+// imagePositions - số ảnh hiện có và position lớn nhất (-1 khi chưa có ảnh)
+type imagePositions struct {
+	Count       int64
+	MaxPosition int32
+}
+
+// AddProductImages thêm các ảnh của cùng một sản phẩm trong một transaction, position nối tiếp sau MAX(position)
+// để không trùng khi đã xoá bớt ảnh. Ảnh đầu tiên của sản phẩm luôn là ảnh chính.
+func (s *Store) AddProductImages(ctx context.Context, images []ProductImage) error {
+	if len(images) == 0 {
+		return nil
+	}
+	productID, productType := images[0].ProductID, images[0].ProductType
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing imagePositions
+		if err := tx.Model(&ProductImage{}).
+			Select("COUNT(*) AS count, COALESCE(MAX(position), -1) AS max_position").
+			Where("product_id = ? AND product_type = ?", productID, productType).
+			Scan(&existing).Error; err != nil {
+			return err
+		}
+		primary := -1
+		for i := range images {
+			images[i].Position = existing.MaxPosition + 1 + int32(i)
+			if images[i].IsPrimary && primary < 0 {
+				primary = i
+			}
+			images[i].IsPrimary = false
+		}
+		if primary < 0 && existing.Count == 0 {
+			primary = 0
+		}
+		if primary >= 0 {
+			images[primary].IsPrimary = true
 		}
+		if err := tx.Create(&images).Error; err != nil {
+			return err
+		}
+		if primary < 0 {
+			return nil
+		}
+		return setPrimaryProductImage(tx, productID, productType, images[primary].ID, images[primary].URL)
+	})
+}
 
-		stock.StockQuantity -= quantity
-		stock.ReservedQuantity += quantity
+func (s *Store) ListProductImages(ctx context.Context, productID int32, productType string) ([]ProductImage, error) {
+	var images []ProductImage
+	if err := s.db.WithContext(ctx).
+		Where("product_id = ? AND product_type = ?", productID, productType).
+		Order("position").
+		Find(&images).Error; err != nil {
+		return nil, err
+	}
+	return images, nil
+}
 
-		return tx.Save(&stock).Error
+// DeleteProductImage xoá ảnh và trả về bản ghi đã xoá để gateway dọn file trong blob store.
+// Nếu xoá ảnh chính thì ảnh kế tiếp (theo position) trở thành ảnh chính.
+func (s *Store) DeleteProductImage(ctx context.Context, id int32) (*ProductImage, error) {
+	var image ProductImage
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&image, id).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&ProductImage{}, id).Error; err != nil {
+			return err
+		}
+		if !image.IsPrimary {
+			return nil
+		}
+		var next ProductImage
+		err := tx.Where("product_id = ? AND product_type = ?", image.ProductID, image.ProductType).
+			Order("position").
+			First(&next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return setPrimaryProductImage(tx, image.ProductID, image.ProductType, 0, "")
+		}
+		if err != nil {
+			return err
+		}
+		return setPrimaryProductImage(tx, image.ProductID, image.ProductType, next.ID, next.URL)
 	})
+	if err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+// ------------------ Suppliers ------------------
+func (s *Store) CreateSupplier(ctx context.Context, supplier *Supplier) error {
+	return s.db.WithContext(ctx).Create(supplier).Error
+}
+
+func (s *Store) UpdateSupplier(ctx context.Context, supplier *Supplier) error {
+	return s.db.WithContext(ctx).Save(supplier).Error
+}
+
+func (s *Store) GetSupplierByID(ctx context.Context, id int32) (*Supplier, error) {
+	var supplier Supplier
+	if err := s.db.WithContext(ctx).First(&supplier, id).Error; err != nil {
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+func (s *Store) ListSuppliers(ctx context.Context) ([]Supplier, error) {
+	var suppliers []Supplier
+	if err := s.db.WithContext(ctx).Order("name").Find(&suppliers).Error; err != nil {
+		return nil, err
+	}
+	return suppliers, nil
+}
+
+// ------------------ Purchase Orders ------------------
+func (s *Store) CreatePurchaseOrder(ctx context.Context, order *PurchaseOrder) error {
+	// GORM tự tạo các dòng Lines cùng transaction với đơn nhập
+	return s.db.WithContext(ctx).Create(order).Error
 }
 
-func (s *Store) ConfirmPickup(ctx context.Context, branchID, productID int32, productType string, quantity int32) error {
+func (s *Store) GetPurchaseOrderByID(ctx context.Context, id int32) (*PurchaseOrder, error) {
+	var order PurchaseOrder
+	if err := s.db.WithContext(ctx).Preload("Lines").First(&order, id).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (s *Store) ListPurchaseOrders(ctx context.Context, filter PurchaseOrderFilter) ([]PurchaseOrder, error) {
+	query := s.db.WithContext(ctx).Preload("Lines")
+	if filter.SupplierID != 0 {
+		query = query.Where("supplier_id = ?", filter.SupplierID)
+	}
+	if filter.BranchID != 0 {
+		query = query.Where("branch_id = ?", filter.BranchID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	var orders []PurchaseOrder
+	if err := query.Order("created_at DESC").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (s *Store) CancelPurchaseOrder(ctx context.Context, id int32) error {
 	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var stock BranchProduct
+		var order PurchaseOrder
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, id).Error; err != nil {
+			return err
+		}
+		// Chỉ huỷ được khi chưa nhận hàng, hàng đã nhập kho không tự động trả lại
+		if order.Status != PurchaseOrderOrdered {
+			return fmt.Errorf("%w: purchase order %d cannot be cancelled in status %s", ErrInvalidState, id, order.Status)
+		}
+		return tx.Model(&order).Update("status", PurchaseOrderCancelled).Error
+	})
+}
+
+// ReceivePurchaseOrder ghi nhận một lần nhận hàng: cộng tồn kho chi nhánh,
+// cập nhật giá vốn bình quân và trạng thái đơn nhập trong cùng một transaction.
+func (s *Store) ReceivePurchaseOrder(ctx context.Context, purchaseOrderID int32, lines []GoodsReceiptLine, note string) (*GoodsReceipt, PurchaseOrderStatus, error) {
+	var receipt GoodsReceipt
+	var newStatus PurchaseOrderStatus
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var order PurchaseOrder
 		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("branch_id = ? AND product_id = ? AND product_type = ?", branchID, productID, productType).
-			First(&stock).Error; err != nil {
+			Preload("Lines").
+			First(&order, purchaseOrderID).Error; err != nil {
 			return err
 		}
+		if order.Status != PurchaseOrderOrdered && order.Status != PurchaseOrderPartiallyReceived {
+			return fmt.Errorf("%w: purchase order %d cannot receive goods in status %s", ErrInvalidState, purchaseOrderID, order.Status)
+		}
+
+		lineIndex := make(map[int32]int, len(order.Lines))
+		for i, l := range order.Lines {
+			lineIndex[l.ID] = i
+		}
+
+		for i, rl := range lines {
+			idx, ok := lineIndex[rl.PurchaseOrderLineID]
+			if !ok {
+				return fmt.Errorf("%w: line %d does not belong to purchase order %d", ErrInvalidArgument, rl.PurchaseOrderLineID, purchaseOrderID)
+			}
+			poLine := &order.Lines[idx]
+			if poLine.QuantityReceived+rl.Quantity > poLine.QuantityOrdered {
+				return fmt.Errorf("%w: line %d: receiving %d exceeds remaining quantity %d",
+					ErrInvalidArgument, poLine.ID, rl.Quantity, poLine.QuantityOrdered-poLine.QuantityReceived)
+			}
+			if rl.UnitCost <= 0 {
+				rl.UnitCost = poLine.UnitCost
+			}
+			rl.ProductID = poLine.ProductID
+			rl.ProductType = poLine.ProductType
+			rl.VariantID = poLine.VariantID
+			lines[i] = rl
 
-		if stock.ReservedQuantity < quantity {
-			return fmt.Errorf("not enough reserved stock to confirm")
+			poLine.QuantityReceived += rl.Quantity
+			if err := tx.Model(poLine).Update("quantity_received", poLine.QuantityReceived).Error; err != nil {
+				return err
+			}
+
+			if err := receiveIntoBranchStock(tx, order.BranchID, rl.ProductID, rl.ProductType, rl.VariantID, rl.Quantity, rl.UnitCost); err != nil {
+				return err
+			}
 		}
 
-		stock.ReservedQuantity -= quantity
-		// Không cộng lại vào available vì đã lấy ra.
+		receipt = GoodsReceipt{
+			PurchaseOrderID: order.ID,
+			BranchID:        order.BranchID,
+			Note:            note,
+			Lines:           lines,
+		}
+		if err := tx.Create(&receipt).Error; err != nil {
+			return err
+		}
 
-		return tx.Save(&stock).Error
+		newStatus = PurchaseOrderReceived
+		for _, l := range order.Lines {
+			if l.QuantityReceived < l.QuantityOrdered {
+				newStatus = PurchaseOrderPartiallyReceived
+				break
+			}
+		}
+		return tx.Model(&order).Update("status", newStatus).Error
 	})
+	if err != nil {
+		return nil, "", err
+	}
+	return &receipt, newStatus, nil
+}
+
+// receiveIntoBranchStock cộng hàng nhập vào tồn kho chi nhánh (tạo dòng tồn kho nếu chưa có)
+// và tính lại giá vốn bình quân gia quyền trên tổng số lượng đang giữ (kể cả đã đặt trước).
+func receiveIntoBranchStock(tx *gorm.DB, branchID, productID int32, productType string, variantID, quantity int32, unitCost float32) error {
+	var stock BranchProduct
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("branch_id = ? AND product_id = ? AND product_type = ? AND variant_id = ?", branchID, productID, productType, variantID).
+		First(&stock).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return tx.Create(&BranchProduct{
+			BranchID:      branchID,
+			ProductID:     productID,
+			ProductType:   productType,
+			VariantID:     variantID,
+			StockQuantity: quantity,
+			AverageCost:   unitCost,
+		}).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	held := stock.OnHand()
+	if held > 0 {
+		stock.AverageCost = (stock.AverageCost*float32(held) + unitCost*float32(quantity)) / float32(held+quantity)
+	} else {
+		stock.AverageCost = unitCost
+	}
+	stock.StockQuantity += quantity
+	return tx.Save(&stock).Error
 }
 
-func (s *Store) ReleaseReservation(ctx context.Context, branchID, productID int32, productType string, quantity int32) error {
+// ------------------ Bulk Import ------------------
+
+// ImportProducts tạo mới / cập nhật sản phẩm trong một transaction; các dòng đã được service kiểm tra
+func (s *Store) ImportProducts(ctx context.Context, rows []ProductImportRow) error {
 	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var stock BranchProduct
-		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
-			Where("branch_id = ? AND product_id = ? AND product_type = ?", branchID, productID, productType).
-			First(&stock).Error; err != nil {
-			return err
+		for _, r := range rows {
+			if r.ProductID == 0 {
+				var model interface{}
+				switch r.ProductType {
+				case "food":
+					model = &Food{Name: r.Name, Description: r.Description, Price: r.Price, IsAttachable: r.IsAttachable, ImgUrl: r.ImgUrl}
+				case "accessory":
+					model = &Accessory{Name: r.Name, Description: r.Description, Price: r.Price, IsAttachable: r.IsAttachable, ImgUrl: r.ImgUrl}
+				case "medicine":
+					model = &Medicine{Name: r.Name, Description: r.Description, Price: r.Price, IsAttachable: r.IsAttachable, ImgUrl: r.ImgUrl}
+				default:
+					return ErrInvalidProductType
+				}
+				if err := tx.Create(model).Error; err != nil {
+					return fmt.Errorf("row %d: %w", r.Row, err)
+				}
+				continue
+			}
+			model, err := productModel(r.ProductType)
+			if err != nil {
+				return err
+			}
+			previous, err := currentPrice(tx, r.ProductType, r.ProductID, 0)
+			if err != nil {
+				return fmt.Errorf("row %d: %w", r.Row, err)
+			}
+			if previous != r.Price {
+				now := time.Now()
+				change := &PriceChange{ProductID: r.ProductID, ProductType: r.ProductType, Price: r.Price, PreviousPrice: previous,
+					EffectiveAt: now, AppliedAt: &now, Note: "import"}
+				if err := tx.Create(change).Error; err != nil {
+					return fmt.Errorf("row %d: %w", r.Row, err)
+				}
+			}
+			// Dùng map để ghi được cả is_attachable = false; img_url rỗng thì giữ nguyên
+			values := map[string]interface{}{
+				"name":          r.Name,
+				"description":   r.Description,
+				"price":         r.Price,
+				"is_attachable": r.IsAttachable,
+			}
+			if r.ImgUrl != "" {
+				values["img_url"] = r.ImgUrl
+			}
+			if err := tx.Model(model).Where("id = ?", r.ProductID).Updates(values).Error; err != nil {
+				return fmt.Errorf("row %d: %w", r.Row, err)
+			}
 		}
+		return nil
+	})
+}
 
-		if stock.ReservedQuantity < quantity {
-			return fmt.Errorf("not enough reserved stock to release")
+// ImportInventory ghi đè StockQuantity theo từng dòng, tạo dòng BranchProduct nếu chi nhánh chưa có sản phẩm
+func (s *Store) ImportInventory(ctx context.Context, rows []InventoryImportRow) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, r := range rows {
+			bp := BranchProduct{
+				BranchID:      r.BranchID,
+				ProductID:     r.ProductID,
+				ProductType:   r.ProductType,
+				VariantID:     r.VariantID,
+				StockQuantity: r.StockQuantity,
+			}
+			if err := tx.Clauses(clause.OnConflict{
+				DoUpdates: clause.AssignmentColumns([]string{"stock_quantity"}),
+			}).Create(&bp).Error; err != nil {
+				return fmt.Errorf("row %d: %w", r.Row, err)
+			}
 		}
+		return nil
+	})
+}
+
+// ------------------ Promotions ------------------
+// promotionEditableColumns - các cột UpdatePromotion được ghi; số lượt đã dùng và trạng thái bật/tắt
+// do RedeemPromotions/DeactivatePromotion cập nhật nên không bị ghi đè bởi lần sửa đồng thời
+var promotionEditableColumns = []string{
+	"name", "description", "code", "type", "value", "max_discount", "buy_quantity", "get_quantity",
+	"scope", "category", "item_type", "item_id", "min_spend", "starts_at", "ends_at", "usage_limit", "per_customer_limit",
+	"updated_at",
+}
+
+// duplicateCouponCode đổi lỗi trùng unique index mã giảm giá (khi hai request cùng tạo một mã) thành lỗi nghiệp vụ
+func duplicateCouponCode(err error, code CouponCode) error {
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("%w: coupon code %s is already used", ErrInvalidArgument, code)
+	}
+	return err
+}
+
+func (s *Store) CreatePromotion(ctx context.Context, promotion *Promotion) error {
+	return duplicateCouponCode(s.db.WithContext(ctx).Create(promotion).Error, promotion.Code)
+}
+
+func (s *Store) UpdatePromotion(ctx context.Context, promotion *Promotion) error {
+	err := s.db.WithContext(ctx).Model(&Promotion{ID: promotion.ID}).
+		Select(promotionEditableColumns).
+		Updates(promotion).Error
+	return duplicateCouponCode(err, promotion.Code)
+}
+
+func (s *Store) GetPromotionByID(ctx context.Context, id int32) (*Promotion, error) {
+	var promotion Promotion
+	if err := s.db.WithContext(ctx).First(&promotion, id).Error; err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+func (s *Store) GetPromotionByCode(ctx context.Context, code string) (*Promotion, error) {
+	var promotion Promotion
+	if err := s.db.WithContext(ctx).Where("code = ?", code).First(&promotion).Error; err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
 
-		stock.ReservedQuantity -= quantity
-		stock.StockQuantity += quantity
+func (s *Store) DeactivatePromotion(ctx context.Context, id int32) error {
+	result := s.db.WithContext(ctx).Model(&Promotion{}).Where("id = ?", id).Update("is_active", false)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (s *Store) ListPromotions(ctx context.Context, includeInactive bool) ([]Promotion, error) {
+	var promotions []Promotion
+	query := s.db.WithContext(ctx).Order("id")
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+	if err := query.Find(&promotions).Error; err != nil {
+		return nil, err
+	}
+	return promotions, nil
+}
+
+// CountCustomerRedemptions đếm số lượt khách đã dùng từng khuyến mãi
+func (s *Store) CountCustomerRedemptions(ctx context.Context, customerID int32, promotionIDs []int32) (map[int32]int32, error) {
+	var rows []struct {
+		PromotionID int32
+		Count       int32
+	}
+	if err := s.db.WithContext(ctx).Model(&PromotionRedemption{}).
+		Select("promotion_id, COUNT(*) AS count").
+		Where("customer_id = ? AND promotion_id IN ?", customerID, promotionIDs).
+		Group("promotion_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	usage := make(map[int32]int32, len(rows))
+	for _, r := range rows {
+		usage[r.PromotionID] = r.Count
+	}
+	return usage, nil
+}
 
-		return tx.Save(&stock).Error
+func (s *Store) RedeemPromotions(ctx context.Context, customerID int32, applied []AppliedPromotion) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range applied {
+			var promotion Promotion
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&promotion, applied[i].PromotionID).Error; err != nil {
+				return err
+			}
+			if promotion.UsageLimit > 0 && promotion.UsedCount >= promotion.UsageLimit {
+				return fmt.Errorf("%w: promotion %q usage limit reached", ErrInvalidState, promotion.Name)
+			}
+			if promotion.PerCustomerLimit > 0 {
+				var used int64
+				if err := tx.Model(&PromotionRedemption{}).
+					Where("promotion_id = ? AND customer_id = ?", promotion.ID, customerID).
+					Count(&used).Error; err != nil {
+					return err
+				}
+				if used >= int64(promotion.PerCustomerLimit) {
+					return fmt.Errorf("%w: customer usage limit reached for promotion %q", ErrInvalidState, promotion.Name)
+				}
+			}
+
+			redemption := PromotionRedemption{
+				PromotionID: promotion.ID,
+				CustomerID:  customerID,
+				Discount:    applied[i].Discount,
+			}
+			if err := tx.Create(&redemption).Error; err != nil {
+				return err
+			}
+			applied[i].RedemptionID = redemption.ID
+			if err := tx.Model(&promotion).UpdateColumn("used_count", gorm.Expr("used_count + 1")).Error; err != nil {
+				return err
+			}
+		}
+		return nil
 	})
 }
+
+// ReleasePromotionRedemptions xoá các lượt dùng và trả lại lượt cho khuyến mãi
+func (s *Store) ReleasePromotionRedemptions(ctx context.Context, redemptionIDs []int32) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Khoá các lượt dùng để hai lần trả đồng thời (huỷ đơn và sweeper) không trừ used_count hai lần
+		var redemptions []PromotionRedemption
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id IN ?", redemptionIDs).Find(&redemptions).Error; err != nil {
+			return err
+		}
+		for _, r := range redemptions {
+			if err := tx.Model(&Promotion{}).
+				Where("id = ? AND used_count > 0", r.PromotionID).
+				UpdateColumn("used_count", gorm.Expr("used_count - 1")).Error; err != nil {
+				return err
+			}
+		}
+		if len(redemptions) == 0 {
+			return nil
+		}
+		return tx.Delete(&redemptions).Error
+	})
+}
+
+// Đánh giá sản phẩm
+func (s *Store) CreateReview(ctx context.Context, review *Review) error {
+	return s.db.WithContext(ctx).Create(review).Error
+}
+
+func (s *Store) GetReviewByID(ctx context.Context, id int32) (*Review, error) {
+	var review Review
+	if err := s.db.WithContext(ctx).First(&review, id).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+func (s *Store) FindReview(ctx context.Context, customerID, productID int32, productType string) (*Review, error) {
+	var reviews []Review
+	err := s.db.WithContext(ctx).
+		Where("customer_id = ? AND product_id = ? AND product_type = ?", customerID, productID, productType).
+		Limit(1).Find(&reviews).Error
+	if err != nil || len(reviews) == 0 {
+		return nil, err
+	}
+	return &reviews[0], nil
+}
+
+func (s *Store) ListReviews(ctx context.Context, filter ReviewFilter) ([]Review, error) {
+	var reviews []Review
+	query := s.db.WithContext(ctx).Order("id DESC")
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ProductID > 0 {
+		query = query.Where("product_id = ? AND product_type = ?", filter.ProductID, filter.ProductType)
+	}
+	if filter.Page > 0 {
+		query = query.Offset(int((filter.Page - 1) * filter.PageSize)).Limit(int(filter.PageSize))
+	}
+	if err := query.Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+func (s *Store) UpdateReviewModeration(ctx context.Context, review *Review) error {
+	return s.db.WithContext(ctx).Model(review).
+		Select("status", "moderated_by", "moderation_note", "moderated_at").
+		Updates(review).Error
+}
+
+func (s *Store) ReviewSummaries(ctx context.Context) (map[string]ReviewSummary, error) {
+	var rows []struct {
+		ProductID     int32
+		ProductType   string
+		AverageRating float64
+		ReviewCount   int32
+	}
+	err := s.db.WithContext(ctx).Model(&Review{}).
+		Select("product_id, product_type, AVG(rating) AS average_rating, COUNT(*) AS review_count").
+		Where("status = ?", ReviewApproved).
+		Group("product_id, product_type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	summaries := make(map[string]ReviewSummary, len(rows))
+	for _, r := range rows {
+		summaries[stockKey(r.ProductType, r.ProductID)] = ReviewSummary{
+			AverageRating: float32(r.AverageRating),
+			ReviewCount:   r.ReviewCount,
+		}
+	}
+	return summaries, nil
+}
+
+// ------------------ Price History ------------------
+func (s *Store) CreatePriceChange(ctx context.Context, change *PriceChange) error {
+	return s.db.WithContext(ctx).Create(change).Error
+}
+
+func (s *Store) GetPriceChangeByID(ctx context.Context, id int32) (*PriceChange, error) {
+	var change PriceChange
+	if err := s.db.WithContext(ctx).First(&change, id).Error; err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+func (s *Store) ListPriceChanges(ctx context.Context, productType string, productID, variantID int32) ([]PriceChange, error) {
+	var changes []PriceChange
+	err := s.db.WithContext(ctx).
+		Where("product_type = ? AND product_id = ? AND variant_id = ?", productType, productID, variantID).
+		Order("effective_at, id").
+		Find(&changes).Error
+	return changes, err
+}
+
+func (s *Store) DeletePriceChange(ctx context.Context, id int32) error {
+	result := s.db.WithContext(ctx).Where("id = ? AND applied_at IS NULL", id).Delete(&PriceChange{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: price change %d is not scheduled", ErrInvalidState, id)
+	}
+	return nil
+}
+
+// priceRow trả về truy vấn trỏ tới dòng đang lưu giá: biến thể khi variantID != 0, ngược lại là sản phẩm gốc
+func priceRow(tx *gorm.DB, productType string, productID, variantID int32) (*gorm.DB, error) {
+	if variantID != 0 {
+		return tx.Model(&ProductVariant{}).
+			Where("id = ? AND product_id = ? AND product_type = ?", variantID, productID, productType), nil
+	}
+	model, err := productModel(productType)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Model(model).Where("id = ?", productID), nil
+}
+
+func currentPrice(tx *gorm.DB, productType string, productID, variantID int32) (float32, error) {
+	row, err := priceRow(tx, productType, productID, variantID)
+	if err != nil {
+		return 0, err
+	}
+	var prices []float32
+	if err := row.Pluck("price", &prices).Error; err != nil {
+		return 0, err
+	}
+	if len(prices) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return prices[0], nil
+}
+
+func (s *Store) CurrentPrice(ctx context.Context, productType string, productID, variantID int32) (float32, error) {
+	return currentPrice(s.db.WithContext(ctx), productType, productID, variantID)
+}
+
+func (s *Store) ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]PriceChange, error) {
+	var due []PriceChange
+	if err := s.db.WithContext(ctx).
+		Where("applied_at IS NULL AND skipped_at IS NULL AND effective_at <= ?", now).
+		Order("effective_at, id").
+		Limit(limit).
+		Find(&due).Error; err != nil {
+		return nil, err
+	}
+
+	var applied []PriceChange
+	for _, change := range due {
+		// Mỗi thay đổi một transaction; giá vừa bị huỷ sau khi lấy danh sách thì bỏ qua
+		superseded := false
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// Giá đã được sửa trực tiếp (hoặc áp dụng) sau thời điểm hiệu lực: giữ giá mới hơn, bỏ qua giá hẹn trước này
+			var newer int64
+			if err := tx.Model(&PriceChange{}).
+				Where("product_type = ? AND product_id = ? AND variant_id = ? AND applied_at IS NOT NULL AND effective_at > ?",
+					change.ProductType, change.ProductID, change.VariantID, change.EffectiveAt).
+				Count(&newer).Error; err != nil {
+				return err
+			}
+			if newer > 0 {
+				result := tx.Model(&PriceChange{}).Where("id = ? AND applied_at IS NULL AND skipped_at IS NULL", change.ID).Update("skipped_at", now)
+				if result.Error != nil {
+					return result.Error
+				}
+				if result.RowsAffected == 0 {
+					return ErrInvalidState
+				}
+				superseded = true
+				return nil
+			}
+			result := tx.Model(&PriceChange{}).Where("id = ? AND applied_at IS NULL AND skipped_at IS NULL", change.ID).Update("applied_at", now)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrInvalidState
+			}
+			previous, err := currentPrice(tx, change.ProductType, change.ProductID, change.VariantID)
+			if err != nil {
+				return err
+			}
+			row, err := priceRow(tx, change.ProductType, change.ProductID, change.VariantID)
+			if err != nil {
+				return err
+			}
+			if err := row.Update("price", change.Price).Error; err != nil {
+				return err
+			}
+			change.PreviousPrice = previous
+			change.AppliedAt = &now
+			return tx.Model(&PriceChange{}).Where("id = ?", change.ID).Update("previous_price", previous).Error
+		})
+		if errors.Is(err, ErrInvalidState) {
+			continue
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Sản phẩm / biến thể đã bị xoá: đánh dấu bỏ qua để giá này không chặn cả lô ở mọi lần quét sau
+			log.Printf("Skipping price change %d: %s %d variant %d not found", change.ID, change.ProductType, change.ProductID, change.VariantID)
+			if err := s.db.WithContext(ctx).Model(&PriceChange{}).Where("id = ?", change.ID).Update("skipped_at", now).Error; err != nil {
+				return applied, err
+			}
+			continue
+		}
+		if err != nil {
+			return applied, err
+		}
+		if superseded {
+			log.Printf("Skipping price change %d: %s %d variant %d was repriced after %s", change.ID, change.ProductType, change.ProductID, change.VariantID, change.EffectiveAt.Format(time.RFC3339))
+			continue
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
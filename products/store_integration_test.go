@@ -0,0 +1,282 @@
+//go:build integration
+
+package main
+
+// Kiểm thử hồi quy số lượng tồn kho và giữ hàng trên MySQL thật (hoặc MariaDB/TiDB tương thích).
+// Chạy với một database trống dành riêng cho test:
+//
+//	PRODUCTS_TEST_DSN="root:secret@tcp(127.0.0.1:3306)/products_test?parseTime=true" go test -tags integration ./...
+//
+// Mỗi test tạo chi nhánh và sản phẩm riêng nên có thể chạy lại trên cùng database.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	dsn := os.Getenv("PRODUCTS_TEST_DSN")
+	if dsn == "" {
+		t.Skip("PRODUCTS_TEST_DSN is not set")
+	}
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true, Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	if err := migrate(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return NewStore(db)
+}
+
+// seedStock tạo một chi nhánh, một thực phẩm và một phụ kiện với tồn kho cho trước
+func seedStock(t *testing.T, s *Store, foodStock, accessoryStock int32) (branch Branch, food Food, accessory Accessory) {
+	t.Helper()
+	name := fmt.Sprintf("%s-%d", t.Name(), time.Now().UnixNano())
+	branch = Branch{Name: name}
+	food = Food{Name: name + "-food", Price: 10}
+	accessory = Accessory{Name: name + "-accessory", Price: 20}
+	for _, record := range []interface{}{&branch, &food, &accessory} {
+		if err := s.db.Create(record).Error; err != nil {
+			t.Fatalf("seed: %v", err)
+		}
+	}
+	stock := []BranchProduct{
+		{BranchID: branch.ID, ProductID: food.ID, ProductType: "food", StockQuantity: foodStock},
+		{BranchID: branch.ID, ProductID: accessory.ID, ProductType: "accessory", StockQuantity: accessoryStock},
+	}
+	if err := s.db.Create(&stock).Error; err != nil {
+		t.Fatalf("seed stock: %v", err)
+	}
+	return branch, food, accessory
+}
+
+func branchStock(t *testing.T, s *Store, branchID, productID int32, productType string) BranchProduct {
+	t.Helper()
+	var stock BranchProduct
+	if err := s.db.Where("branch_id = ? AND product_id = ? AND product_type = ? AND variant_id = 0", branchID, productID, productType).
+		First(&stock).Error; err != nil {
+		t.Fatalf("load stock: %v", err)
+	}
+	return stock
+}
+
+// availableByListing - số lượng có thể bán của từng sản phẩm theo cả hai RPC danh sách và GetAvailableQuantity
+func availableByListing(t *testing.T, s *Store, branchID int32, productType string, productID int32) (byType, all, quantity int32) {
+	t.Helper()
+	ctx := context.Background()
+	find := func(products []GeneralProduct) int32 {
+		for _, p := range products {
+			if p.ProductType == productType && p.ProductID == productID {
+				return p.AvailableQuantity
+			}
+		}
+		return 0
+	}
+	list, err := s.ListAvailableProductsByBranch(ctx, branchID, productType)
+	if err != nil {
+		t.Fatalf("ListAvailableProductsByBranch: %v", err)
+	}
+	allList, err := s.ListAvailableAllProductsByBranch(ctx, branchID)
+	if err != nil {
+		t.Fatalf("ListAvailableAllProductsByBranch: %v", err)
+	}
+	quantity, err = s.GetAvailableQuantity(ctx, branchID, productType, productID, nil)
+	if err != nil {
+		t.Fatalf("GetAvailableQuantity: %v", err)
+	}
+	return find(list), find(allList), quantity
+}
+
+func assertAvailable(t *testing.T, s *Store, branchID int32, productType string, productID, want int32) {
+	t.Helper()
+	byType, all, quantity := availableByListing(t, s, branchID, productType, productID)
+	if byType != want || all != want || quantity != want {
+		t.Fatalf("%s %d available: by type %d, all %d, quantity %d; want %d", productType, productID, byType, all, quantity, want)
+	}
+}
+
+func TestAvailabilityConsistentAcrossListings(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	branch, food, accessory := seedStock(t, s, 10, 5)
+
+	assertAvailable(t, s, branch.ID, "food", food.ID, 10)
+	assertAvailable(t, s, branch.ID, "accessory", accessory.ID, 5)
+
+	held := &Reservation{BranchID: branch.ID, ProductID: food.ID, ProductType: "food", Quantity: 3}
+	if err := s.ReserveProduct(ctx, held); err != nil {
+		t.Fatalf("ReserveProduct: %v", err)
+	}
+	assertAvailable(t, s, branch.ID, "food", food.ID, 7)
+	if stock := branchStock(t, s, branch.ID, food.ID, "food"); stock.ReservedQuantity != 3 || stock.OnHand() != 10 {
+		t.Fatalf("after reserve: reserved %d, on hand %d; want 3, 10", stock.ReservedQuantity, stock.OnHand())
+	}
+
+	if _, err := s.ReleaseReservation(ctx, held.ID); err != nil {
+		t.Fatalf("ReleaseReservation: %v", err)
+	}
+	assertAvailable(t, s, branch.ID, "food", food.ID, 10)
+
+	picked := &Reservation{BranchID: branch.ID, ProductID: food.ID, ProductType: "food", Quantity: 4}
+	if err := s.ReserveProduct(ctx, picked); err != nil {
+		t.Fatalf("ReserveProduct: %v", err)
+	}
+	if _, err := s.ConfirmReservation(ctx, picked.ID); err != nil {
+		t.Fatalf("ConfirmReservation: %v", err)
+	}
+	assertAvailable(t, s, branch.ID, "food", food.ID, 6)
+	if stock := branchStock(t, s, branch.ID, food.ID, "food"); stock.ReservedQuantity != 0 || stock.OnHand() != 6 {
+		t.Fatalf("after confirm: reserved %d, on hand %d; want 0, 6", stock.ReservedQuantity, stock.OnHand())
+	}
+}
+
+func TestSoldOutProductIsHiddenFromListings(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	branch, food, accessory := seedStock(t, s, 2, 1)
+
+	if err := s.ReserveProduct(ctx, &Reservation{BranchID: branch.ID, ProductID: food.ID, ProductType: "food", Quantity: 2}); err != nil {
+		t.Fatalf("ReserveProduct: %v", err)
+	}
+	all, err := s.ListAvailableAllProductsByBranch(ctx, branch.ID)
+	if err != nil {
+		t.Fatalf("ListAvailableAllProductsByBranch: %v", err)
+	}
+	if len(all) != 1 || all[0].ProductType != "accessory" || all[0].ProductID != accessory.ID {
+		t.Fatalf("listing after food sold out = %+v, want only accessory %d", all, accessory.ID)
+	}
+	foods, err := s.ListAvailableProductsByBranch(ctx, branch.ID, "food")
+	if err != nil {
+		t.Fatalf("ListAvailableProductsByBranch: %v", err)
+	}
+	if len(foods) != 0 {
+		t.Fatalf("food listing = %+v, want empty", foods)
+	}
+}
+
+func TestConcurrentReservationsDoNotOversell(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	const stock, buyers = 10, 25
+	branch, food, _ := seedStock(t, s, stock, 0)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded, shortfalls := 0, 0
+	for i := 0; i < buyers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := s.ReserveProduct(ctx, &Reservation{BranchID: branch.ID, ProductID: food.ID, ProductType: "food", Quantity: 1})
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				succeeded++
+			case errors.Is(err, ErrInvalidState):
+				shortfalls++
+			default:
+				t.Errorf("ReserveProduct: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if succeeded != stock || shortfalls != buyers-stock {
+		t.Fatalf("succeeded %d, short %d; want %d, %d", succeeded, shortfalls, stock, buyers-stock)
+	}
+	got := branchStock(t, s, branch.ID, food.ID, "food")
+	if got.StockQuantity != 0 || got.ReservedQuantity != stock {
+		t.Fatalf("stock %d, reserved %d; want 0, %d", got.StockQuantity, got.ReservedQuantity, stock)
+	}
+	var held int64
+	s.db.Model(&Reservation{}).Where("branch_id = ? AND status = ?", branch.ID, ReservationHeld).Count(&held)
+	if held != stock {
+		t.Fatalf("held reservations %d, want %d", held, stock)
+	}
+}
+
+func TestReserveProductsRollsBackWholeCartOnShortfall(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	branch, food, accessory := seedStock(t, s, 5, 1)
+
+	shortfalls, err := s.ReserveProducts(ctx, []Reservation{
+		{BranchID: branch.ID, ProductID: food.ID, ProductType: "food", Quantity: 3},
+		{BranchID: branch.ID, ProductID: accessory.ID, ProductType: "accessory", Quantity: 2},
+	})
+	if err != nil {
+		t.Fatalf("ReserveProducts: %v", err)
+	}
+	if len(shortfalls) != 1 || shortfalls[0].ProductType != "accessory" || shortfalls[0].Available != 1 {
+		t.Fatalf("shortfalls = %+v, want accessory with 1 available", shortfalls)
+	}
+	assertAvailable(t, s, branch.ID, "food", food.ID, 5)
+	assertAvailable(t, s, branch.ID, "accessory", accessory.ID, 1)
+	var count int64
+	s.db.Model(&Reservation{}).Where("branch_id = ?", branch.ID).Count(&count)
+	if count != 0 {
+		t.Fatalf("reservations after rollback = %d, want 0", count)
+	}
+}
+
+func TestExpireRacesWithConfirm(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+	branch, food, _ := seedStock(t, s, 5, 0)
+
+	expired := time.Now().Add(-time.Minute)
+	reservation := &Reservation{BranchID: branch.ID, ProductID: food.ID, ProductType: "food", Quantity: 2, ExpiresAt: &expired}
+	if err := s.ReserveProduct(ctx, reservation); err != nil {
+		t.Fatalf("ReserveProduct: %v", err)
+	}
+
+	// Sweeper và khách xác nhận lấy hàng cùng lúc: đúng một bên thành công, tồn kho không bị cộng/trừ hai lần
+	var wg sync.WaitGroup
+	var confirmErr, expireErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, confirmErr = s.ConfirmReservation(ctx, reservation.ID)
+	}()
+	go func() {
+		defer wg.Done()
+		_, expireErr = s.ExpireReservations(ctx, time.Now(), reservationSweepBatch)
+	}()
+	wg.Wait()
+	if expireErr != nil {
+		t.Fatalf("ExpireReservations: %v", expireErr)
+	}
+	if confirmErr != nil && !errors.Is(confirmErr, ErrInvalidState) {
+		t.Fatalf("ConfirmReservation: %v", confirmErr)
+	}
+
+	final, err := s.GetReservation(ctx, reservation.ID)
+	if err != nil {
+		t.Fatalf("GetReservation: %v", err)
+	}
+	stock := branchStock(t, s, branch.ID, food.ID, "food")
+	switch final.Status {
+	case ReservationConfirmed:
+		if confirmErr != nil || stock.StockQuantity != 3 || stock.ReservedQuantity != 0 {
+			t.Fatalf("confirmed: err %v, stock %d, reserved %d; want nil, 3, 0", confirmErr, stock.StockQuantity, stock.ReservedQuantity)
+		}
+	case ReservationExpired:
+		if confirmErr == nil || stock.StockQuantity != 5 || stock.ReservedQuantity != 0 {
+			t.Fatalf("expired: err %v, stock %d, reserved %d; want ErrInvalidState, 5, 0", confirmErr, stock.StockQuantity, stock.ReservedQuantity)
+		}
+	default:
+		t.Fatalf("reservation status = %s, want confirmed or expired", final.Status)
+	}
+}
@@ -2,12 +2,16 @@ package main
 
 import (
 	"context"
+	"database/sql/driver"
+	"fmt"
 	"time"
 
 	pb "github.com/quanbin27/commons/genproto/products"
+	"github.com/quanbin27/commons/pricing"
 )
 
-// Food - Bảng thực phẩm cho thú cưng
+// Food - Bảng thực phẩm cho thú cưng. Accessory và Medicine cùng cấu trúc.
+// Xoá sản phẩm chỉ lưu trữ (IsArchived): ẩn khỏi danh sách bán nhưng đơn hàng cũ vẫn tra được theo id.
 type Food struct {
 	ID           int32     `gorm:"primaryKey"`
 	Name         string    `gorm:"size:255;not null"`
@@ -17,6 +21,10 @@ type Food struct {
 	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
 	IsAttachable bool      `gorm:"default:false"`
 	ImgUrl       string
+	SKU          ItemCode `gorm:"size:64;uniqueIndex:idx_foods_unique_sku"`
+	Barcode      ItemCode `gorm:"size:64;uniqueIndex:idx_foods_unique_barcode"`
+	IsArchived   bool     `gorm:"not null;default:false;index"`
+	ArchivedAt   *time.Time
 }
 
 // Accessory - Bảng phụ kiện thú cưng
@@ -29,6 +37,10 @@ type Accessory struct {
 	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
 	IsAttachable bool      `gorm:"default:false"`
 	ImgUrl       string
+	SKU          ItemCode `gorm:"size:64;uniqueIndex:idx_accessories_unique_sku"`
+	Barcode      ItemCode `gorm:"size:64;uniqueIndex:idx_accessories_unique_barcode"`
+	IsArchived   bool     `gorm:"not null;default:false;index"`
+	ArchivedAt   *time.Time
 }
 
 // Medicine - Bảng thuốc thú cưng
@@ -41,23 +53,513 @@ type Medicine struct {
 	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
 	IsAttachable bool      `gorm:"default:false"`
 	ImgUrl       string
+	SKU          ItemCode `gorm:"size:64;uniqueIndex:idx_medicines_unique_sku"`
+	Barcode      ItemCode `gorm:"size:64;uniqueIndex:idx_medicines_unique_barcode"`
+	IsArchived   bool     `gorm:"not null;default:false;index"`
+	ArchivedAt   *time.Time
+}
+
+// ProductVariant - Biến thể của sản phẩm (túi 1kg/5kg, size S/M/L, vị...) có SKU, giá và tồn kho riêng.
+// Sản phẩm gốc (Food/Accessory/Medicine) vẫn dùng để hiển thị trong danh mục; sản phẩm không có biến thể
+// bán và lưu tồn kho trực tiếp với VariantID = 0.
+type ProductVariant struct {
+	ID          int32     `gorm:"primaryKey"`
+	ProductID   int32     `gorm:"index:idx_variant_product;not null"`
+	ProductType string    `gorm:"index:idx_variant_product;size:20;not null"`
+	Name        string    `gorm:"size:255;not null"` // Tên hiển thị, vd "Túi 5kg"
+	SKU         string    `gorm:"size:64;uniqueIndex;not null"`
+	Barcode     ItemCode  `gorm:"size:64;uniqueIndex:idx_product_variants_unique_barcode"`
+	Size        string    `gorm:"size:50"`
+	Weight      string    `gorm:"size:50"`
+	Flavour     string    `gorm:"size:100"`
+	Price       float32   `gorm:"not null"`
+	IsActive    bool      `gorm:"not null;default:true"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
 }
 
 // Branch - Bảng chi nhánh
 type Branch struct {
-	ID       int32  `gorm:"primaryKey"`
-	Name     string `gorm:"size:255;not null"`
-	Location string `gorm:"size:500"`
+	ID        int32   `gorm:"primaryKey"`
+	Name      string  `gorm:"size:255;not null"`
+	Location  string  `gorm:"size:500"`
+	Phone     string  `gorm:"size:50"`
+	Email     string  `gorm:"size:255"`
+	Latitude  float64 `gorm:"default:0"`
+	Longitude float64 `gorm:"default:0"`
+	Timezone  string  `gorm:"size:64;not null;default:'Asia/Ho_Chi_Minh'"` // giờ mở cửa tính theo múi giờ này
+	IsActive  bool    `gorm:"not null;default:true"`
+	// AppointmentCapacity - Số lịch hẹn được phục vụ cùng lúc tại chi nhánh, 0 = không giới hạn
+	AppointmentCapacity int32 `gorm:"not null;default:0"`
+}
+
+// BranchOpeningHour - Một khung giờ mở cửa trong tuần, một ngày có thể có nhiều khung.
+// Ngày không có khung giờ nào là ngày đóng cửa.
+type BranchOpeningHour struct {
+	ID        int32  `gorm:"primaryKey"`
+	BranchID  int32  `gorm:"index;not null"`
+	Weekday   int32  `gorm:"not null"`        // 0 = Chủ nhật ... 6 = Thứ bảy (time.Weekday)
+	OpenTime  string `gorm:"size:5;not null"` // "HH:MM"
+	CloseTime string `gorm:"size:5;not null"` // "HH:MM"
+}
+
+// BranchClosure - Ngày nghỉ của chi nhánh (lễ, sửa chữa...), tính cả StartDate và EndDate.
+// Lưu ngày dạng "YYYY-MM-DD" để không bị lệch múi giờ giữa DB và chi nhánh.
+type BranchClosure struct {
+	ID        int32     `gorm:"primaryKey"`
+	BranchID  int32     `gorm:"index;not null"`
+	StartDate string    `gorm:"size:10;not null"`
+	EndDate   string    `gorm:"size:10;not null"`
+	Reason    string    `gorm:"size:255"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
 }
 
-// BranchProduct - Quản lý tồn kho theo từng chi nhánh
+// BranchProduct - Quản lý tồn kho theo từng chi nhánh, mỗi biến thể một dòng (VariantID = 0 là sản phẩm không có biến thể).
+// ReserveProduct chuyển hàng từ StockQuantity sang ReservedQuantity (tạo một Reservation), ConfirmPickup xuất phần đã giữ,
+// ReleaseReservation hoặc hết hạn giữ thì trả lại StockQuantity. Vì vậy:
+//   - StockQuantity: số lượng có thể bán / đặt thêm (available), không gồm hàng đang giữ
+//   - ReservedQuantity: số lượng đang giữ cho khách, chưa lấy
+//   - OnHand() = StockQuantity + ReservedQuantity: số lượng thực có trong kho
 type BranchProduct struct {
-	BranchID         int32  `gorm:"primaryKey"`
-	ProductID        int32  `gorm:"primaryKey"`
-	ProductType      string `gorm:"primaryKey"` // "food", "accessory", "medicine"
-	StockQuantity    int32  `gorm:"not null"`
-	ReservedQuantity int32  `gorm:"default:0"` // Số lượng đã đặt nhưng chưa giao
+	BranchID         int32   `gorm:"primaryKey"`
+	ProductID        int32   `gorm:"primaryKey"`
+	ProductType      string  `gorm:"primaryKey"` // "food", "accessory", "medicine"
+	VariantID        int32   `gorm:"primaryKey;default:0"`
+	StockQuantity    int32   `gorm:"not null"`
+	ReservedQuantity int32   `gorm:"default:0"` // Số lượng đã đặt nhưng chưa giao
+	AverageCost      float32 `gorm:"default:0"` // Giá vốn bình quân gia quyền, cập nhật khi nhập hàng
+}
+
+// availableQuantitySQL - Biểu thức SQL tương ứng với BranchProduct.Available, dùng khi lọc trong truy vấn
+const availableQuantitySQL = "stock_quantity"
+
+// Available - Số lượng có thể bán / đặt thêm tại chi nhánh, dùng cho mọi danh sách sản phẩm
+func (bp BranchProduct) Available() int32 {
+	return bp.StockQuantity
+}
+
+// OnHand - Số lượng thực có trong kho, gồm cả hàng đang giữ cho khách
+func (bp BranchProduct) OnHand() int32 {
+	return bp.StockQuantity + bp.ReservedQuantity
+}
+
+// --- GIỮ HÀNG ---
+type ReservationStatus string
+
+const (
+	ReservationHeld      ReservationStatus = "held"
+	ReservationConfirmed ReservationStatus = "confirmed"
+	ReservationReleased  ReservationStatus = "released"
+	ReservationExpired   ReservationStatus = "expired"
+)
+
+// Reservation - Một lần giữ hàng tại chi nhánh. Chỉ lần giữ ở trạng thái held mới được xác nhận hoặc trả lại,
+// nên mỗi lần giữ chỉ xuất kho / trả kho đúng một lần.
+type Reservation struct {
+	ID          int32             `gorm:"primaryKey"`
+	BranchID    int32             `gorm:"index:idx_reservation_stock;not null"`
+	ProductID   int32             `gorm:"index:idx_reservation_stock;not null"`
+	ProductType string            `gorm:"index:idx_reservation_stock;size:20;not null"`
+	VariantID   int32             `gorm:"index:idx_reservation_stock;not null;default:0"`
+	Quantity    int32             `gorm:"not null"`
+	OrderID     *int32            `gorm:"index"`
+	Status      ReservationStatus `gorm:"type:varchar(20);not null;default:'held';index:idx_reservation_expiry"`
+	ExpiresAt   *time.Time        `gorm:"index:idx_reservation_expiry"` // nil = giữ đến khi xác nhận hoặc huỷ
+	CreatedAt   time.Time         `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time         `gorm:"autoUpdateTime"`
+}
+
+// --- ĐÁNH GIÁ SẢN PHẨM ---
+type ReviewStatus string
+
+const (
+	ReviewPending  ReviewStatus = "pending"
+	ReviewApproved ReviewStatus = "approved"
+	ReviewRejected ReviewStatus = "rejected"
+)
+
+// Review - Đánh giá của khách cho sản phẩm đã mua, mỗi khách đánh giá một sản phẩm một lần.
+// Chỉ đánh giá đã được nhân viên duyệt mới hiển thị và được tính vào điểm trung bình.
+type Review struct {
+	ID             int32        `gorm:"primaryKey"`
+	ProductID      int32        `gorm:"uniqueIndex:idx_review_customer_product;index:idx_review_product;not null"`
+	ProductType    string       `gorm:"uniqueIndex:idx_review_customer_product;index:idx_review_product;size:20;not null"`
+	CustomerID     int32        `gorm:"uniqueIndex:idx_review_customer_product;not null"`
+	OrderID        int32        `gorm:"not null"` // đơn COMPLETED chứa sản phẩm, bên Orders service
+	Rating         int32        `gorm:"not null"` // 1-5 sao
+	Comment        string       `gorm:"type:text"`
+	Status         ReviewStatus `gorm:"type:varchar(20);not null;default:'pending';index"`
+	ModeratedBy    int32
+	ModerationNote string `gorm:"size:500"`
+	ModeratedAt    *time.Time
+	CreatedAt      time.Time `gorm:"autoCreateTime"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime"`
+}
+
+// ReviewSummary - Điểm trung bình và số đánh giá đã duyệt của một sản phẩm
+type ReviewSummary struct {
+	AverageRating float32
+	ReviewCount   int32
+}
+
+// ReviewFilter - Điều kiện lọc đánh giá cho nhân viên kiểm duyệt, giá trị rỗng = không lọc
+type ReviewFilter struct {
+	Status      ReviewStatus
+	ProductID   int32
+	ProductType string
+	Page        int32 // bắt đầu từ 1; 0 = không phân trang
+	PageSize    int32
+}
+
+// PriceChange - Lịch sử giá của sản phẩm (VariantID = 0) hoặc biến thể. Giá sửa trực tiếp được ghi với
+// AppliedAt = thời điểm sửa; giá hẹn trước có AppliedAt = nil cho tới khi bộ hẹn giờ áp dụng vào sản phẩm.
+type PriceChange struct {
+	ID            int32     `gorm:"primaryKey"`
+	ProductID     int32     `gorm:"index:idx_price_change_product;not null"`
+	ProductType   string    `gorm:"index:idx_price_change_product;size:20;not null"`
+	VariantID     int32     `gorm:"index:idx_price_change_product;not null;default:0"`
+	Price         float32   `gorm:"not null"`
+	PreviousPrice float32   // giá ngay trước khi thay đổi có hiệu lực, ghi lúc áp dụng
+	EffectiveAt   time.Time `gorm:"index;not null"`
+	AppliedAt     *time.Time
+	SkippedAt     *time.Time // giá hẹn trước đến hạn nhưng sản phẩm / biến thể không còn, bộ hẹn giờ bỏ qua
+	CreatedBy     int32
+	Note          string    `gorm:"size:255"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// Status - "scheduled" khi chưa áp dụng, "applied" khi đã áp dụng, "skipped" khi không áp dụng được
+func (c *PriceChange) Status() string {
+	switch {
+	case c.AppliedAt != nil:
+		return "applied"
+	case c.SkippedAt != nil:
+		return "skipped"
+	}
+	return "scheduled"
+}
+
+func (c PriceChange) PriceChange() pricing.Change {
+	return pricing.Change{Price: c.Price, PreviousPrice: c.PreviousPrice, EffectiveAt: c.EffectiveAt, AppliedAt: c.AppliedAt, Skipped: c.SkippedAt != nil}
+}
+
+// ProductCode - Chủ sở hữu của một SKU / mã vạch: sản phẩm gốc (VariantID = 0) hoặc biến thể
+type ProductCode struct {
+	ProductType string
+	ProductID   int32
+	VariantID   int32
+}
+
+// ProductLookup - Kết quả tra cứu theo mã vạch / SKU cho bán tại quầy
+type ProductLookup struct {
+	Product GeneralProduct // AvailableQuantity là số lượng có thể bán tại chi nhánh
+	Variant *ProductVariant
+	Price   float32
+	SKU     string
+	Barcode string
+}
+
+// ReserveItem - Một dòng cần giữ hàng trong ReserveProducts
+type ReserveItem struct {
+	ProductID   int32
+	ProductType string
+	VariantID   int32
+	Quantity    int32
+}
+
+// ReservationShortfall - Sản phẩm không đủ hàng khi giữ cả giỏ, Requested là tổng số lượng yêu cầu của sản phẩm
+type ReservationShortfall struct {
+	ProductID   int32
+	ProductType string
+	VariantID   int32
+	Requested   int32
+	Available   int32
+}
+
+// SearchQuery - Điều kiện tìm kiếm sản phẩm cho storefront
+type SearchQuery struct {
+	Keyword         string
+	ProductTypes    []string
+	MinPrice        float32 // 0 = không giới hạn
+	MaxPrice        float32 // 0 = không giới hạn
+	IsAttachable    *bool
+	InStockBranchID int32 // > 0: chỉ lấy sản phẩm còn hàng tại chi nhánh
+	SortBy          string
+	Page            int32
+	PageSize        int32
+}
+
+type FacetCount struct {
+	Value string
+	Count int32
+}
+
+type SearchFacets struct {
+	ProductTypes []FacetCount
+	Attachable   []FacetCount
+	PriceRanges  []FacetCount
+}
+
+type SearchResult struct {
+	Products []GeneralProduct
+	Total    int64
+	Facets   SearchFacets
+}
+
+// --- TRẠNG THÁI ĐƠN NHẬP HÀNG ---
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderOrdered           PurchaseOrderStatus = "ordered"
+	PurchaseOrderPartiallyReceived PurchaseOrderStatus = "partially_received"
+	PurchaseOrderReceived          PurchaseOrderStatus = "received"
+	PurchaseOrderCancelled         PurchaseOrderStatus = "cancelled"
+)
+
+// Supplier - Bảng nhà cung cấp
+type Supplier struct {
+	ID          int32     `gorm:"primaryKey"`
+	Name        string    `gorm:"size:255;not null"`
+	ContactName string    `gorm:"size:255"`
+	Phone       string    `gorm:"size:50"`
+	Email       string    `gorm:"size:255"`
+	Address     string    `gorm:"size:500"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// PurchaseOrder - Đơn nhập hàng từ nhà cung cấp cho một chi nhánh
+type PurchaseOrder struct {
+	ID           int32               `gorm:"primaryKey"`
+	SupplierID   int32               `gorm:"index;not null"`
+	BranchID     int32               `gorm:"index;not null"`
+	Status       PurchaseOrderStatus `gorm:"type:varchar(20);not null;default:'ordered'"`
+	ExpectedDate *time.Time
+	Note         string              `gorm:"size:500"`
+	TotalCost    float32             `gorm:"not null"`
+	CreatedAt    time.Time           `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time           `gorm:"autoUpdateTime"`
+	Lines        []PurchaseOrderLine `gorm:"foreignKey:PurchaseOrderID"`
+}
+
+// PurchaseOrderLine - Dòng sản phẩm trong đơn nhập hàng
+type PurchaseOrderLine struct {
+	ID               int32   `gorm:"primaryKey"`
+	PurchaseOrderID  int32   `gorm:"index;not null"`
+	ProductID        int32   `gorm:"not null"`
+	ProductType      string  `gorm:"size:20;not null"`
+	VariantID        int32   `gorm:"not null;default:0"`
+	QuantityOrdered  int32   `gorm:"not null"`
+	QuantityReceived int32   `gorm:"not null;default:0"`
+	UnitCost         float32 `gorm:"not null"`
+}
+
+// GoodsReceipt - Phiếu nhập kho, mỗi lần nhận hàng (có thể nhận một phần) tạo một phiếu
+type GoodsReceipt struct {
+	ID              int32              `gorm:"primaryKey"`
+	PurchaseOrderID int32              `gorm:"index;not null"`
+	BranchID        int32              `gorm:"index;not null"`
+	Note            string             `gorm:"size:500"`
+	ReceivedAt      time.Time          `gorm:"autoCreateTime"`
+	Lines           []GoodsReceiptLine `gorm:"foreignKey:GoodsReceiptID"`
+}
+
+// GoodsReceiptLine - Số lượng và giá vốn thực nhận của một dòng đơn nhập
+type GoodsReceiptLine struct {
+	ID                  int32   `gorm:"primaryKey"`
+	GoodsReceiptID      int32   `gorm:"index;not null"`
+	PurchaseOrderLineID int32   `gorm:"index;not null"`
+	ProductID           int32   `gorm:"not null"`
+	ProductType         string  `gorm:"size:20;not null"`
+	VariantID           int32   `gorm:"not null;default:0"`
+	Quantity            int32   `gorm:"not null"`
+	UnitCost            float32 `gorm:"not null"`
+}
+
+// PurchaseOrderFilter - Điều kiện lọc đơn nhập hàng, giá trị 0/rỗng là không lọc
+type PurchaseOrderFilter struct {
+	SupplierID int32
+	BranchID   int32
+	Status     PurchaseOrderStatus
+}
+
+// --- KHUYẾN MÃI ---
+type PromotionType string
+
+const (
+	PromotionPercentage PromotionType = "percentage"
+	PromotionFixed      PromotionType = "fixed"
+	PromotionBuyXGetY   PromotionType = "buy_x_get_y"
+)
+
+type PromotionScope string
+
+const (
+	PromotionScopeAll      PromotionScope = "all"
+	PromotionScopeCategory PromotionScope = "category"
+	PromotionScopeProduct  PromotionScope = "product"
+)
+
+// CouponCode - Mã giảm giá, lưu NULL khi rỗng để unique index chỉ ràng buộc các mã thật
+// (MySQL cho phép nhiều dòng NULL trong unique index).
+type CouponCode string
+
+func (c CouponCode) Value() (driver.Value, error) {
+	if c == "" {
+		return nil, nil
+	}
+	return string(c), nil
+}
+
+func (c *CouponCode) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*c = ""
+	case string:
+		*c = CouponCode(v)
+	case []byte:
+		*c = CouponCode(v)
+	default:
+		return fmt.Errorf("cannot scan %T into CouponCode", value)
+	}
+	return nil
+}
+
+// ItemCode - SKU / mã vạch của sản phẩm, lưu NULL khi rỗng như CouponCode để unique index
+// chỉ ràng buộc các mã thật (sản phẩm không bắt buộc có mã).
+type ItemCode string
+
+func (c ItemCode) Value() (driver.Value, error) {
+	if c == "" {
+		return nil, nil
+	}
+	return string(c), nil
+}
+
+func (c *ItemCode) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*c = ""
+	case string:
+		*c = ItemCode(v)
+	case []byte:
+		*c = ItemCode(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ItemCode", value)
+	}
+	return nil
+}
+
+// Promotion - Bảng khuyến mãi. Code rỗng là khuyến mãi tự động áp dụng cho mọi giỏ hàng đủ điều kiện,
+// ngược lại khách phải nhập mã (coupon) mới được áp dụng.
+type Promotion struct {
+	ID               int32          `gorm:"primaryKey"`
+	Name             string         `gorm:"size:255;not null"`
+	Description      string         `gorm:"size:500"`
+	Code             CouponCode     `gorm:"size:50;uniqueIndex:idx_promotions_coupon_code"` // lưu dạng in hoa, NULL khi rỗng
+	Type             PromotionType  `gorm:"type:varchar(20);not null"`
+	Value            float32        `gorm:"not null;default:0"`
+	MaxDiscount      float32        `gorm:"not null;default:0"`
+	BuyQuantity      int32          `gorm:"not null;default:0"`
+	GetQuantity      int32          `gorm:"not null;default:0"`
+	Scope            PromotionScope `gorm:"type:varchar(20);not null;default:'all'"`
+	Category         string         `gorm:"size:20"`
+	ItemType         string         `gorm:"size:20"`
+	ItemID           int32
+	MinSpend         float32 `gorm:"not null;default:0"`
+	StartsAt         *time.Time
+	EndsAt           *time.Time
+	UsageLimit       int32     `gorm:"not null;default:0"`
+	PerCustomerLimit int32     `gorm:"not null;default:0"`
+	UsedCount        int32     `gorm:"not null;default:0"`
+	IsActive         bool      `gorm:"not null;default:true"`
+	CreatedAt        time.Time `gorm:"autoCreateTime"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime"`
+}
+
+// PromotionRedemption - Một lượt dùng khuyến mãi của khách hàng, dùng để kiểm tra giới hạn lượt dùng
+type PromotionRedemption struct {
+	ID          int32     `gorm:"primaryKey"`
+	PromotionID int32     `gorm:"index;not null"`
+	CustomerID  int32     `gorm:"index;not null"`
+	Discount    float32   `gorm:"not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+// CartItem - Một dòng trong giỏ hàng cần tính giá, ItemType là loại sản phẩm hoặc "service"
+type CartItem struct {
+	ItemType  string
+	ItemID    int32
+	Quantity  int32
+	UnitPrice float32
+}
+
+type AppliedPromotion struct {
+	PromotionID  int32
+	Code         string
+	Name         string
+	Discount     float32
+	RedemptionID int32
+}
+
+type CartPricing struct {
+	Subtotal float32
+	Discount float32
+	Total    float32
+	Applied  []AppliedPromotion
 }
+
+// ProductImage - Ảnh của sản phẩm, một sản phẩm có nhiều ảnh; ảnh chính được đồng bộ vào ImgUrl
+type ProductImage struct {
+	ID           int32     `gorm:"primaryKey"`
+	ProductID    int32     `gorm:"index:idx_product_image;not null"`
+	ProductType  string    `gorm:"index:idx_product_image;size:20;not null"`
+	URL          string    `gorm:"size:500;not null"`
+	ThumbnailURL string    `gorm:"size:500"`
+	StorageKey   string    `gorm:"size:255"`
+	ThumbnailKey string    `gorm:"size:255"`
+	Position     int32     `gorm:"not null;default:0"`
+	IsPrimary    bool      `gorm:"default:false"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// ProductImportRow - Một dòng trong file nhập sản phẩm, ProductID = 0 là tạo mới
+type ProductImportRow struct {
+	Row          int32
+	ProductType  string
+	ProductID    int32
+	Name         string
+	Description  string
+	Price        float32
+	IsAttachable bool
+	ImgUrl       string
+}
+
+// InventoryImportRow - Một dòng trong file nhập tồn kho, ghi đè StockQuantity của sản phẩm tại chi nhánh
+type InventoryImportRow struct {
+	Row           int32
+	BranchID      int32
+	ProductType   string
+	ProductID     int32
+	VariantID     int32 // 0 = sản phẩm không có biến thể
+	StockQuantity int32
+}
+
+type ImportRowError struct {
+	Row     int32
+	Field   string
+	Message string
+}
+
+// ImportResult - Báo cáo nhập hàng loạt; Created/Updated là số dòng sẽ (hoặc đã) tạo mới/cập nhật
+type ImportResult struct {
+	TotalRows int32
+	Created   int32
+	Updated   int32
+	Errors    []ImportRowError
+	Applied   bool
+}
+
 type GeneralProduct struct {
 	Name              string
 	Description       string
@@ -67,6 +569,11 @@ type GeneralProduct struct {
 	ProductType       string
 	IsAttachable      bool
 	AvailableQuantity int32
+	SKU               string
+	Barcode           string
+	AverageRating     float32
+	ReviewCount       int32
+	IsArchived        bool
 }
 type ProductWithStock struct {
 	Name         string
@@ -108,19 +615,109 @@ type ProductStore interface {
 
 	// Chi nhánh
 	GetBranchByID(ctx context.Context, id int32) (*Branch, error)
-	ListBranches(ctx context.Context) ([]Branch, error)
+	ListBranches(ctx context.Context, includeInactive bool) ([]Branch, error)
+	CreateBranch(ctx context.Context, branch *Branch) error
+	UpdateBranch(ctx context.Context, branch *Branch) error
+	DeactivateBranch(ctx context.Context, id int32) error
+	SetBranchOpeningHours(ctx context.Context, branchID int32, hours []BranchOpeningHour) error
+	GetBranchOpeningHours(ctx context.Context, branchID int32) ([]BranchOpeningHour, error)
+	AddBranchClosure(ctx context.Context, closure *BranchClosure) error
+	ListBranchClosures(ctx context.Context, branchID int32, fromDate string) ([]BranchClosure, error)
+	DeleteBranchClosure(ctx context.Context, id int32) error
 
 	// Tồn kho
 	GetBranchInventory(ctx context.Context, branchID int32) ([]BranchProduct, error)
-	UpdateBranchInventory(ctx context.Context, branchID int32, productID int32, productType string, stockQuantity int32) error
+	UpdateBranchInventory(ctx context.Context, branchID int32, productID int32, productType string, variantID int32, stockQuantity int32) error
 	ListAttachableProducts(ctx context.Context) ([]GeneralProduct, error)
 	ListAllProducts(ctx context.Context) ([]GeneralProduct, error)
 	ListAvailableProductsByBranch(ctx context.Context, branchID int32, productType string) ([]GeneralProduct, error)
 	ListAvailableAllProductsByBranch(ctx context.Context, branchID int32) ([]GeneralProduct, error)
 	ListAllProductsWithStock(ctx context.Context) ([]ProductWithStock, error)
-	ReleaseReservation(ctx context.Context, branchID, productID int32, productType string, quantity int32) error
-	ConfirmPickup(ctx context.Context, branchID, productID int32, productType string, quantity int32) error
-	ReserveProduct(ctx context.Context, branchID, productID int32, productType string, quantity int32) error
+
+	// Biến thể sản phẩm
+	CreateVariant(ctx context.Context, variant *ProductVariant) error
+	UpdateVariant(ctx context.Context, variant *ProductVariant) error
+	GetVariantByID(ctx context.Context, id int32) (*ProductVariant, error)
+	ListVariants(ctx context.Context, productID int32, productType string, includeInactive bool) ([]ProductVariant, error)
+	ListVariantsByIDs(ctx context.Context, ids []int32) ([]ProductVariant, error)
+	DeactivateVariant(ctx context.Context, id int32) error
+	// FindByCode tìm sản phẩm và biến thể có SKU hoặc mã vạch bằng code
+	FindByCode(ctx context.Context, code string) ([]ProductCode, error)
+	GetGeneralProduct(ctx context.Context, productType string, productID int32) (*GeneralProduct, error)
+	// RestoreProduct bỏ lưu trữ sản phẩm đã xoá, ListArchivedProducts liệt kê các sản phẩm đang lưu trữ
+	RestoreProduct(ctx context.Context, productType string, productID int32) error
+	ListArchivedProducts(ctx context.Context) ([]GeneralProduct, error)
+	// GetAvailableQuantity trả về số lượng có thể bán tại chi nhánh của một biến thể, variantID = nil là tổng mọi dòng của sản phẩm
+	GetAvailableQuantity(ctx context.Context, branchID int32, productType string, productID int32, variantID *int32) (int32, error)
+	// CountActiveVariants đếm số biến thể đang bán của mỗi sản phẩm, key là stockKey(productType, productID)
+	CountActiveVariants(ctx context.Context, productType string, productIDs []int32) (map[string]int32, error)
+
+	// Giữ hàng
+	ReserveProduct(ctx context.Context, reservation *Reservation) error
+	// ReserveProducts giữ tất cả reservations (cùng chi nhánh) trong một transaction, thiếu hàng thì không giữ gì và trả về shortfalls
+	ReserveProducts(ctx context.Context, reservations []Reservation) ([]ReservationShortfall, error)
+	GetReservation(ctx context.Context, id int32) (*Reservation, error)
+	ConfirmReservation(ctx context.Context, id int32) (*Reservation, error)
+	ReleaseReservation(ctx context.Context, id int32) (*Reservation, error)
+	AssignReservations(ctx context.Context, ids []int32, orderID int32, expiresAt *time.Time) error
+	// ExpireReservations trả lại kho các lần giữ đã quá hạn, trả về số lần giữ được trả
+	ExpireReservations(ctx context.Context, now time.Time, limit int) (int, error)
+
+	// Ảnh sản phẩm
+	// AddProductImages thêm các ảnh của cùng một sản phẩm, tất cả hoặc không ảnh nào
+	AddProductImages(ctx context.Context, images []ProductImage) error
+	ListProductImages(ctx context.Context, productID int32, productType string) ([]ProductImage, error)
+	DeleteProductImage(ctx context.Context, id int32) (*ProductImage, error)
+
+	// Nhà cung cấp & đơn nhập hàng
+	CreateSupplier(ctx context.Context, supplier *Supplier) error
+	UpdateSupplier(ctx context.Context, supplier *Supplier) error
+	GetSupplierByID(ctx context.Context, id int32) (*Supplier, error)
+	ListSuppliers(ctx context.Context) ([]Supplier, error)
+	CreatePurchaseOrder(ctx context.Context, order *PurchaseOrder) error
+	GetPurchaseOrderByID(ctx context.Context, id int32) (*PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, filter PurchaseOrderFilter) ([]PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, id int32) error
+	ReceivePurchaseOrder(ctx context.Context, purchaseOrderID int32, lines []GoodsReceiptLine, note string) (*GoodsReceipt, PurchaseOrderStatus, error)
+
+	// Nhập hàng loạt, mỗi lần nhập chạy trong một transaction
+	ImportProducts(ctx context.Context, rows []ProductImportRow) error
+	ImportInventory(ctx context.Context, rows []InventoryImportRow) error
+
+	// Khuyến mãi
+	CreatePromotion(ctx context.Context, promotion *Promotion) error
+	UpdatePromotion(ctx context.Context, promotion *Promotion) error
+	GetPromotionByID(ctx context.Context, id int32) (*Promotion, error)
+	GetPromotionByCode(ctx context.Context, code string) (*Promotion, error)
+	DeactivatePromotion(ctx context.Context, id int32) error
+	ListPromotions(ctx context.Context, includeInactive bool) ([]Promotion, error)
+	CountCustomerRedemptions(ctx context.Context, customerID int32, promotionIDs []int32) (map[int32]int32, error)
+	// RedeemPromotions khoá các khuyến mãi, kiểm tra lại giới hạn lượt dùng rồi ghi nhận lượt dùng,
+	// RedemptionID của từng phần tử trong applied được gán sau khi ghi
+	RedeemPromotions(ctx context.Context, customerID int32, applied []AppliedPromotion) error
+	ReleasePromotionRedemptions(ctx context.Context, redemptionIDs []int32) error
+
+	// Đánh giá sản phẩm
+	CreateReview(ctx context.Context, review *Review) error
+	GetReviewByID(ctx context.Context, id int32) (*Review, error)
+	// FindReview trả về đánh giá của khách cho sản phẩm, nil nếu chưa có
+	FindReview(ctx context.Context, customerID, productID int32, productType string) (*Review, error)
+	ListReviews(ctx context.Context, filter ReviewFilter) ([]Review, error)
+	UpdateReviewModeration(ctx context.Context, review *Review) error
+	// ReviewSummaries tính điểm các đánh giá đã duyệt, key là stockKey(productType, productID)
+	ReviewSummaries(ctx context.Context) (map[string]ReviewSummary, error)
+
+	// Lịch sử giá
+	CreatePriceChange(ctx context.Context, change *PriceChange) error
+	GetPriceChangeByID(ctx context.Context, id int32) (*PriceChange, error)
+	// ListPriceChanges - Theo thời điểm hiệu lực tăng dần
+	ListPriceChanges(ctx context.Context, productType string, productID, variantID int32) ([]PriceChange, error)
+	// DeletePriceChange chỉ xoá giá hẹn trước chưa áp dụng
+	DeletePriceChange(ctx context.Context, id int32) error
+	// CurrentPrice - Giá đang lưu trên sản phẩm, hoặc trên biến thể khi variantID != 0
+	CurrentPrice(ctx context.Context, productType string, productID, variantID int32) (float32, error)
+	// ApplyDuePriceChanges áp dụng tối đa limit giá hẹn trước đã đến hạn, trả về các thay đổi đã áp dụng
+	ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]PriceChange, error)
 }
 
 // ProductService Interface - Implement business logic with internal types
@@ -128,39 +725,105 @@ type ProductService interface {
 	// Thực phẩm
 	GetFoodByID(ctx context.Context, id int32) (*Food, error)
 	ListFoods(ctx context.Context) ([]Food, error)
-	CreateFood(ctx context.Context, name, description string, price float32) (string, error)
-	UpdateFood(ctx context.Context, id int32, name, description string, price float32) (string, error)
+	CreateFood(ctx context.Context, name, description string, price float32, imgURL, sku, barcode string) (string, error)
+	UpdateFood(ctx context.Context, id int32, name, description string, price float32, imgURL, sku, barcode string) (string, error)
 	DeleteFood(ctx context.Context, id int32) (string, error)
 
 	// Phụ kiện
 	GetAccessoryByID(ctx context.Context, id int32) (*Accessory, error)
 	ListAccessories(ctx context.Context) ([]Accessory, error)
-	CreateAccessory(ctx context.Context, name, description string, price float32) (string, error)
-	UpdateAccessory(ctx context.Context, id int32, name, description string, price float32) (string, error)
+	CreateAccessory(ctx context.Context, name, description string, price float32, imgURL, sku, barcode string) (string, error)
+	UpdateAccessory(ctx context.Context, id int32, name, description string, price float32, imgURL, sku, barcode string) (string, error)
 	DeleteAccessory(ctx context.Context, id int32) (string, error)
 
 	// Thuốc
 	GetMedicineByID(ctx context.Context, id int32) (*Medicine, error)
 	ListMedicines(ctx context.Context) ([]Medicine, error)
-	CreateMedicine(ctx context.Context, name, description string, price float32) (string, error)
-	UpdateMedicine(ctx context.Context, id int32, name, description string, price float32) (string, error)
+	CreateMedicine(ctx context.Context, name, description string, price float32, imgURL, sku, barcode string) (string, error)
+	UpdateMedicine(ctx context.Context, id int32, name, description string, price float32, imgURL, sku, barcode string) (string, error)
 	DeleteMedicine(ctx context.Context, id int32) (string, error)
 
 	// Chi nhánh
 	GetBranchByID(ctx context.Context, id int32) (*Branch, error)
-	ListBranches(ctx context.Context) ([]Branch, error)
+	ListBranches(ctx context.Context, includeInactive bool) ([]Branch, error)
+	CreateBranch(ctx context.Context, branch *Branch) (int32, string, error)
+	UpdateBranch(ctx context.Context, branch *Branch) (string, error)
+	DeactivateBranch(ctx context.Context, id int32) (string, error)
+	SetBranchOpeningHours(ctx context.Context, branchID int32, hours []BranchOpeningHour) (string, error)
+	GetBranchSchedule(ctx context.Context, branchID int32) (*Branch, []BranchOpeningHour, []BranchClosure, error)
+	AddBranchClosure(ctx context.Context, closure *BranchClosure) (*BranchClosure, error)
+	DeleteBranchClosure(ctx context.Context, id int32) (string, error)
+	CheckBranchOpen(ctx context.Context, branchID int32, at time.Time, duration time.Duration) (bool, string, error)
 
 	// Tồn kho
 	GetBranchInventory(ctx context.Context, branchID int32) ([]BranchProduct, error)
-	UpdateBranchInventory(ctx context.Context, branchID, productID int32, productType string, stockQuantity int32) (string, error)
+	UpdateBranchInventory(ctx context.Context, branchID, productID int32, productType string, variantID, stockQuantity int32) (string, error)
 	ListAttachableProducts(ctx context.Context) ([]GeneralProduct, error)
 	ListAllProducts(ctx context.Context) ([]GeneralProduct, error)
 	ListAvailableProductsByBranch(ctx context.Context, branchID int32, productType string) ([]GeneralProduct, error)
 	ListAvailableAllProductsByBranch(ctx context.Context, branchID int32) ([]GeneralProduct, error)
 	ListAllProductsWithStock(ctx context.Context) ([]ProductWithStock, error)
-	ReserveProduct(ctx context.Context, branchID, productID int32, productType string, quantity int32) error
-	ConfirmPickup(ctx context.Context, branchID, productID int32, productType string, quantity int32) error
-	ReleaseReservation(ctx context.Context, branchID, productID int32, productType string, quantity int32) error
+	SearchProducts(ctx context.Context, query SearchQuery) (*SearchResult, error)
+	GetProductByBarcode(ctx context.Context, code string, branchID int32) (*ProductLookup, error)
+	RestoreProduct(ctx context.Context, productType string, productID int32) (string, error)
+	ListArchivedProducts(ctx context.Context) ([]GeneralProduct, error)
+
+	// Ảnh sản phẩm
+	AddProductImages(ctx context.Context, images []ProductImage) ([]ProductImage, error)
+	ListProductImages(ctx context.Context, productID int32, productType string) ([]ProductImage, error)
+	DeleteProductImage(ctx context.Context, id int32) (*ProductImage, error)
+
+	// Biến thể sản phẩm
+	CreateVariant(ctx context.Context, variant *ProductVariant) (int32, string, error)
+	UpdateVariant(ctx context.Context, variant *ProductVariant) (string, error)
+	GetVariant(ctx context.Context, id int32) (*ProductVariant, error)
+	ListVariants(ctx context.Context, productID int32, productType string, includeInactive bool) ([]ProductVariant, error)
+	DeactivateVariant(ctx context.Context, id int32) (string, error)
+
+	// Giữ hàng
+	ReserveProduct(ctx context.Context, branchID, productID int32, productType string, variantID, quantity, orderID int32, ttl time.Duration) (*Reservation, error)
+	ReserveProducts(ctx context.Context, branchID int32, items []ReserveItem, orderID int32, ttl time.Duration) ([]Reservation, []ReservationShortfall, error)
+	GetReservation(ctx context.Context, id int32) (*Reservation, error)
+	ConfirmPickup(ctx context.Context, reservationID int32) (*Reservation, error)
+	ReleaseReservation(ctx context.Context, reservationID int32) (*Reservation, error)
+	AssignReservations(ctx context.Context, reservationIDs []int32, orderID int32, expiresAt *time.Time) (string, error)
+	ReleaseExpiredReservations(ctx context.Context) (int, error)
+
+	// Nhà cung cấp & đơn nhập hàng
+	CreateSupplier(ctx context.Context, supplier *Supplier) (int32, string, error)
+	UpdateSupplier(ctx context.Context, supplier *Supplier) (string, error)
+	ListSuppliers(ctx context.Context) ([]Supplier, error)
+	CreatePurchaseOrder(ctx context.Context, supplierID, branchID int32, expectedDate *time.Time, note string, lines []PurchaseOrderLine) (int32, string, error)
+	GetPurchaseOrder(ctx context.Context, id int32) (*PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, filter PurchaseOrderFilter) ([]PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, id int32) (string, error)
+	ReceivePurchaseOrder(ctx context.Context, purchaseOrderID int32, lines []GoodsReceiptLine, note string) (int32, PurchaseOrderStatus, error)
+
+	// Nhập hàng loạt
+	ImportProducts(ctx context.Context, rows []ProductImportRow, dryRun bool) (*ImportResult, error)
+	ImportInventory(ctx context.Context, rows []InventoryImportRow, dryRun bool) (*ImportResult, error)
+
+	// Khuyến mãi
+	CreatePromotion(ctx context.Context, promotion *Promotion) (int32, string, error)
+	UpdatePromotion(ctx context.Context, promotion *Promotion) (string, error)
+	DeactivatePromotion(ctx context.Context, id int32) (string, error)
+	ListPromotions(ctx context.Context, includeInactive bool) ([]Promotion, error)
+	PriceCart(ctx context.Context, customerID int32, items []CartItem, couponCodes []string) (*CartPricing, error)
+	RedeemPromotions(ctx context.Context, customerID int32, items []CartItem, couponCodes []string) (*CartPricing, error)
+	ReleasePromotionRedemptions(ctx context.Context, redemptionIDs []int32) (string, error)
+
+	// Đánh giá sản phẩm, orderID là đơn COMPLETED của khách có chứa sản phẩm
+	CreateReview(ctx context.Context, review *Review, orderID int32) (*Review, error)
+	ListProductReviews(ctx context.Context, productID int32, productType string, page, pageSize int32) ([]Review, ReviewSummary, error)
+	ListReviews(ctx context.Context, filter ReviewFilter) ([]Review, error)
+	ModerateReview(ctx context.Context, id int32, status ReviewStatus, moderatorID int32, note string) (*Review, error)
+
+	// Lịch sử giá
+	SchedulePriceChange(ctx context.Context, change *PriceChange) (*PriceChange, error)
+	CancelPriceChange(ctx context.Context, id int32) (string, error)
+	ListPriceHistory(ctx context.Context, productType string, productID, variantID int32) ([]PriceChange, float32, error)
+	GetPriceAt(ctx context.Context, productType string, productID, variantID int32, at time.Time) (float32, error)
+	ApplyScheduledPrices(ctx context.Context) (int, error)
 }
 
 // Helper functions to convert between internal types and protobuf types
@@ -172,6 +835,9 @@ func toProtoFood(f *Food) *pb.Food {
 		Price:        f.Price,
 		Imgurl:       f.ImgUrl,
 		IsAttachable: f.IsAttachable,
+		Sku:          string(f.SKU),
+		Barcode:      string(f.Barcode),
+		IsArchived:   f.IsArchived,
 	}
 }
 func toProtoGeneralProduct(f *GeneralProduct) *pb.GeneralProduct {
@@ -184,6 +850,11 @@ func toProtoGeneralProduct(f *GeneralProduct) *pb.GeneralProduct {
 		ProductId:         f.ProductID,
 		IsAttachable:      f.IsAttachable,
 		AvailableQuantity: f.AvailableQuantity,
+		Sku:               f.SKU,
+		Barcode:           f.Barcode,
+		AverageRating:     f.AverageRating,
+		ReviewCount:       f.ReviewCount,
+		IsArchived:        f.IsArchived,
 	}
 }
 func toProtoProductWithStock(f *ProductWithStock) *pb.ProductWithStock {
@@ -215,6 +886,9 @@ func toProtoAccessory(a *Accessory) *pb.Accessory {
 		Price:        a.Price,
 		Imgurl:       a.ImgUrl,
 		IsAttachable: a.IsAttachable,
+		Sku:          string(a.SKU),
+		Barcode:      string(a.Barcode),
+		IsArchived:   a.IsArchived,
 	}
 }
 
@@ -226,14 +900,42 @@ func toProtoMedicine(m *Medicine) *pb.Medicine {
 		Price:        m.Price,
 		Imgurl:       m.ImgUrl,
 		IsAttachable: m.IsAttachable,
+		Sku:          string(m.SKU),
+		Barcode:      string(m.Barcode),
+		IsArchived:   m.IsArchived,
 	}
 }
 
 func toProtoBranch(b *Branch) *pb.Branch {
 	return &pb.Branch{
-		Id:       b.ID,
-		Name:     b.Name,
-		Location: b.Location,
+		Id:                  b.ID,
+		Name:                b.Name,
+		Location:            b.Location,
+		Phone:               b.Phone,
+		Email:               b.Email,
+		Latitude:            b.Latitude,
+		Longitude:           b.Longitude,
+		Timezone:            b.Timezone,
+		IsActive:            b.IsActive,
+		AppointmentCapacity: b.AppointmentCapacity,
+	}
+}
+
+func toProtoOpeningHour(h *BranchOpeningHour) *pb.OpeningHour {
+	return &pb.OpeningHour{
+		Weekday:   h.Weekday,
+		OpenTime:  h.OpenTime,
+		CloseTime: h.CloseTime,
+	}
+}
+
+func toProtoBranchClosure(c *BranchClosure) *pb.BranchClosure {
+	return &pb.BranchClosure{
+		Id:        c.ID,
+		BranchId:  c.BranchID,
+		StartDate: c.StartDate,
+		EndDate:   c.EndDate,
+		Reason:    c.Reason,
 	}
 }
 
@@ -244,5 +946,240 @@ func toProtoBranchProduct(bp *BranchProduct) *pb.BranchProduct {
 		ProductType:      bp.ProductType,
 		StockQuantity:    bp.StockQuantity,
 		ReservedQuantity: bp.ReservedQuantity,
+		VariantId:        bp.VariantID,
+	}
+}
+
+func toProtoReservation(r *Reservation) *pb.Reservation {
+	var orderID int32
+	if r.OrderID != nil {
+		orderID = *r.OrderID
+	}
+	return &pb.Reservation{
+		Id:          r.ID,
+		BranchId:    r.BranchID,
+		ProductId:   r.ProductID,
+		ProductType: r.ProductType,
+		Quantity:    r.Quantity,
+		OrderId:     orderID,
+		Status:      string(r.Status),
+		ExpiresAt:   formatOptionalTime(r.ExpiresAt),
+		CreatedAt:   r.CreatedAt.Format(time.RFC3339),
+		VariantId:   r.VariantID,
+	}
+}
+
+func toProtoProductLookup(l *ProductLookup) *pb.ProductLookup {
+	resp := &pb.ProductLookup{
+		Product: toProtoGeneralProduct(&l.Product),
+		Price:   l.Price,
+		Sku:     l.SKU,
+		Barcode: l.Barcode,
+	}
+	if l.Variant != nil {
+		resp.Variant = toProtoVariant(l.Variant)
+	}
+	return resp
+}
+
+func toProtoVariant(v *ProductVariant) *pb.ProductVariant {
+	return &pb.ProductVariant{
+		Id:          v.ID,
+		ProductId:   v.ProductID,
+		ProductType: v.ProductType,
+		Name:        v.Name,
+		Sku:         v.SKU,
+		Barcode:     string(v.Barcode),
+		Size:        v.Size,
+		Weight:      v.Weight,
+		Flavour:     v.Flavour,
+		Price:       v.Price,
+		IsActive:    v.IsActive,
+	}
+}
+
+func fromProtoVariant(v *pb.ProductVariant) *ProductVariant {
+	return &ProductVariant{
+		ID:          v.Id,
+		ProductID:   v.ProductId,
+		ProductType: v.ProductType,
+		Name:        v.Name,
+		SKU:         v.Sku,
+		Barcode:     ItemCode(v.Barcode),
+		Size:        v.Size,
+		Weight:      v.Weight,
+		Flavour:     v.Flavour,
+		Price:       v.Price,
+	}
+}
+
+func toProtoProductImage(img *ProductImage) *pb.ProductImage {
+	return &pb.ProductImage{
+		Id:           img.ID,
+		ProductId:    img.ProductID,
+		ProductType:  img.ProductType,
+		Url:          img.URL,
+		ThumbnailUrl: img.ThumbnailURL,
+		StorageKey:   img.StorageKey,
+		ThumbnailKey: img.ThumbnailKey,
+		Position:     img.Position,
+		IsPrimary:    img.IsPrimary,
+	}
+}
+
+func toProtoFacetCounts(counts []FacetCount) []*pb.FacetCount {
+	protoCounts := make([]*pb.FacetCount, len(counts))
+	for i, c := range counts {
+		protoCounts[i] = &pb.FacetCount{Value: c.Value, Count: c.Count}
+	}
+	return protoCounts
+}
+
+func toProtoSearchFacets(f *SearchFacets) *pb.SearchFacets {
+	return &pb.SearchFacets{
+		ProductTypes: toProtoFacetCounts(f.ProductTypes),
+		Attachable:   toProtoFacetCounts(f.Attachable),
+		PriceRanges:  toProtoFacetCounts(f.PriceRanges),
+	}
+}
+
+func toProtoSupplier(s *Supplier) *pb.Supplier {
+	return &pb.Supplier{
+		Id:          s.ID,
+		Name:        s.Name,
+		ContactName: s.ContactName,
+		Phone:       s.Phone,
+		Email:       s.Email,
+		Address:     s.Address,
+	}
+}
+
+func toProtoPurchaseOrder(po *PurchaseOrder) *pb.PurchaseOrder {
+	var expectedDate string
+	if po.ExpectedDate != nil {
+		expectedDate = po.ExpectedDate.Format(time.RFC3339)
+	}
+	lines := make([]*pb.PurchaseOrderLine, len(po.Lines))
+	for i, l := range po.Lines {
+		lines[i] = &pb.PurchaseOrderLine{
+			Id:               l.ID,
+			ProductId:        l.ProductID,
+			ProductType:      l.ProductType,
+			QuantityOrdered:  l.QuantityOrdered,
+			QuantityReceived: l.QuantityReceived,
+			UnitCost:         l.UnitCost,
+			VariantId:        l.VariantID,
+		}
+	}
+	return &pb.PurchaseOrder{
+		Id:           po.ID,
+		SupplierId:   po.SupplierID,
+		BranchId:     po.BranchID,
+		Status:       string(po.Status),
+		ExpectedDate: expectedDate,
+		Note:         po.Note,
+		TotalCost:    po.TotalCost,
+		Lines:        lines,
+		CreatedAt:    po.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toProtoImportResult(r *ImportResult) *pb.ImportResponse {
+	resp := &pb.ImportResponse{
+		TotalRows: r.TotalRows,
+		Created:   r.Created,
+		Updated:   r.Updated,
+		Applied:   r.Applied,
+	}
+	for _, e := range r.Errors {
+		resp.Errors = append(resp.Errors, &pb.ImportRowError{Row: e.Row, Field: e.Field, Message: e.Message})
+	}
+	return resp
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func toProtoPromotion(p *Promotion) *pb.Promotion {
+	return &pb.Promotion{
+		Id:               p.ID,
+		Name:             p.Name,
+		Description:      p.Description,
+		Code:             string(p.Code),
+		Type:             string(p.Type),
+		Value:            p.Value,
+		MaxDiscount:      p.MaxDiscount,
+		BuyQuantity:      p.BuyQuantity,
+		GetQuantity:      p.GetQuantity,
+		Scope:            string(p.Scope),
+		Category:         p.Category,
+		ItemType:         p.ItemType,
+		ItemId:           p.ItemID,
+		MinSpend:         p.MinSpend,
+		StartsAt:         formatOptionalTime(p.StartsAt),
+		EndsAt:           formatOptionalTime(p.EndsAt),
+		UsageLimit:       p.UsageLimit,
+		PerCustomerLimit: p.PerCustomerLimit,
+		UsedCount:        p.UsedCount,
+		IsActive:         p.IsActive,
+	}
+}
+
+func toProtoCartPricing(c *CartPricing) *pb.CartPricing {
+	applied := make([]*pb.AppliedPromotion, len(c.Applied))
+	for i, a := range c.Applied {
+		applied[i] = &pb.AppliedPromotion{
+			PromotionId:  a.PromotionID,
+			Code:         a.Code,
+			Name:         a.Name,
+			Discount:     a.Discount,
+			RedemptionId: a.RedemptionID,
+		}
+	}
+	return &pb.CartPricing{
+		Subtotal:          c.Subtotal,
+		Discount:          c.Discount,
+		Total:             c.Total,
+		AppliedPromotions: applied,
+	}
+}
+
+func toProtoPriceChange(c *PriceChange) *pb.PriceChange {
+	change := &pb.PriceChange{
+		Id:            c.ID,
+		ProductId:     c.ProductID,
+		ProductType:   c.ProductType,
+		VariantId:     c.VariantID,
+		Price:         c.Price,
+		PreviousPrice: c.PreviousPrice,
+		EffectiveAt:   c.EffectiveAt.Format(time.RFC3339),
+		CreatedBy:     c.CreatedBy,
+		Note:          c.Note,
+		Status:        c.Status(),
+	}
+	if c.AppliedAt != nil {
+		change.AppliedAt = c.AppliedAt.Format(time.RFC3339)
+	}
+	return change
+}
+
+func toProtoReview(r *Review) *pb.Review {
+	return &pb.Review{
+		Id:             r.ID,
+		ProductId:      r.ProductID,
+		ProductType:    r.ProductType,
+		CustomerId:     r.CustomerID,
+		OrderId:        r.OrderID,
+		Rating:         r.Rating,
+		Comment:        r.Comment,
+		Status:         string(r.Status),
+		ModeratedBy:    r.ModeratedBy,
+		ModerationNote: r.ModerationNote,
+		CreatedAt:      r.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      r.UpdatedAt.Format(time.RFC3339),
 	}
 }
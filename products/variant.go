@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Biến thể sản phẩm: tồn kho, giữ hàng và đơn nhập của sản phẩm có biến thể được tính theo từng biến thể,
+// sản phẩm không có biến thể dùng VariantID = 0. Khi sản phẩm đã có biến thể đang bán thì phải chỉ rõ biến thể.
+
+// validateVariant kiểm tra và chuẩn hoá biến thể trước khi lưu
+func validateVariant(v *ProductVariant) error {
+	v.Name = strings.TrimSpace(v.Name)
+	v.SKU = normalizeSKU(v.SKU)
+	v.Barcode = ItemCode(normalizeBarcode(string(v.Barcode)))
+	v.Size = strings.TrimSpace(v.Size)
+	v.Weight = strings.TrimSpace(v.Weight)
+	v.Flavour = strings.TrimSpace(v.Flavour)
+	if v.Name == "" {
+		return fmt.Errorf("%w: variant name is required", ErrInvalidArgument)
+	}
+	if v.SKU == "" {
+		return fmt.Errorf("%w: variant sku is required", ErrInvalidArgument)
+	}
+	if v.Price < 0 {
+		return fmt.Errorf("%w: variant price must not be negative", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// checkItemVariants kiểm tra VariantID của các dòng: biến thể phải thuộc đúng sản phẩm và đang bán,
+// sản phẩm có biến thể đang bán thì không được dùng VariantID = 0.
+// variants là các biến thể được tham chiếu, hasVariants là số biến thể đang bán theo stockKey.
+func checkItemVariants(items []ReserveItem, variants map[int32]ProductVariant, hasVariants map[string]int32) error {
+	for i, item := range items {
+		if item.VariantID == 0 {
+			if hasVariants[stockKey(item.ProductType, item.ProductID)] > 0 {
+				return fmt.Errorf("%w: item %d: %s %d has variants, variant_id is required",
+					ErrInvalidArgument, i+1, item.ProductType, item.ProductID)
+			}
+			continue
+		}
+		v, ok := variants[item.VariantID]
+		if !ok || v.ProductID != item.ProductID || v.ProductType != item.ProductType {
+			return fmt.Errorf("%w: item %d: variant %d does not belong to %s %d",
+				ErrInvalidArgument, i+1, item.VariantID, item.ProductType, item.ProductID)
+		}
+		if !v.IsActive {
+			return fmt.Errorf("%w: item %d: variant %d is no longer sold", ErrInvalidState, i+1, item.VariantID)
+		}
+	}
+	return nil
+}
+
+// normalizeSKU - SKU lưu dạng chữ hoa để tra cứu không phân biệt hoa thường
+func normalizeSKU(sku string) string {
+	return strings.ToUpper(strings.TrimSpace(sku))
+}
+
+func normalizeBarcode(barcode string) string {
+	return strings.TrimSpace(barcode)
+}
@@ -101,6 +101,27 @@ func (h *GRPCHandler) ListPets(ctx context.Context, req *pb.ListPetsRequest) (*p
 	return resp, nil
 }
 
+func (h *GRPCHandler) GetPetsByIDs(ctx context.Context, req *pb.GetPetsByIDsRequest) (*pb.GetPetsByIDsResponse, error) {
+	pets, err := h.service.GetPetsByIDs(ctx, req.Ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get pets: %v", err)
+	}
+	resp := &pb.GetPetsByIDsResponse{}
+	for _, pet := range pets {
+		resp.Pets = append(resp.Pets, &pb.Pet{
+			Id:           pet.ID.Hex(),
+			Name:         pet.Name,
+			Species:      pet.Species,
+			Dob:          pet.Dob,
+			OwnerId:      pet.OwnerID,
+			Color:        pet.Color,
+			Weight:       pet.Weight,
+			IdentityMark: pet.IdentityMark,
+		})
+	}
+	return resp, nil
+}
+
 // --- Examination Methods ---
 func (h *GRPCHandler) CreateExamination(ctx context.Context, req *pb.CreateExaminationRequest) (*pb.CreateExaminationResponse, error) {
 	id, err := h.service.CreateExamination(ctx, req.PetId, req.Date, req.VetId, req.Diagnosis, req.Notes, req.VetName)
@@ -65,6 +65,10 @@ func (s *PetRecordService) ListPets(ctx context.Context, ownerID string) ([]*Pet
 	return s.store.ListPets(ctx, ownerID)
 }
 
+func (s *PetRecordService) GetPetsByIDs(ctx context.Context, ids []string) ([]*Pet, error) {
+	return s.store.GetPetsByIDs(ctx, ids)
+}
+
 // --- Examination Methods ---
 func (s *PetRecordService) CreateExamination(ctx context.Context, petID, dateStr, vetID, diagnosis, notes, vetName string) (string, error) {
 	if petID == "" || vetID == "" {
@@ -152,6 +152,30 @@ func (s *MongoStore) ListPets(ctx context.Context, ownerID string) ([]*Pet, erro
 	return pets, nil
 }
 
+func (s *MongoStore) GetPetsByIDs(ctx context.Context, ids []string) ([]*Pet, error) {
+	objIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		// ID không hợp lệ được bỏ qua như ID không tồn tại
+		if objID, err := primitive.ObjectIDFromHex(id); err == nil {
+			objIDs = append(objIDs, objID)
+		}
+	}
+	if len(objIDs) == 0 {
+		return nil, nil
+	}
+	cursor, err := s.pets.Find(ctx, bson.M{"_id": bson.M{"$in": objIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var pets []*Pet
+	if err := cursor.All(ctx, &pets); err != nil {
+		return nil, err
+	}
+	return pets, nil
+}
+
 // --- Examination Methods ---
 func (s *MongoStore) CreateExamination(ctx context.Context, exam *Examination) (string, error) {
 	exam.ID = primitive.NewObjectID()
@@ -63,6 +63,7 @@ type RecordsStore interface {
 	UpdatePet(ctx context.Context, pet *Pet) error
 	DeletePet(ctx context.Context, id string) error
 	ListPets(ctx context.Context, ownerID string) ([]*Pet, error)
+	GetPetsByIDs(ctx context.Context, ids []string) ([]*Pet, error)
 
 	CreateExamination(ctx context.Context, exam *Examination) (string, error)
 	GetExamination(ctx context.Context, id string) (*Examination, error)
@@ -92,6 +93,7 @@ type RecordsService interface {
 	UpdatePet(ctx context.Context, id, name, species string, dob string, ownerID, color string, weight float32, identityMark string) (*Pet, error)
 	DeletePet(ctx context.Context, id string) error
 	ListPets(ctx context.Context, ownerID string) ([]*Pet, error)
+	GetPetsByIDs(ctx context.Context, ids []string) ([]*Pet, error)
 
 	CreateExamination(ctx context.Context, petID, dateStr, vetID, diagnosis, notes, vetName string) (string, error)
 	GetExamination(ctx context.Context, id string) (*Examination, error)
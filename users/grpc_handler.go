@@ -119,6 +119,17 @@ func (h *UsersGrpcHandler) GetAllCustomers(ctx context.Context, req *pb.GetAllCu
 	}
 	return &pb.GetAllCustomersResponse{Users: protoUsers}, nil
 }
+func (h *UsersGrpcHandler) GetUsersByIDs(ctx context.Context, req *pb.GetUsersByIDsRequest) (*pb.GetUsersByIDsResponse, error) {
+	users, err := h.userService.GetUsersByIDs(ctx, req.IDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	protoUsers := make([]*pb.User, len(users))
+	for i, user := range users {
+		protoUsers[i] = toProtoUser(&user)
+	}
+	return &pb.GetUsersByIDsResponse{Users: protoUsers}, nil
+}
 func (h *UsersGrpcHandler) GetAllUsers(ctx context.Context, req *pb.GetAllUsersRequest) (*pb.GetAllUsersResponse, error) {
 	users, err := h.userService.GetAllUsers(ctx)
 	if err != nil {
@@ -240,7 +251,7 @@ func (h *UsersGrpcHandler) GetCustomersByName(ctx context.Context, req *pb.GetCu
 func (h *UsersGrpcHandler) GetBranchByEmployeeID(ctx context.Context, req *pb.GetBranchByEmployeeIDRequest) (*pb.GetBranchByEmployeeIDResponse, error) {
 	branchID, err := h.userService.GetBranchByEmployeeID(ctx, req.EmployeeId)
 	if err != nil {
-		if errors.Is(err, errors.New("no branch found for user ID")) {
+		if errors.Is(err, ErrBranchNotFound) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
 		}
 		return nil, status.Errorf(codes.Internal, err.Error())
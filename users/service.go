@@ -324,6 +324,18 @@ func (s *Service) GetAllCustomers(ctx context.Context) ([]User, error) {
 	return users, nil
 }
 
+// GetUsersByIDs retrieves the users with the given IDs, unknown IDs are skipped
+func (s *Service) GetUsersByIDs(ctx context.Context, ids []int32) ([]User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	users, err := s.userStore.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+	return users, nil
+}
+
 // GetAllUsers retrieves all users
 func (s *Service) GetAllUsers(ctx context.Context) ([]UserWithRole, error) {
 	users, err := s.userStore.GetAllUsers(ctx)
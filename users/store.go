@@ -7,6 +7,9 @@ import (
 	"gorm.io/gorm"
 )
 
+// ErrBranchNotFound - nhân viên không tồn tại hoặc chưa được gán chi nhánh
+var ErrBranchNotFound = errors.New("no branch found for user")
+
 type Store struct {
 	db *gorm.DB
 }
@@ -220,12 +223,12 @@ func (s *Store) GetBranchByEmployeeID(ctx context.Context, userID int32) (int32,
 	err := s.db.WithContext(ctx).Where("id = ?", userID).First(&eb).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return 0, fmt.Errorf("no branch found for user ID %d", userID)
+			return 0, fmt.Errorf("%w: user ID %d", ErrBranchNotFound, userID)
 		}
 		return 0, err
 	}
 	if eb.BranchID == nil {
-		return -1, fmt.Errorf("no branch associated with user ID %d", userID)
+		return -1, fmt.Errorf("%w: no branch associated with user ID %d", ErrBranchNotFound, userID)
 	}
 	branchID := *eb.BranchID
 	return branchID, nil
@@ -46,6 +46,7 @@ type UserService interface {
 	GetCustomersByName(ctx context.Context, nameFilter string) ([]User, error)
 	GetBranchByEmployeeID(ctx context.Context, employeeID int32) (int32, error)
 	CreateUser(ctx context.Context, email, name, phoneNumber string) (int32, error)
+	GetUsersByIDs(ctx context.Context, ids []int32) ([]User, error)
 }
 
 // User represents a user in the internal system